@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+type XenditRefundRequest struct {
+	Event      string           `json:"event"`
+	BusinessID string           `json:"business_id"`
+	Created    time.Time        `json:"created"`
+	Data       XenditRefundData `json:"data"`
+}
+
+type XenditRefundData struct {
+	ID          string    `json:"id"`
+	PaymentID   string    `json:"payment_id"`
+	InvoiceID   string    `json:"invoice_id"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	Status      string    `json:"status"`
+	Reason      string    `json:"reason"`
+	ReferenceID string    `json:"reference_id"`
+	FailureCode string    `json:"failure_code"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
@@ -90,6 +90,63 @@ func (ch *CartHandler) UpdateCartQty(ctx context.Context, req *cart.UpdateCartQt
 	return res, nil
 }
 
+func (ch *CartHandler) CreateGuestCart(ctx context.Context, req *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &cart.CreateGuestCartResponse{
+			BaseResponse: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.cartService.CreateGuestCart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *CartHandler) BulkUpdateCart(ctx context.Context, req *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &cart.BulkUpdateCartResponse{
+			BaseResponse: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.cartService.BulkUpdateCart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *CartHandler) ClearCart(ctx context.Context, req *cart.ClearCartRequest) (*cart.ClearCartResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &cart.ClearCartResponse{
+			BaseResponse: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.cartService.ClearCart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func NewCartHandler(cartService services.ICartService) *CartHandler {
 	return &CartHandler{
 		cartService: cartService,
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/integration"
+)
+
+type integrationHandler struct {
+	integration.UnimplementedIntegrationServiceServer
+
+	integrationService services.IIntegrationService
+}
+
+func (ih *integrationHandler) CreateWebhookSubscription(ctx context.Context, req *integration.CreateWebhookSubscriptionRequest) (*integration.CreateWebhookSubscriptionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.CreateWebhookSubscriptionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.CreateWebhookSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) UpdateWebhookSubscription(ctx context.Context, req *integration.UpdateWebhookSubscriptionRequest) (*integration.UpdateWebhookSubscriptionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.UpdateWebhookSubscriptionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.UpdateWebhookSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) DeleteWebhookSubscription(ctx context.Context, req *integration.DeleteWebhookSubscriptionRequest) (*integration.DeleteWebhookSubscriptionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.DeleteWebhookSubscriptionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.DeleteWebhookSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) ListWebhookSubscriptions(ctx context.Context, req *integration.ListWebhookSubscriptionsRequest) (*integration.ListWebhookSubscriptionsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.ListWebhookSubscriptionsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.ListWebhookSubscriptions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) PingWebhookSubscription(ctx context.Context, req *integration.PingWebhookSubscriptionRequest) (*integration.PingWebhookSubscriptionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.PingWebhookSubscriptionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.PingWebhookSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) ListWebhookDeliveries(ctx context.Context, req *integration.ListWebhookDeliveriesRequest) (*integration.ListWebhookDeliveriesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.ListWebhookDeliveriesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ih *integrationHandler) RetryWebhookDelivery(ctx context.Context, req *integration.RetryWebhookDeliveryRequest) (*integration.RetryWebhookDeliveryResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &integration.RetryWebhookDeliveryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ih.integrationService.RetryWebhookDelivery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewIntegrationHandler(integrationService services.IIntegrationService) *integrationHandler {
+	return &integrationHandler{
+		integrationService: integrationService,
+	}
+}
@@ -33,6 +33,246 @@ func (nh *newsletterHandler) Subscribe(ctx context.Context, req *newsletter.Subs
 	return res, nil
 }
 
+func (nh *newsletterHandler) ConfirmSubscription(ctx context.Context, req *newsletter.ConfirmSubscriptionRequest) (*newsletter.ConfirmSubscriptionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.ConfirmSubscriptionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.ConfirmSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) Unsubscribe(ctx context.Context, req *newsletter.UnsubscribeRequest) (*newsletter.UnsubscribeResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.UnsubscribeResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.Unsubscribe(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) GetPreferences(ctx context.Context, req *newsletter.GetPreferencesRequest) (*newsletter.GetPreferencesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.GetPreferencesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.GetPreferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) UpdatePreferences(ctx context.Context, req *newsletter.UpdatePreferencesRequest) (*newsletter.UpdatePreferencesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.UpdatePreferencesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.UpdatePreferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ListSubscribers(ctx context.Context, req *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.ListSubscribersResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.ListSubscribers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ExportSubscribers(req *newsletter.ExportSubscribersRequest, stream newsletter.NewsletterService_ExportSubscribersServer) error {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrorStatus(validationErrors)
+	}
+
+	return nh.newsletterService.ExportSubscribers(req, stream)
+}
+
+func (nh *newsletterHandler) CreateCampaign(ctx context.Context, req *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.CreateCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.CreateCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) UpdateCampaign(ctx context.Context, req *newsletter.UpdateCampaignRequest) (*newsletter.UpdateCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.UpdateCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.UpdateCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) SendTestCampaign(ctx context.Context, req *newsletter.SendTestCampaignRequest) (*newsletter.SendTestCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.SendTestCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.SendTestCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ScheduleCampaign(ctx context.Context, req *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.ScheduleCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.ScheduleCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) CancelCampaign(ctx context.Context, req *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.CancelCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.CancelCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ListCampaigns(ctx context.Context, req *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.ListCampaignsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.ListCampaigns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) DetailCampaign(ctx context.Context, req *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &newsletter.DetailCampaignResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.newsletterService.DetailCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func NewNewsletterHandler(newsletterService services.INewsletterService) *newsletterHandler {
 	return &newsletterHandler{
 		newsletterService: newsletterService,
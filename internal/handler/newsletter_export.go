@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/newsletter"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/export"
+	"google.golang.org/grpc/metadata"
+)
+
+// exportSubscribersMethod is the RPC whose access rules the download
+// follows.
+const exportSubscribersMethod = "/newsletter.NewsletterService/ExportSubscribers"
+
+// newsletterExportHandler serves the subscriber export as a file download.
+// It takes the same filters as ExportSubscribers as query parameters:
+//
+//	GET /export/newsletter-subscribers?format=csv&status=subscribed
+//	    &topic=promotions&email=gmail.com
+type newsletterExportHandler struct {
+	newsletterService services.INewsletterService
+	authenticator     Authenticator
+}
+
+func (nh *newsletterExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	ctx, err := nh.authenticator.Authenticate(ctx, exportSubscribersMethod)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = export.FormatCSV
+	}
+
+	req := &newsletter.ExportSubscribersRequest{
+		Filter: &newsletter.ListSubscribersRequest{
+			Status: query.Get("status"),
+			Topic:  query.Get("topic"),
+			Email:  query.Get("email"),
+		},
+		Format: format,
+	}
+
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	if validationErrors != nil {
+		writeStatusError(w, validationErrorStatus(validationErrors))
+		return
+	}
+
+	// Large exports outlive the server's write timeout.
+	err = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	if err != nil {
+		log.Printf("failed to clear write deadline for subscriber export: %v", err)
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(req.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, services.SubscriberExportFileName(req.Format, time.Now())))
+
+	body := &trackingWriter{w: w}
+	err = nh.newsletterService.WriteSubscriberExport(ctx, req, body)
+	if err != nil {
+		if body.written {
+			// Too late for an error status, the client gets a cut off file.
+			log.Printf("subscriber export stopped: %v", err)
+			return
+		}
+
+		w.Header().Del("Content-Disposition")
+		writeStatusError(w, err)
+	}
+}
+
+func NewNewsletterExportHandler(newsletterService services.INewsletterService, authenticator Authenticator) http.Handler {
+	return &newsletterExportHandler{
+		newsletterService: newsletterService,
+		authenticator:     authenticator,
+	}
+}
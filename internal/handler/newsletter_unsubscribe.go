@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/newsletter"
+)
+
+// newsletterUnsubscribeHandler is the target of the List-Unsubscribe header
+// of newsletter emails:
+//
+//	POST /newsletter/unsubscribe?token=...  unsubscribes at once (RFC 8058)
+//	GET  /newsletter/unsubscribe?token=...  redirects to the unsubscribe page
+//
+// GET never unsubscribes, since mail scanners open links on their own.
+type newsletterUnsubscribeHandler struct {
+	newsletterService services.INewsletterService
+}
+
+func (nh *newsletterUnsubscribeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "missing token", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		http.Redirect(w, r, nh.newsletterService.UnsubscribePageURL(token), http.StatusSeeOther)
+	case http.MethodPost:
+		res, err := nh.newsletterService.Unsubscribe(r.Context(), &newsletter.UnsubscribeRequest{Token: token})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		if res.Base.IsError {
+			http.Error(w, res.Base.Message, int(res.Base.StatusCode))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte(res.Base.Message))
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func NewNewsletterUnsubscribeHandler(newsletterService services.INewsletterService) http.Handler {
+	return &newsletterUnsubscribeHandler{
+		newsletterService: newsletterService,
+	}
+}
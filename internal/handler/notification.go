@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/notification"
+)
+
+type notificationHandler struct {
+	notification.UnimplementedNotificationServiceServer
+
+	notificationService services.INotificationService
+}
+
+func (nh *notificationHandler) GetNotificationPreferences(ctx context.Context, req *notification.GetNotificationPreferencesRequest) (*notification.GetNotificationPreferencesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &notification.GetNotificationPreferencesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.notificationService.GetNotificationPreferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *notificationHandler) UpdateNotificationPreferences(ctx context.Context, req *notification.UpdateNotificationPreferencesRequest) (*notification.UpdateNotificationPreferencesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &notification.UpdateNotificationPreferencesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.notificationService.UpdateNotificationPreferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *notificationHandler) ListNotifications(ctx context.Context, req *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &notification.ListNotificationsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := nh.notificationService.ListNotifications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewNotificationHandler(notificationService services.INotificationService) *notificationHandler {
+	return &notificationHandler{
+		notificationService: notificationService,
+	}
+}
@@ -2,10 +2,15 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
 	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type orderHandler struct {
@@ -109,6 +114,148 @@ func (oh *orderHandler) UpdateOrderStatus(ctx context.Context, req *order.Update
 	return res, nil
 }
 
+func (oh *orderHandler) CreateShipment(ctx context.Context, req *order.CreateShipmentRequest) (*order.CreateShipmentResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.CreateShipmentResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.CreateShipment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (oh *orderHandler) ListOrderStatusHistory(ctx context.Context, req *order.ListOrderStatusHistoryRequest) (*order.ListOrderStatusHistoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.ListOrderStatusHistoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.ListOrderStatusHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (oh *orderHandler) ListOrderStatuses(ctx context.Context, req *order.ListOrderStatusesRequest) (*order.ListOrderStatusesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.ListOrderStatusesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.ListOrderStatuses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (oh *orderHandler) CancelOrder(ctx context.Context, req *order.CancelOrderRequest) (*order.CancelOrderResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.CancelOrderResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.CancelOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (oh *orderHandler) ExportOrders(req *order.ExportOrdersRequest, stream order.OrderService_ExportOrdersServer) error {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrorStatus(validationErrors)
+	}
+
+	return oh.orderService.ExportOrders(req, stream)
+}
+
+func (oh *orderHandler) WatchOrder(req *order.WatchOrderRequest, stream order.OrderService_WatchOrderServer) error {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrorStatus(validationErrors)
+	}
+
+	return oh.orderService.WatchOrder(req, stream)
+}
+
+func (oh *orderHandler) WatchOrders(req *order.WatchOrdersRequest, stream order.OrderService_WatchOrdersServer) error {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrorStatus(validationErrors)
+	}
+
+	return oh.orderService.WatchOrders(req, stream)
+}
+
+func (oh *orderHandler) GetOrderReceipt(ctx context.Context, req *order.GetOrderReceiptRequest) (*order.GetOrderReceiptResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.GetOrderReceiptResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.GetOrderReceipt(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// validationErrorStatus reports validation errors on streaming RPCs, which
+// have no response to carry them.
+func validationErrorStatus(validationErrors []*common.ValidationError) error {
+	messages := make([]string, 0, len(validationErrors))
+	for _, ve := range validationErrors {
+		messages = append(messages, fmt.Sprintf("%s: %s", ve.Field, ve.Message))
+	}
+
+	return status.Error(codes.InvalidArgument, strings.Join(messages, "; "))
+}
+
 func NewOrderHandler(orderService services.IOrderService) *orderHandler {
 	return &orderHandler{
 		orderService: orderService,
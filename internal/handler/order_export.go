@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/export"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportOrdersMethod is the RPC whose access rules the download follows.
+const exportOrdersMethod = "/order.OrderService/ExportOrders"
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, method string) (context.Context, error)
+}
+
+// orderExportHandler serves the order export as a file download, for
+// browsers that cannot read a gRPC stream. It takes the same filters as
+// ExportOrders as query parameters:
+//
+//	GET /export/orders?format=csv&status_code=paid&status_code=shipped
+//	    &created_from=2024-01-01T00:00:00Z&created_to=2024-02-01T00:00:00Z
+//	    &min_total=100000&max_total=500000&total_currency=IDR
+//	    &customer=budi&number_prefix=INV&payment_method=EWALLET
+type orderExportHandler struct {
+	orderService  services.IOrderService
+	authenticator Authenticator
+}
+
+func (oh *orderExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	ctx, err := oh.authenticator.Authenticate(ctx, exportOrdersMethod)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	req, err := exportOrdersRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	if validationErrors != nil {
+		writeStatusError(w, validationErrorStatus(validationErrors))
+		return
+	}
+
+	// Large exports outlive the server's write timeout.
+	err = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	if err != nil {
+		log.Printf("failed to clear write deadline for order export: %v", err)
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(req.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, services.OrderExportFileName(req.Format, time.Now())))
+
+	body := &trackingWriter{w: w}
+	err = oh.orderService.WriteOrderExport(ctx, req, body)
+	if err != nil {
+		if body.written {
+			// Too late for an error status, the client gets a cut off file.
+			log.Printf("order export stopped: %v", err)
+			return
+		}
+
+		w.Header().Del("Content-Disposition")
+		writeStatusError(w, err)
+	}
+}
+
+func exportOrdersRequestFromQuery(r *http.Request) (*order.ExportOrdersRequest, error) {
+	query := r.URL.Query()
+
+	filter := &order.ListOrderAdminRequest{
+		StatusCodes:   query["status_code"],
+		Customer:      query.Get("customer"),
+		NumberPrefix:  query.Get("number_prefix"),
+		PaymentMethod: query.Get("payment_method"),
+	}
+
+	for name, target := range map[string]**timestamppb.Timestamp{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+	} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time", name)
+		}
+		*target = timestamppb.New(t)
+	}
+
+	for name, target := range map[string]**common.Money{
+		"min_total": &filter.MinTotal,
+		"max_total": &filter.MaxTotal,
+	} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		minorUnits, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a whole number of minor units", name)
+		}
+		*target = &common.Money{
+			CurrencyCode: query.Get("total_currency"),
+			MinorUnits:   minorUnits,
+		}
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = export.FormatCSV
+	}
+
+	return &order.ExportOrdersRequest{
+		Filter: filter,
+		Format: format,
+	}, nil
+}
+
+func writeStatusError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.NotFound:
+		code = http.StatusNotFound
+	}
+
+	if code == http.StatusInternalServerError {
+		log.Println(err)
+		http.Error(w, "internal server error", code)
+		return
+	}
+
+	http.Error(w, st.Message(), code)
+}
+
+// trackingWriter remembers whether the response body has been started.
+type trackingWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (tw *trackingWriter) Write(p []byte) (int, error) {
+	tw.written = true
+	return tw.w.Write(p)
+}
+
+func NewOrderExportHandler(orderService services.IOrderService, authenticator Authenticator) http.Handler {
+	return &orderExportHandler{
+		orderService:  orderService,
+		authenticator: authenticator,
+	}
+}
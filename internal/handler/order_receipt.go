@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"google.golang.org/grpc/metadata"
+)
+
+// getOrderReceiptMethod is the RPC whose access rules the download follows.
+const getOrderReceiptMethod = "/order.OrderService/GetOrderReceipt"
+
+// orderReceiptHandler serves the PDF receipt of an order as a download:
+//
+//	GET /orders/{order_id}/receipt
+type orderReceiptHandler struct {
+	orderService  services.IOrderService
+	authenticator Authenticator
+}
+
+func (oh *orderReceiptHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	orderID, ok := ReceiptOrderID(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	ctx, err := oh.authenticator.Authenticate(ctx, getOrderReceiptMethod)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	res, err := oh.orderService.GetOrderReceipt(ctx, &order.GetOrderReceiptRequest{OrderId: orderID})
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	if res.Base.IsError {
+		http.Error(w, res.Base.Message, int(res.Base.StatusCode))
+		return
+	}
+
+	w.Header().Set("Content-Type", res.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, res.FileName))
+	w.Write(res.Content)
+}
+
+// ReceiptOrderID returns the order id of a receipt download path.
+func ReceiptOrderID(path string) (string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "orders" || parts[2] != "receipt" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+func NewOrderReceiptHandler(orderService services.IOrderService, authenticator Authenticator) http.Handler {
+	return &orderReceiptHandler{
+		orderService:  orderService,
+		authenticator: authenticator,
+	}
+}
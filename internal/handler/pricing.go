@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/pricing"
+)
+
+type pricingHandler struct {
+	pricing.UnimplementedPricingServiceServer
+
+	pricingService services.IPricingService
+}
+
+func (ph *pricingHandler) SetProductPrice(ctx context.Context, req *pricing.SetProductPriceRequest) (*pricing.SetProductPriceResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.SetProductPriceResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.SetProductPrice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *pricingHandler) DeleteProductPrice(ctx context.Context, req *pricing.DeleteProductPriceRequest) (*pricing.DeleteProductPriceResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.DeleteProductPriceResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.DeleteProductPrice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *pricingHandler) ListProductPrices(ctx context.Context, req *pricing.ListProductPricesRequest) (*pricing.ListProductPricesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.ListProductPricesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.ListProductPrices(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *pricingHandler) SetExchangeRate(ctx context.Context, req *pricing.SetExchangeRateRequest) (*pricing.SetExchangeRateResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.SetExchangeRateResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.SetExchangeRate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *pricingHandler) ListExchangeRates(ctx context.Context, req *pricing.ListExchangeRatesRequest) (*pricing.ListExchangeRatesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.ListExchangeRatesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.ListExchangeRates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *pricingHandler) DeriveProductPrices(ctx context.Context, req *pricing.DeriveProductPricesRequest) (*pricing.DeriveProductPricesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &pricing.DeriveProductPricesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.pricingService.DeriveProductPrices(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewPricingHandler(pricingService services.IPricingService) *pricingHandler {
+	return &pricingHandler{
+		pricingService: pricingService,
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/promotion"
+)
+
+type promotionHandler struct {
+	promotion.UnimplementedPromotionServiceServer
+
+	promotionService services.IPromotionService
+}
+
+func (ph *promotionHandler) CreatePromotion(ctx context.Context, req *promotion.CreatePromotionRequest) (*promotion.CreatePromotionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.CreatePromotionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.CreatePromotion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *promotionHandler) UpdatePromotion(ctx context.Context, req *promotion.UpdatePromotionRequest) (*promotion.UpdatePromotionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.UpdatePromotionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.UpdatePromotion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *promotionHandler) DeletePromotion(ctx context.Context, req *promotion.DeletePromotionRequest) (*promotion.DeletePromotionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.DeletePromotionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.DeletePromotion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *promotionHandler) DetailPromotion(ctx context.Context, req *promotion.DetailPromotionRequest) (*promotion.DetailPromotionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.DetailPromotionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.DetailPromotion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *promotionHandler) ListPromotion(ctx context.Context, req *promotion.ListPromotionRequest) (*promotion.ListPromotionResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.ListPromotionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.ListPromotion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *promotionHandler) ValidateCoupon(ctx context.Context, req *promotion.ValidateCouponRequest) (*promotion.ValidateCouponResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &promotion.ValidateCouponResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.promotionService.ValidateCoupon(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewPromotionHandler(promotionService services.IPromotionService) *promotionHandler {
+	return &promotionHandler{
+		promotionService: promotionService,
+	}
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/returns"
+)
+
+type returnHandler struct {
+	returns.UnimplementedReturnServiceServer
+
+	returnService services.IReturnService
+}
+
+func (rh *returnHandler) RequestReturn(ctx context.Context, req *returns.RequestReturnRequest) (*returns.RequestReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.RequestReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.RequestReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) CancelReturn(ctx context.Context, req *returns.CancelReturnRequest) (*returns.CancelReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.CancelReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.CancelReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) ApproveReturn(ctx context.Context, req *returns.ApproveReturnRequest) (*returns.ApproveReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.ApproveReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.ApproveReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) RejectReturn(ctx context.Context, req *returns.RejectReturnRequest) (*returns.RejectReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.RejectReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.RejectReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) ReceiveReturn(ctx context.Context, req *returns.ReceiveReturnRequest) (*returns.ReceiveReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.ReceiveReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.ReceiveReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) ListReturns(ctx context.Context, req *returns.ListReturnsRequest) (*returns.ListReturnsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.ListReturnsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.ListReturns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) DetailReturn(ctx context.Context, req *returns.DetailReturnRequest) (*returns.DetailReturnResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.DetailReturnResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.DetailReturn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *returnHandler) RefundReport(ctx context.Context, req *returns.RefundReportRequest) (*returns.RefundReportResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &returns.RefundReportResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.returnService.RefundReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewReturnHandler(returnService services.IReturnService) *returnHandler {
+	return &returnHandler{
+		returnService: returnService,
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/shipping"
+)
+
+type shippingHandler struct {
+	shipping.UnimplementedShippingServiceServer
+
+	shippingService services.IShippingService
+}
+
+func (sh *shippingHandler) CreateShippingMethod(ctx context.Context, req *shipping.CreateShippingMethodRequest) (*shipping.CreateShippingMethodResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.CreateShippingMethodResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.CreateShippingMethod(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) UpdateShippingMethod(ctx context.Context, req *shipping.UpdateShippingMethodRequest) (*shipping.UpdateShippingMethodResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.UpdateShippingMethodResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.UpdateShippingMethod(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) DeleteShippingMethod(ctx context.Context, req *shipping.DeleteShippingMethodRequest) (*shipping.DeleteShippingMethodResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.DeleteShippingMethodResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.DeleteShippingMethod(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) ListShippingMethods(ctx context.Context, req *shipping.ListShippingMethodsRequest) (*shipping.ListShippingMethodsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.ListShippingMethodsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.ListShippingMethods(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) SetShippingRates(ctx context.Context, req *shipping.SetShippingRatesRequest) (*shipping.SetShippingRatesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.SetShippingRatesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.SetShippingRates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) QuoteShipping(ctx context.Context, req *shipping.QuoteShippingRequest) (*shipping.QuoteShippingResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &shipping.QuoteShippingResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := sh.shippingService.QuoteShipping(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewShippingHandler(shippingService services.IShippingService) *shippingHandler {
+	return &shippingHandler{
+		shippingService: shippingService,
+	}
+}
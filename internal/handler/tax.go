@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/tax"
+)
+
+type taxHandler struct {
+	tax.UnimplementedTaxServiceServer
+
+	taxService services.ITaxService
+}
+
+func (th *taxHandler) CreateTaxRule(ctx context.Context, req *tax.CreateTaxRuleRequest) (*tax.CreateTaxRuleResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &tax.CreateTaxRuleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := th.taxService.CreateTaxRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (th *taxHandler) UpdateTaxRule(ctx context.Context, req *tax.UpdateTaxRuleRequest) (*tax.UpdateTaxRuleResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &tax.UpdateTaxRuleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := th.taxService.UpdateTaxRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (th *taxHandler) DeleteTaxRule(ctx context.Context, req *tax.DeleteTaxRuleRequest) (*tax.DeleteTaxRuleResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &tax.DeleteTaxRuleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := th.taxService.DeleteTaxRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (th *taxHandler) ListTaxRules(ctx context.Context, req *tax.ListTaxRulesRequest) (*tax.ListTaxRulesResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &tax.ListTaxRulesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := th.taxService.ListTaxRules(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewTaxHandler(taxService services.ITaxService) *taxHandler {
+	return &taxHandler{
+		taxService: taxService,
+	}
+}
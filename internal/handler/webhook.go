@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"crypto/subtle"
 	"log"
 	"net/http"
 
@@ -11,6 +12,17 @@ import (
 
 type webhookHandler struct {
 	webhookService services.IWebhookService
+	callbackToken  string
+}
+
+// VerifyCallbackToken rejects requests that do not carry the callback
+// verification token set in the Xendit dashboard, before the body is read.
+func (wh *webhookHandler) VerifyCallbackToken(c *fiber.Ctx) error {
+	token := c.Get("x-callback-token")
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(wh.callbackToken)) != 1 {
+		return c.SendStatus(http.StatusUnauthorized)
+	}
+	return c.Next()
 }
 
 func (wh *webhookHandler) ReceiveInvoice(c *fiber.Ctx) error {
@@ -29,8 +41,25 @@ func (wh *webhookHandler) ReceiveInvoice(c *fiber.Ctx) error {
 	return c.SendStatus(http.StatusOK)
 }
 
-func NewWebhookHandler(webhookService services.IWebhookService) *webhookHandler {
+func (wh *webhookHandler) ReceiveRefund(c *fiber.Ctx) error {
+	var req dto.XenditRefundRequest
+	err := c.BodyParser(&req)
+	if err != nil {
+		log.Println(err)
+		return c.SendStatus(http.StatusBadRequest)
+	}
+
+	err = wh.webhookService.ReceiveRefund(c.UserContext(), &req)
+	if err != nil {
+		log.Println(err)
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+	return c.SendStatus(http.StatusOK)
+}
+
+func NewWebhookHandler(webhookService services.IWebhookService, callbackToken string) *webhookHandler {
 	return &webhookHandler{
 		webhookService: webhookService,
+		callbackToken:  callbackToken,
 	}
 }
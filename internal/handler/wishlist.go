@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/services"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/wishlist"
+)
+
+type wishlistHandler struct {
+	wishlist.UnimplementedWishlistServiceServer
+
+	wishlistService services.IWishlistService
+}
+
+func (wh *wishlistHandler) AddToWishlist(ctx context.Context, req *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.AddToWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.AddToWishlist(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) RemoveFromWishlist(ctx context.Context, req *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.RemoveFromWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.RemoveFromWishlist(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) ListWishlist(ctx context.Context, req *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.ListWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.ListWishlist(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) MoveWishlistToCart(ctx context.Context, req *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.MoveWishlistToCart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) MostWishlistedProducts(ctx context.Context, req *wishlist.MostWishlistedProductsRequest) (*wishlist.MostWishlistedProductsResponse, error) {
+	validationErrors, err := utils.CheckValidation(req)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.MostWishlistedProductsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.MostWishlistedProducts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewWishlistHandler(wishlistService services.IWishlistService) *wishlistHandler {
+	return &wishlistHandler{
+		wishlistService: wishlistService,
+	}
+}
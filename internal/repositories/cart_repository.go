@@ -3,27 +3,69 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 	"gorm.io/gorm"
 )
 
+// CartOwner identifies whose cart is being accessed. Exactly one of the
+// fields is set: UserID for a logged in user, GuestCartID for a guest cart.
+type CartOwner struct {
+	UserID      uint
+	GuestCartID uint
+}
+
+func (o CartOwner) IsGuest() bool {
+	return o.GuestCartID != 0
+}
+
+// Owns reports whether the cart line belongs to the owner.
+func (o CartOwner) Owns(cart *models.Cart) bool {
+	if o.IsGuest() {
+		return cart.GuestCartID != nil && *cart.GuestCartID == o.GuestCartID
+	}
+	return cart.UserID != nil && *cart.UserID == o.UserID
+}
+
 type ICartRepository interface {
 	GetCartByProductUserID(ctx context.Context, productId uint, userId uint) (*models.Cart, error)
+	GetCartByProductOwner(ctx context.Context, productId uint, owner CartOwner) (*models.Cart, error)
 	CreateNewCart(ctx context.Context, cart *models.Cart) error
 	UpdateCart(ctx context.Context, cart *models.Cart) error
 	GetListCart(ctx context.Context, userId uint) ([]*models.Cart, error)
+	GetListCartByOwner(ctx context.Context, owner CartOwner) ([]*models.Cart, error)
 	GetCartById(ctx context.Context, cartId uint) (*models.Cart, error)
 	DeleteCart(ctx context.Context, cartId uint, deletedBy string) error
+	DeleteCartsByOwner(ctx context.Context, owner CartOwner, deletedBy string) error
+	CreateGuestCart(ctx context.Context, guestCart *models.GuestCart) error
+	GetGuestCartByToken(ctx context.Context, token string) (*models.GuestCart, error)
+	UpdateGuestCart(ctx context.Context, guestCart *models.GuestCart) error
+	BeginTransaction(ctx context.Context) (*gorm.DB, error)
+	WithTx(tx *gorm.DB) ICartRepository
+}
+
+func scopeCartOwner(owner CartOwner) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if owner.IsGuest() {
+			return db.Where("guest_cart_id = ?", owner.GuestCartID)
+		}
+		return db.Where("user_id = ?", owner.UserID)
+	}
 }
 
 func (cr *cartRepository) GetCartByProductUserID(ctx context.Context, productId uint, userId uint) (*models.Cart, error) {
+	return cr.GetCartByProductOwner(ctx, productId, CartOwner{UserID: userId})
+}
+
+func (cr *cartRepository) GetCartByProductOwner(ctx context.Context, productId uint, owner CartOwner) (*models.Cart, error) {
 	var cart models.Cart
 
 	err := cr.db.WithContext(ctx).
 		Preload("Product").
 		Preload("User").
-		Where("product_id = ? AND user_id = ?", productId, userId).
+		Scopes(scopeCartOwner(owner)).
+		Where("product_id = ?", productId).
 		Where("is_deleted = ?", false).
 		First(&cart).Error
 
@@ -54,12 +96,19 @@ func (cr *cartRepository) UpdateCart(ctx context.Context, cart *models.Cart) err
 }
 
 func (cr *cartRepository) GetListCart(ctx context.Context, userId uint) ([]*models.Cart, error) {
+	return cr.GetListCartByOwner(ctx, CartOwner{UserID: userId})
+}
+
+func (cr *cartRepository) GetListCartByOwner(ctx context.Context, owner CartOwner) ([]*models.Cart, error) {
 	var carts []*models.Cart
 
+	// Deleted products are still loaded so the line can be reported as unavailable.
 	err := cr.db.WithContext(ctx).
-		Preload("Product").
+		Preload("Product", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
 		Preload("User").
-		Where("user_id = ?", userId).
+		Scopes(scopeCartOwner(owner)).
 		Where("is_deleted = ?", false).
 		Find(&carts).Error
 
@@ -99,6 +148,59 @@ func (cr *cartRepository) DeleteCart(ctx context.Context, cartId uint, deletedBy
 		}).Error
 }
 
+func (cr *cartRepository) DeleteCartsByOwner(ctx context.Context, owner CartOwner, deletedBy string) error {
+	return cr.db.WithContext(ctx).
+		Model(&models.Cart{}).
+		Scopes(scopeCartOwner(owner)).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_by": deletedBy,
+		}).Error
+}
+
+func (cr *cartRepository) CreateGuestCart(ctx context.Context, guestCart *models.GuestCart) error {
+	return cr.db.WithContext(ctx).Create(guestCart).Error
+}
+
+func (cr *cartRepository) GetGuestCartByToken(ctx context.Context, token string) (*models.GuestCart, error) {
+	var guestCart models.GuestCart
+
+	err := cr.db.WithContext(ctx).
+		Where("token = ?", token).
+		Where("merged_at IS NULL").
+		Where("expires_at > ?", time.Now()).
+		Where("is_deleted = ?", false).
+		First(&guestCart).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &guestCart, nil
+}
+
+func (cr *cartRepository) UpdateGuestCart(ctx context.Context, guestCart *models.GuestCart) error {
+	return cr.db.WithContext(ctx).Save(guestCart).Error
+}
+
+func (cr *cartRepository) BeginTransaction(ctx context.Context) (*gorm.DB, error) {
+	tx := cr.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return tx, nil
+}
+
+func (cr *cartRepository) WithTx(tx *gorm.DB) ICartRepository {
+	return &cartRepository{
+		db: tx,
+	}
+}
+
 type cartRepository struct {
 	db *gorm.DB
 }
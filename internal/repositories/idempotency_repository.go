@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IIdempotencyRepository interface {
+	Reserve(ctx context.Context, record *models.IdempotencyKey) (*models.IdempotencyKey, error)
+	Complete(ctx context.Context, id uint, response []byte, completedAt time.Time) error
+	Release(ctx context.Context, id uint) error
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+// Reserve saves record unless the user already has a live record with the
+// same key. That record is returned instead, and nil when record was saved.
+func (ir *idempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyKey) (*models.IdempotencyKey, error) {
+	// An expired key may be used again, and so may a key whose request
+	// stopped before it completed or released it.
+	err := ir.db.WithContext(ctx).
+		Where("user_id = ? AND key = ?", record.UserID, record.Key).
+		Where("expires_at <= ? OR (completed_at IS NULL AND locked_until <= ?)", record.CreatedAt, record.CreatedAt).
+		Delete(&models.IdempotencyKey{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := ir.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "key"}},
+			DoNothing: true,
+		}).
+		Create(record)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing models.IdempotencyKey
+	err = ir.db.WithContext(ctx).
+		Where("user_id = ? AND key = ?", record.UserID, record.Key).
+		First(&existing).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("idempotency key was removed while it was being reserved")
+		}
+		return nil, err
+	}
+
+	return &existing, nil
+}
+
+func (ir *idempotencyRepository) Complete(ctx context.Context, id uint, response []byte, completedAt time.Time) error {
+	return ir.db.WithContext(ctx).
+		Model(&models.IdempotencyKey{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"response":     response,
+			"completed_at": completedAt,
+			"locked_until": nil,
+		}).Error
+}
+
+// Release removes a reservation whose request failed, so the key can be
+// retried.
+func (ir *idempotencyRepository) Release(ctx context.Context, id uint) error {
+	return ir.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.IdempotencyKey{}).Error
+}
+
+func (ir *idempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	res := ir.db.WithContext(ctx).
+		Where("expires_at <= ?", before).
+		Delete(&models.IdempotencyKey{})
+	return res.RowsAffected, res.Error
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IIdempotencyRepository {
+	return &idempotencyRepository{
+		db: db,
+	}
+}
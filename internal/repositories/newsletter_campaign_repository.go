@@ -0,0 +1,265 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var newsletterCampaignSorts = map[string]bool{
+	"created_at": true,
+}
+
+// NewsletterCampaignFilter narrows the campaign list. Zero fields do not
+// filter.
+type NewsletterCampaignFilter struct {
+	Status string
+}
+
+type INewsletterCampaignRepository interface {
+	CreateCampaign(ctx context.Context, campaign *models.NewsletterCampaign) error
+	UpdateCampaign(ctx context.Context, campaign *models.NewsletterCampaign, fromStatuses []string) (bool, error)
+	GetCampaignByID(ctx context.Context, id uint) (*models.NewsletterCampaign, error)
+	GetCampaignsPagination(ctx context.Context, filter *NewsletterCampaignFilter, pagination *common.PaginationRequest) ([]*models.NewsletterCampaign, *common.PaginationResponse, error)
+	StartDueCampaigns(ctx context.Context, now time.Time) ([]*models.NewsletterCampaign, error)
+	ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.NewsletterDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *models.NewsletterDelivery) error
+	FinishSentCampaigns(ctx context.Context, now time.Time) (int64, error)
+	CountDeliveries(ctx context.Context, campaignIDs []uint) (map[uint]map[string]int64, error)
+}
+
+type newsletterCampaignRepository struct {
+	db *gorm.DB
+}
+
+func (cr *newsletterCampaignRepository) CreateCampaign(ctx context.Context, campaign *models.NewsletterCampaign) error {
+	return cr.db.WithContext(ctx).Create(campaign).Error
+}
+
+// UpdateCampaign saves the campaign if it is still in one of fromStatuses
+// and reports whether it did. When it was started meanwhile, its start time
+// is kept.
+func (cr *newsletterCampaignRepository) UpdateCampaign(ctx context.Context, campaign *models.NewsletterCampaign, fromStatuses []string) (bool, error) {
+	result := cr.db.WithContext(ctx).
+		Model(campaign).
+		Select("*").
+		Omit("id", "started_at", "created_at", "created_by").
+		Where("status IN ?", fromStatuses).
+		Updates(campaign)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (cr *newsletterCampaignRepository) GetCampaignByID(ctx context.Context, id uint) (*models.NewsletterCampaign, error) {
+	var campaign models.NewsletterCampaign
+
+	err := cr.db.WithContext(ctx).
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		First(&campaign).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &campaign, nil
+}
+
+func (cr *newsletterCampaignRepository) GetCampaignsPagination(ctx context.Context, filter *NewsletterCampaignFilter, pagination *common.PaginationRequest) ([]*models.NewsletterCampaign, *common.PaginationResponse, error) {
+	query := cr.db.WithContext(ctx).
+		Model(&models.NewsletterCampaign{}).
+		Where("is_deleted = ?", false)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	return paginate[*models.NewsletterCampaign](query, pagination, newsletterCampaignSorts)
+}
+
+// StartDueCampaigns moves scheduled campaigns whose time has come to sending
+// and creates a pending delivery for every subscriber in their segment. The
+// recipients are fixed at this point, later subscribers are not mailed.
+func (cr *newsletterCampaignRepository) StartDueCampaigns(ctx context.Context, now time.Time) ([]*models.NewsletterCampaign, error) {
+	var campaigns []*models.NewsletterCampaign
+
+	err := cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", models.NewsletterCampaignStatusScheduled).
+			Where("scheduled_at <= ?", now).
+			Where("is_deleted = ?", false).
+			Order("scheduled_at ASC").
+			Find(&campaigns).Error
+		if err != nil {
+			return err
+		}
+
+		for _, campaign := range campaigns {
+			recipients := tx.
+				Model(&models.Newsletter{}).
+				Select("CAST(? AS bigint), id, email, CAST(? AS varchar), CAST(? AS timestamptz)", campaign.ID, models.NewsletterDeliveryStatusPending, now).
+				Where("status = ?", models.NewsletterStatusSubscribed).
+				Where("is_deleted = ?", false)
+			if campaign.Topic != "" {
+				recipients, err = whereNewsletterTopic(recipients, campaign.Topic)
+				if err != nil {
+					return err
+				}
+			}
+			if campaign.Locale != "" {
+				recipients = recipients.Where("locale = ?", campaign.Locale)
+			}
+
+			err = tx.Exec("INSERT INTO newsletter_delivery (campaign_id, newsletter_id, email, status, created_at) ? ON CONFLICT (campaign_id, newsletter_id) DO NOTHING", recipients).Error
+			if err != nil {
+				return err
+			}
+
+			campaign.Status = models.NewsletterCampaignStatusSending
+			campaign.StartedAt = &now
+			err = tx.Model(campaign).
+				Updates(map[string]interface{}{
+					"status":     campaign.Status,
+					"started_at": campaign.StartedAt,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+// ClaimPendingDeliveries returns pending deliveries of campaigns that are
+// sending, with their campaign and subscriber as they are now. Each one is
+// leased so no other worker picks it up while it is being sent.
+func (cr *newsletterCampaignRepository) ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.NewsletterDelivery, error) {
+	var deliveries []*models.NewsletterDelivery
+
+	err := cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED", Table: clause.Table{Name: "newsletter_delivery"}}).
+			Joins("JOIN newsletter_campaign ON newsletter_campaign.id = newsletter_delivery.campaign_id").
+			Where("newsletter_delivery.status = ?", models.NewsletterDeliveryStatusPending).
+			Where("(newsletter_delivery.lease_until IS NULL OR newsletter_delivery.lease_until <= ?)", now).
+			Where("newsletter_campaign.status = ?", models.NewsletterCampaignStatusSending).
+			Order("newsletter_delivery.id ASC").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(deliveries))
+		for _, d := range deliveries {
+			ids = append(ids, d.ID)
+		}
+
+		return tx.Model(&models.NewsletterDelivery{}).
+			Where("id IN ?", ids).
+			Update("lease_until", now.Add(lease)).Error
+	})
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+	}
+
+	deliveries = nil
+	err = cr.db.WithContext(ctx).
+		Preload("Campaign").
+		Preload("Newsletter").
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (cr *newsletterCampaignRepository) UpdateDelivery(ctx context.Context, delivery *models.NewsletterDelivery) error {
+	return cr.db.WithContext(ctx).Omit("Campaign", "Newsletter").Save(delivery).Error
+}
+
+// FinishSentCampaigns marks sending campaigns with no pending delivery left
+// as sent and returns how many it marked.
+func (cr *newsletterCampaignRepository) FinishSentCampaigns(ctx context.Context, now time.Time) (int64, error) {
+	pending := cr.db.
+		Model(&models.NewsletterDelivery{}).
+		Select("1").
+		Where("newsletter_delivery.campaign_id = newsletter_campaign.id").
+		Where("newsletter_delivery.status = ?", models.NewsletterDeliveryStatusPending)
+
+	result := cr.db.WithContext(ctx).
+		Model(&models.NewsletterCampaign{}).
+		Where("status = ?", models.NewsletterCampaignStatusSending).
+		Where("NOT EXISTS (?)", pending).
+		Updates(map[string]interface{}{
+			"status":      models.NewsletterCampaignStatusSent,
+			"finished_at": now,
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+// CountDeliveries returns the number of deliveries of each campaign by
+// status.
+func (cr *newsletterCampaignRepository) CountDeliveries(ctx context.Context, campaignIDs []uint) (map[uint]map[string]int64, error) {
+	counts := make(map[uint]map[string]int64)
+	if len(campaignIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CampaignID uint
+		Status     string
+		Total      int64
+	}
+
+	err := cr.db.WithContext(ctx).
+		Model(&models.NewsletterDelivery{}).
+		Select("campaign_id, status, COUNT(*) AS total").
+		Where("campaign_id IN ?", campaignIDs).
+		Group("campaign_id, status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if counts[row.CampaignID] == nil {
+			counts[row.CampaignID] = make(map[string]int64)
+		}
+		counts[row.CampaignID][row.Status] = row.Total
+	}
+
+	return counts, nil
+}
+
+func NewNewsletterCampaignRepository(db *gorm.DB) INewsletterCampaignRepository {
+	return &newsletterCampaignRepository{
+		db: db,
+	}
+}
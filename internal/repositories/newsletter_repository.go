@@ -2,14 +2,36 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var newsletterSorts = map[string]bool{
+	"created_at": true,
+	"email":      true,
+}
+
+// NewsletterFilter narrows the subscriber list. Zero fields do not filter.
+type NewsletterFilter struct {
+	Status string
+	// Topic keeps subscribers who want the topic, including those who want
+	// every topic.
+	Topic string
+	// Email matches part of the address.
+	Email string
+}
+
 type INewsletterRepository interface {
 	GetNewsletterByEmail(ctx context.Context, email string) (*models.Newsletter, error)
-	CreateNewsletter(ctx context.Context, newsletter *models.Newsletter) error
+	GetNewsletterByID(ctx context.Context, id uint) (*models.Newsletter, error)
+	GetNewsletterByConfirmationToken(ctx context.Context, tokenHash string) (*models.Newsletter, error)
+	CreateNewsletter(ctx context.Context, newsletter *models.Newsletter) (bool, error)
+	UpdateNewsletter(ctx context.Context, newsletter *models.Newsletter) error
+	GetNewslettersPagination(ctx context.Context, filter *NewsletterFilter, pagination *common.PaginationRequest) ([]*models.Newsletter, *common.PaginationResponse, error)
 }
 
 type newsletterRepository struct {
@@ -27,11 +49,76 @@ func (nr *newsletterRepository) GetNewsletterByEmail(ctx context.Context, email
 	return &newsletter, nil
 }
 
-func (nr *newsletterRepository) CreateNewsletter(ctx context.Context, newsletter *models.Newsletter) error {
-	if err := nr.db.WithContext(ctx).Create(newsletter).Error; err != nil {
-		return err
+func (nr *newsletterRepository) GetNewsletterByID(ctx context.Context, id uint) (*models.Newsletter, error) {
+	var newsletter models.Newsletter
+	if err := nr.db.WithContext(ctx).Where("id = ?", id).First(&newsletter).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &newsletter, nil
+}
+
+func (nr *newsletterRepository) GetNewsletterByConfirmationToken(ctx context.Context, tokenHash string) (*models.Newsletter, error) {
+	var newsletter models.Newsletter
+	if err := nr.db.WithContext(ctx).Where("confirmation_token_hash = ?", tokenHash).First(&newsletter).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &newsletter, nil
+}
+
+// CreateNewsletter saves a new subscriber and reports whether it did. It
+// saves nothing when the email is already subscribed, which a concurrent
+// request may have just done.
+func (nr *newsletterRepository) CreateNewsletter(ctx context.Context, newsletter *models.Newsletter) (bool, error) {
+	result := nr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "email"}},
+			DoNothing: true,
+		}).
+		Create(newsletter)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (nr *newsletterRepository) UpdateNewsletter(ctx context.Context, newsletter *models.Newsletter) error {
+	return nr.db.WithContext(ctx).Save(newsletter).Error
+}
+
+func (nr *newsletterRepository) GetNewslettersPagination(ctx context.Context, filter *NewsletterFilter, pagination *common.PaginationRequest) ([]*models.Newsletter, *common.PaginationResponse, error) {
+	query := nr.db.WithContext(ctx).Model(&models.Newsletter{})
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Topic != "" {
+		var err error
+		query, err = whereNewsletterTopic(query, filter.Topic)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if filter.Email != "" {
+		query = query.Where("email ILIKE ?", "%"+escapeLike(filter.Email)+"%")
+	}
+
+	return paginate[*models.Newsletter](query, pagination, newsletterSorts)
+}
+
+// whereNewsletterTopic keeps subscribers who want the topic, including
+// those who want every topic.
+func whereNewsletterTopic(query *gorm.DB, topic string) (*gorm.DB, error) {
+	topics, err := json.Marshal([]string{topic})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return query.Where("(COALESCE(topics, 'null'::jsonb) IN ('null'::jsonb, '[]'::jsonb) OR topics @> ?::jsonb)", string(topics)), nil
 }
 
 func NewNewsletterRepository(db *gorm.DB) INewsletterRepository {
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var notificationSorts = map[string]bool{
+	"created_at": true,
+}
+
+// NotificationFilter narrows the notification log. Zero fields do not
+// filter.
+type NotificationFilter struct {
+	UserID    uint
+	Status    string
+	EventType string
+}
+
+type INotificationRepository interface {
+	GetPreference(ctx context.Context, userID uint) (*models.NotificationPreference, error)
+	SavePreference(ctx context.Context, preference *models.NotificationPreference) error
+	CreateNotifications(ctx context.Context, notifications []*models.Notification) error
+	ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Notification, error)
+	UpdateNotification(ctx context.Context, notification *models.Notification) error
+	GetNotificationsPagination(ctx context.Context, filter *NotificationFilter, pagination *common.PaginationRequest) ([]*models.Notification, *common.PaginationResponse, error)
+	WithTx(tx *gorm.DB) INotificationRepository
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func (nr *notificationRepository) GetPreference(ctx context.Context, userID uint) (*models.NotificationPreference, error) {
+	var preference models.NotificationPreference
+
+	err := nr.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&preference).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+func (nr *notificationRepository) SavePreference(ctx context.Context, preference *models.NotificationPreference) error {
+	return nr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"locale", "muted_event_types", "updated_at"}),
+		}).
+		Create(preference).Error
+}
+
+// CreateNotifications queues the notifications. An event that already has
+// one for the channel keeps it, so an event is notified once.
+func (nr *notificationRepository) CreateNotifications(ctx context.Context, notifications []*models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return nr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "outbox_event_id"}, {Name: "channel"}},
+			DoNothing: true,
+		}).
+		Create(&notifications).Error
+}
+
+// ClaimDueNotifications returns pending and failed notifications whose next
+// attempt is due, with their event. Each one is pushed back by lease so no
+// other worker picks it up while it is being sent.
+func (nr *notificationRepository) ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Notification, error) {
+	var notifications []*models.Notification
+
+	err := nr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ?", []string{models.NotificationStatusPending, models.NotificationStatusFailed}).
+			Where("next_attempt_at <= ?", now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&notifications).Error
+		if err != nil || len(notifications) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(notifications))
+		for _, n := range notifications {
+			ids = append(ids, n.ID)
+		}
+
+		return tx.Model(&models.Notification{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil || len(notifications) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+
+	notifications = nil
+	err = nr.db.WithContext(ctx).
+		Preload("OutboxEvent").
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (nr *notificationRepository) UpdateNotification(ctx context.Context, notification *models.Notification) error {
+	return nr.db.WithContext(ctx).Omit("OutboxEvent").Save(notification).Error
+}
+
+func (nr *notificationRepository) GetNotificationsPagination(ctx context.Context, filter *NotificationFilter, pagination *common.PaginationRequest) ([]*models.Notification, *common.PaginationResponse, error) {
+	query := nr.db.WithContext(ctx).Model(&models.Notification{})
+
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EventType != "" {
+		query = query.Where("event_type = ?", filter.EventType)
+	}
+
+	return paginate[*models.Notification](query, pagination, notificationSorts)
+}
+
+func (nr *notificationRepository) WithTx(tx *gorm.DB) INotificationRepository {
+	return &notificationRepository{
+		db: tx,
+	}
+}
+
+func NewNotificationRepository(db *gorm.DB) INotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type INumberingRepository interface {
+	NextNumber(ctx context.Context, module string, period string) (int64, error)
+	WithTx(tx *gorm.DB) INumberingRepository
+}
+
+type numberingRepository struct {
+	db *gorm.DB
+}
+
+// NextNumber increments the sequence of module in period and returns the new
+// value, starting a missing sequence at 1. It is a single upsert, so the row
+// stays locked until the surrounding transaction ends and concurrent callers
+// cannot get the same number.
+func (nr *numberingRepository) NextNumber(ctx context.Context, module string, period string) (int64, error) {
+	sequence := models.NumberSequence{
+		Module: module,
+		Period: period,
+		Number: 1,
+	}
+
+	err := nr.db.WithContext(ctx).
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "module"}, {Name: "period"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"number": gorm.Expr("number_sequence.number + 1"),
+				}),
+			},
+			clause.Returning{Columns: []clause.Column{{Name: "number"}}},
+		).
+		Create(&sequence).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return sequence.Number, nil
+}
+
+func (nr *numberingRepository) WithTx(tx *gorm.DB) INumberingRepository {
+	return &numberingRepository{
+		db: tx,
+	}
+}
+
+func NewNumberingRepository(db *gorm.DB) INumberingRepository {
+	return &numberingRepository{
+		db: db,
+	}
+}
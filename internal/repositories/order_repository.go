@@ -2,21 +2,84 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrOrderStatusChanged is returned when an order is no longer in the status,
+// or refund status, a change was made from, because another request changed
+// it first.
+var ErrOrderStatusChanged = errors.New("order status changed")
+
+// orderStatusColumns are the columns a status change may set. Everything
+// else on the order is left as it is in the database.
+var orderStatusColumns = []string{
+	"order_status_code",
+	"refund_id",
+	"refund_status",
+	"refund_amount",
+	"refunded_at",
+	"xendit_paid_at",
+	"xendit_payment_method",
+	"xendit_payment_channel",
+	"updated_at",
+	"updated_by",
+}
+
+// orderRefundColumns are the columns a refund update may set. The status is
+// not one of them.
+var orderRefundColumns = []string{
+	"refund_id",
+	"refund_status",
+	"refund_amount",
+	"refunded_at",
+	"xendit_paid_at",
+	"xendit_payment_method",
+	"xendit_payment_channel",
+	"updated_at",
+	"updated_by",
+}
+
+// OrderFilter narrows an order list. Zero fields do not filter.
+type OrderFilter struct {
+	StatusCodes []string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// TotalCurrencyCode is the currency of MinTotal and MaxTotal.
+	TotalCurrencyCode string
+	MinTotal          *money.Amount
+	MaxTotal          *money.Amount
+	// Customer matches part of the customer's name or email.
+	Customer      string
+	NumberPrefix  string
+	PaymentMethod string
+}
+
 type IOrderRepository interface {
-	GetNumbering(ctx context.Context, module string) (*models.Numbering, error)
 	CreateOrder(ctx context.Context, order *models.Order) error
 	UpdateOrder(ctx context.Context, order *models.Order) error
-	UpdateNumbering(ctx context.Context, numbering *models.Numbering) error
 	CreateOrderItem(ctx context.Context, orderItem *models.OrderItem) error
+	CreateOrderDiscount(ctx context.Context, orderDiscount *models.OrderDiscount) error
 	GetOrderByID(ctx context.Context, id uint) (*models.Order, error)
-	GetListOrderAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error)
-	GetListOrder(ctx context.Context, userID uint, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error)
+	GetOrderByRefundID(ctx context.Context, refundID string) (*models.Order, error)
+	UpdateOrderStatus(ctx context.Context, order *models.Order, history *models.OrderStatusHistory, events ...*models.OutboxEvent) error
+	UpdateOrderRefund(ctx context.Context, order *models.Order, fromRefundStatus string) error
+	LockOrderStatus(ctx context.Context, orderID uint) (string, error)
+	CreateOrderStatusHistory(ctx context.Context, history *models.OrderStatusHistory) error
+	GetOrderStatusHistory(ctx context.Context, orderID uint) ([]*models.OrderStatusHistory, error)
+	CreateShipment(ctx context.Context, shipment *models.Shipment) error
+	UpdateShipment(ctx context.Context, shipment *models.Shipment) error
+	GetShipmentsInTransit(ctx context.Context) ([]*models.Shipment, error)
+	GetExpiredUnpaidOrders(ctx context.Context, before time.Time) ([]*models.Order, error)
+	GetListOrderAdmin(ctx context.Context, filter *OrderFilter, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error)
+	GetListOrder(ctx context.Context, userID uint, filter *OrderFilter, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error)
 	BeginTransaction(ctx context.Context) (*gorm.DB, error)
 	WithTx(tx *gorm.DB) IOrderRepository
 }
@@ -25,19 +88,6 @@ type orderRepository struct {
 	db *gorm.DB
 }
 
-func (or *orderRepository) GetNumbering(ctx context.Context, module string) (*models.Numbering, error) {
-	var numbering models.Numbering
-
-	err := or.db.WithContext(ctx).
-		Where("module = ?", module).
-		First(&numbering).Error
-	if err != nil {
-		return nil, err
-	}
-
-	return &numbering, nil
-}
-
 func (or *orderRepository) CreateOrder(ctx context.Context, order *models.Order) error {
 	return or.db.WithContext(ctx).Create(order).Error
 }
@@ -46,19 +96,31 @@ func (or *orderRepository) UpdateOrder(ctx context.Context, order *models.Order)
 	return or.db.WithContext(ctx).Save(order).Error
 }
 
-func (or *orderRepository) UpdateNumbering(ctx context.Context, numbering *models.Numbering) error {
-	return or.db.WithContext(ctx).Save(numbering).Error
-}
-
 func (or *orderRepository) CreateOrderItem(ctx context.Context, orderItem *models.OrderItem) error {
 	return or.db.WithContext(ctx).Create(orderItem).Error
 }
 
+func (or *orderRepository) CreateOrderDiscount(ctx context.Context, orderDiscount *models.OrderDiscount) error {
+	return or.db.WithContext(ctx).Create(orderDiscount).Error
+}
+
 func (or *orderRepository) GetOrderByID(ctx context.Context, id uint) (*models.Order, error) {
 	var order models.Order
 
 	err := or.db.WithContext(ctx).
 		Preload("Items").
+		Preload("Discounts").
+		Preload("Shipments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("shipped_at ASC")
+		}).
+		Preload("Shipments.Items").
+		Preload("StatusHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("Returns", func(db *gorm.DB) *gorm.DB {
+			return db.Where("is_deleted = ?", false).Order("id ASC")
+		}).
+		Preload("Returns.Items").
 		Where("id = ?", id).
 		Where("is_deleted = ?", false).
 		First(&order).Error
@@ -69,146 +131,234 @@ func (or *orderRepository) GetOrderByID(ctx context.Context, id uint) (*models.O
 	return &order, nil
 }
 
-func (or *orderRepository) BeginTransaction(ctx context.Context) (*gorm.DB, error) {
-	tx := or.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return nil, tx.Error
-	}
-	return tx, nil
+// UpdateOrderStatus saves the status change in one transaction, together
+// with its history entry and the outbox events describing it. It returns
+// ErrOrderStatusChanged, writing nothing, when the order is no longer in
+// history.FromStatusCode.
+func (or *orderRepository) UpdateOrderStatus(ctx context.Context, order *models.Order, history *models.OrderStatusHistory, events ...*models.OutboxEvent) error {
+	return or.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(order).
+			Select(orderStatusColumns).
+			Where("order_status_code = ?", history.FromStatusCode).
+			Updates(order)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrOrderStatusChanged
+		}
+
+		err := tx.Create(history).Error
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			err = tx.Create(event).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
-func (or *orderRepository) WithTx(tx *gorm.DB) IOrderRepository {
-	return &orderRepository{
-		db: tx,
+// UpdateOrderRefund saves the refund of the order, and the payment it
+// refunds, without a status history entry. It returns ErrOrderStatusChanged,
+// writing nothing, when the refund is no longer in fromRefundStatus.
+func (or *orderRepository) UpdateOrderRefund(ctx context.Context, order *models.Order, fromRefundStatus string) error {
+	res := or.db.WithContext(ctx).
+		Model(order).
+		Select(orderRefundColumns).
+		Where("COALESCE(refund_status, '') = ?", fromRefundStatus).
+		Updates(order)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrOrderStatusChanged
 	}
+
+	return nil
 }
 
-func (or *orderRepository) GetListOrderAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error) {
-	var orders []*models.Order
-	var totalItems int64
+// LockOrderStatus returns the order's current status and keeps the order
+// locked until the transaction ends, so its status cannot change meanwhile.
+func (or *orderRepository) LockOrderStatus(ctx context.Context, orderID uint) (string, error) {
+	var order models.Order
 
-	if pagination == nil {
-		pagination = &common.PaginationRequest{
-			CurrentPage: 1,
-			PerPage:     10,
-		}
+	err := or.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "order_status_code").
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		return "", err
 	}
 
-	page := pagination.CurrentPage
-	if page < 1 {
-		page = 1
-	}
+	return order.OrderStatusCode, nil
+}
 
-	perPage := pagination.PerPage
-	if perPage < 1 {
-		perPage = 10
-	}
-	if perPage > 100 {
-		perPage = 100
-	}
+func (or *orderRepository) CreateOrderStatusHistory(ctx context.Context, history *models.OrderStatusHistory) error {
+	return or.db.WithContext(ctx).Create(history).Error
+}
 
-	query := or.db.WithContext(ctx).
-		Model(&models.Order{}).
-		Where("is_deleted = ?", false)
+func (or *orderRepository) GetOrderStatusHistory(ctx context.Context, orderID uint) ([]*models.OrderStatusHistory, error) {
+	var history []*models.OrderStatusHistory
 
-	// Count total items
-	err := query.Count(&totalItems).Error
+	err := or.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Where("is_deleted = ?", false).
+		Order("id ASC").
+		Find(&history).Error
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	offset := (page - 1) * perPage
+	return history, nil
+}
 
-	// Get orders with order items preloaded
-	err = or.db.WithContext(ctx).
-		Preload("Items").
+// CreateShipment saves the shipment together with its items.
+func (or *orderRepository) CreateShipment(ctx context.Context, shipment *models.Shipment) error {
+	return or.db.WithContext(ctx).Create(shipment).Error
+}
+
+func (or *orderRepository) UpdateShipment(ctx context.Context, shipment *models.Shipment) error {
+	return or.db.WithContext(ctx).Omit("Items", "Order").Save(shipment).Error
+}
+
+func (or *orderRepository) GetShipmentsInTransit(ctx context.Context) ([]*models.Shipment, error) {
+	var shipments []*models.Shipment
+
+	err := or.db.WithContext(ctx).
+		Where("status = ?", models.ShipmentStatusInTransit).
 		Where("is_deleted = ?", false).
-		Order("created_at DESC").
-		Limit(int(perPage)).
-		Offset(int(offset)).
-		Find(&orders).Error
+		Order("shipped_at ASC").
+		Find(&shipments).Error
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	totalPages := int32(totalItems) / perPage
-	if int32(totalItems)%perPage > 0 {
-		totalPages++
-	}
+	return shipments, nil
+}
+
+func (or *orderRepository) GetExpiredUnpaidOrders(ctx context.Context, before time.Time) ([]*models.Order, error) {
+	var orders []*models.Order
 
-	paginationResponse := &common.PaginationResponse{
-		CurrentPage:    page,
-		TotalPageCount: totalPages,
-		PerPage:        perPage,
-		TotalItemCount: int32(totalItems),
+	err := or.db.WithContext(ctx).
+		Preload("Items").
+		Where("order_status_code = ?", models.OrderStatusCodeUnpaid).
+		Where("expired_at < ?", before).
+		Where("is_deleted = ?", false).
+		Order("expired_at ASC").
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
 	}
 
-	return orders, paginationResponse, nil
+	return orders, nil
 }
 
-func (or *orderRepository) GetListOrder(ctx context.Context, userID uint, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error) {
-	var orders []*models.Order
-	var totalItems int64
+func (or *orderRepository) GetOrderByRefundID(ctx context.Context, refundID string) (*models.Order, error) {
+	var order models.Order
 
-	if pagination == nil {
-		pagination = &common.PaginationRequest{
-			CurrentPage: 1,
-			PerPage:     10,
+	err := or.db.WithContext(ctx).
+		Where("refund_id = ?", refundID).
+		Where("is_deleted = ?", false).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
 		}
+		return nil, err
 	}
 
-	page := pagination.CurrentPage
-	if page < 1 {
-		page = 1
-	}
+	return &order, nil
+}
 
-	perPage := pagination.PerPage
-	if perPage < 1 {
-		perPage = 10
+func (or *orderRepository) BeginTransaction(ctx context.Context) (*gorm.DB, error) {
+	tx := or.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
 	}
-	if perPage > 100 {
-		perPage = 100
+	return tx, nil
+}
+
+func (or *orderRepository) WithTx(tx *gorm.DB) IOrderRepository {
+	return &orderRepository{
+		db: tx,
 	}
+}
 
-	query := or.db.WithContext(ctx).
-		Model(&models.Order{}).
-		Where("user_id = ?", userID).
-		Where("is_deleted = ?", false)
+func (or *orderRepository) GetListOrderAdmin(ctx context.Context, filter *OrderFilter, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error) {
+	return or.getOrdersPagination(ctx, or.db.WithContext(ctx), filter, pagination)
+}
 
-	// Count total items
-	err := query.Count(&totalItems).Error
-	if err != nil {
-		return nil, nil, err
-	}
+func (or *orderRepository) GetListOrder(ctx context.Context, userID uint, filter *OrderFilter, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error) {
+	return or.getOrdersPagination(ctx, or.db.WithContext(ctx).Where("user_id = ?", userID), filter, pagination)
+}
 
-	offset := (page - 1) * perPage
+func (or *orderRepository) getOrdersPagination(ctx context.Context, scope *gorm.DB, filter *OrderFilter, pagination *common.PaginationRequest) ([]*models.Order, *common.PaginationResponse, error) {
+	query := applyOrderFilter(scope.Model(&models.Order{}).Where("is_deleted = ?", false), filter)
 
-	// Get orders with order items preloaded
-	err = or.db.WithContext(ctx).
-		Preload("Items").
-		Where("user_id = ?", userID).
-		Where("is_deleted = ?", false).
-		Order("created_at DESC").
-		Limit(int(perPage)).
-		Offset(int(offset)).
-		Find(&orders).Error
-	if err != nil {
-		return nil, nil, err
+	allowedSorts := map[string]bool{
+		"created_at":        true,
+		"updated_at":        true,
+		"number":            true,
+		"order_status_code": true,
+		"total":             true,
+		"user_full_name":    true,
 	}
 
-	totalPages := int32(totalItems) / perPage
-	if int32(totalItems)%perPage > 0 {
-		totalPages++
+	return paginate[*models.Order](query, pagination, allowedSorts, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Items")
+	})
+}
+
+func applyOrderFilter(query *gorm.DB, filter *OrderFilter) *gorm.DB {
+	if filter == nil {
+		return query
 	}
 
-	paginationResponse := &common.PaginationResponse{
-		CurrentPage:    page,
-		TotalPageCount: totalPages,
-		PerPage:        perPage,
-		TotalItemCount: int32(totalItems),
+	if len(filter.StatusCodes) > 0 {
+		query = query.Where("order_status_code IN ?", filter.StatusCodes)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+	if filter.TotalCurrencyCode != "" {
+		query = query.Where("currency_code = ?", filter.TotalCurrencyCode)
+	}
+	if filter.MinTotal != nil {
+		query = query.Where("total >= ?", *filter.MinTotal)
 	}
+	if filter.MaxTotal != nil {
+		query = query.Where("total <= ?", *filter.MaxTotal)
+	}
+	if filter.Customer != "" {
+		pattern := "%" + escapeLike(filter.Customer) + "%"
+		query = query.Where(
+			`(user_full_name ILIKE ? OR user_id IN (SELECT id FROM "user" WHERE email ILIKE ?))`,
+			pattern, pattern,
+		)
+	}
+	if filter.NumberPrefix != "" {
+		query = query.Where("number ILIKE ?", escapeLike(filter.NumberPrefix)+"%")
+	}
+	if filter.PaymentMethod != "" {
+		query = query.Where("LOWER(xendit_payment_method) = LOWER(?)", filter.PaymentMethod)
+	}
+
+	return query
+}
 
-	return orders, paginationResponse, nil
+// escapeLike makes user input match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
 func NewOrderRepository(db *gorm.DB) IOrderRepository {
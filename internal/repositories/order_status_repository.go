@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+)
+
+type IOrderStatusRepository interface {
+	GetOrderStatuses(ctx context.Context) ([]*models.OrderStatus, error)
+	GetTransitions(ctx context.Context) ([]*models.OrderStatusTransition, error)
+	GetTransition(ctx context.Context, fromStatusCode string, toStatusCode string) (*models.OrderStatusTransition, error)
+}
+
+type orderStatusRepository struct {
+	db *gorm.DB
+}
+
+func (osr *orderStatusRepository) GetOrderStatuses(ctx context.Context) ([]*models.OrderStatus, error) {
+	var statuses []*models.OrderStatus
+
+	err := osr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Order("id ASC").
+		Find(&statuses).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+func (osr *orderStatusRepository) GetTransitions(ctx context.Context) ([]*models.OrderStatusTransition, error) {
+	var transitions []*models.OrderStatusTransition
+
+	err := osr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Order("id ASC").
+		Find(&transitions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+func (osr *orderStatusRepository) GetTransition(ctx context.Context, fromStatusCode string, toStatusCode string) (*models.OrderStatusTransition, error) {
+	var transition models.OrderStatusTransition
+
+	err := osr.db.WithContext(ctx).
+		Where("from_status_code = ?", fromStatusCode).
+		Where("to_status_code = ?", toStatusCode).
+		Where("is_deleted = ?", false).
+		First(&transition).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &transition, nil
+}
+
+func NewOrderStatusRepository(db *gorm.DB) IOrderStatusRepository {
+	return &orderStatusRepository{
+		db: db,
+	}
+}
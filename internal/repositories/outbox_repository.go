@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IOutboxRepository interface {
+	CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error
+	GetUndispatchedEvents(ctx context.Context, limit int) ([]*models.OutboxEvent, error)
+	MarkEventsDispatched(ctx context.Context, ids []uint, dispatchedAt time.Time) error
+	BeginTransaction(ctx context.Context) (*gorm.DB, error)
+	WithTx(tx *gorm.DB) IOutboxRepository
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func (or *outboxRepository) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	return or.db.WithContext(ctx).Create(event).Error
+}
+
+// GetUndispatchedEvents returns the oldest events not dispatched yet and
+// locks them, skipping events another dispatcher holds. Call it in a
+// transaction.
+func (or *outboxRepository) GetUndispatchedEvents(ctx context.Context, limit int) ([]*models.OutboxEvent, error) {
+	var events []*models.OutboxEvent
+
+	err := or.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("dispatched_at IS NULL").
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (or *outboxRepository) MarkEventsDispatched(ctx context.Context, ids []uint, dispatchedAt time.Time) error {
+	return or.db.WithContext(ctx).
+		Model(&models.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Update("dispatched_at", dispatchedAt).Error
+}
+
+func (or *outboxRepository) BeginTransaction(ctx context.Context) (*gorm.DB, error) {
+	tx := or.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return tx, nil
+}
+
+func (or *outboxRepository) WithTx(tx *gorm.DB) IOutboxRepository {
+	return &outboxRepository{
+		db: tx,
+	}
+}
+
+func NewOutboxRepository(db *gorm.DB) IOutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ErrInvalidCursor is returned for a cursor that cannot be decoded or was
+// issued for a different sort.
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+const (
+	defaultPerPage   = 10
+	maxPerPage       = 100
+	defaultSortField = "created_at"
+)
+
+// pageCursor is the position after the last row of a page. It is handed to
+// clients as an opaque token.
+type pageCursor struct {
+	Field     string          `json:"f"`
+	Direction string          `json:"d"`
+	Value     json.RawMessage `json:"v"`
+	ID        uint            `json:"id"`
+}
+
+// paginate runs query one page at a time. allowedSorts lists the columns the
+// client may sort by; any other sort falls back to created_at DESC, and the
+// id column breaks ties. A page is picked by the request's cursor when set,
+// otherwise by its page number. scopes are only applied to the page query,
+// not the count, so they are the place for Preload.
+func paginate[T any](query *gorm.DB, pagination *common.PaginationRequest, allowedSorts map[string]bool, scopes ...func(*gorm.DB) *gorm.DB) ([]T, *common.PaginationResponse, error) {
+	var items []T
+	var totalItems int64
+
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     defaultPerPage,
+		}
+	}
+
+	page := pagination.CurrentPage
+	if page < 1 {
+		page = 1
+	}
+
+	perPage := pagination.PerPage
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	sortField := defaultSortField
+	sortDirection := "DESC"
+	if pagination.Sort != nil && pagination.Sort.Field != "" && allowedSorts[pagination.Sort.Field] {
+		sortField = pagination.Sort.Field
+		if strings.EqualFold(pagination.Sort.Direction, "asc") {
+			sortDirection = "ASC"
+		}
+	}
+
+	stmt := &gorm.Statement{DB: query, Context: query.Statement.Context}
+	err := stmt.Parse(new(T))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sortColumn := stmt.Schema.LookUpField(sortField)
+	idColumn := stmt.Schema.PrioritizedPrimaryField
+	if sortColumn == nil || idColumn == nil {
+		return nil, nil, fmt.Errorf("cannot paginate %s by %s", stmt.Schema.Table, sortField)
+	}
+
+	// NULLs have no place in a keyset, so nullable sorts only page by number.
+	keyset := sortColumn.FieldType.Kind() != reflect.Ptr
+
+	if !pagination.SkipTotalCount {
+		err = query.Session(&gorm.Session{}).Count(&totalItems).Error
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pageQuery := query.Session(&gorm.Session{}).Scopes(scopes...)
+
+	if pagination.Cursor != "" {
+		if !keyset {
+			return nil, nil, fmt.Errorf("%w: cannot page by cursor when sorting by %s", ErrInvalidCursor, sortField)
+		}
+
+		value, id, err := decodeCursor(pagination.Cursor, sortColumn, sortDirection)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		comparison := "<"
+		if sortDirection == "ASC" {
+			comparison = ">"
+		}
+		pageQuery = pageQuery.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn.DBName, idColumn.DBName, comparison), value, id)
+
+		// Pages reached by cursor have no number.
+		page = 0
+	} else {
+		pageQuery = pageQuery.Offset(int((page - 1) * perPage))
+	}
+
+	// One extra row tells whether there is a next page.
+	err = pageQuery.
+		Order(fmt.Sprintf("%s %s, %s %s", sortColumn.DBName, sortDirection, idColumn.DBName, sortDirection)).
+		Limit(int(perPage) + 1).
+		Find(&items).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextCursor := ""
+	if len(items) > int(perPage) {
+		items = items[:perPage]
+
+		if keyset {
+			nextCursor, err = encodeCursor(stmt, items[len(items)-1], sortColumn, idColumn, sortDirection)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    page,
+		PerPage:        perPage,
+		TotalItemCount: totalItems,
+		NextCursor:     nextCursor,
+	}
+
+	if !pagination.SkipTotalCount {
+		totalPages := totalItems / int64(perPage)
+		if totalItems%int64(perPage) > 0 {
+			totalPages++
+		}
+		paginationResponse.TotalPageCount = int32(totalPages)
+	}
+
+	return items, paginationResponse, nil
+}
+
+func encodeCursor(stmt *gorm.Statement, item interface{}, sortColumn *schema.Field, idColumn *schema.Field, sortDirection string) (string, error) {
+	row := reflect.Indirect(reflect.ValueOf(item))
+
+	value, _ := sortColumn.ValueOf(stmt.Context, row)
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	id, _ := idColumn.ValueOf(stmt.Context, row)
+	idValue, ok := id.(uint)
+	if !ok {
+		return "", fmt.Errorf("cannot page %s by its primary key", stmt.Schema.Table)
+	}
+
+	data, err := json.Marshal(pageCursor{
+		Field:     sortColumn.DBName,
+		Direction: sortDirection,
+		Value:     rawValue,
+		ID:        idValue,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor returns the sort value and id stored in the cursor. The value
+// is decoded into the column's own type so it binds like any other value of
+// that column.
+func decodeCursor(token string, sortColumn *schema.Field, sortDirection string) (interface{}, uint, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	var cursor pageCursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	if cursor.Field != sortColumn.DBName || cursor.Direction != sortDirection {
+		return nil, 0, fmt.Errorf("%w: it was issued for a different sort", ErrInvalidCursor)
+	}
+
+	value := reflect.New(sortColumn.FieldType)
+	err = json.Unmarshal(cursor.Value, value.Interface())
+	if err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	return value.Elem().Interface(), cursor.ID, nil
+}
@@ -0,0 +1,253 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+type paginationTestRow struct {
+	ID        uint `gorm:"primaryKey"`
+	Name      string
+	CreatedAt time.Time
+	ShippedAt *time.Time
+}
+
+var paginationTestSorts = map[string]bool{
+	"name":       true,
+	"created_at": true,
+	"shipped_at": true,
+}
+
+// newPaginationTestDB opens a dry run session, so queries are built but never
+// sent. The SQL of every page query, with its values filled in, is appended
+// to the returned slice.
+func newPaginationTestDB(t *testing.T) (*gorm.DB, *[]string) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		DSN:                  "host=localhost",
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run database: %v", err)
+	}
+
+	var queries []string
+	err = db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	if err != nil {
+		t.Fatalf("failed to register query callback: %v", err)
+	}
+
+	return db, &queries
+}
+
+func parsePaginationTestRow(t *testing.T, db *gorm.DB) *gorm.Statement {
+	t.Helper()
+
+	stmt := &gorm.Statement{DB: db, Context: context.Background()}
+	err := stmt.Parse(&paginationTestRow{})
+	if err != nil {
+		t.Fatalf("failed to parse test model: %v", err)
+	}
+	return stmt
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	db, _ := newPaginationTestDB(t)
+	stmt := parsePaginationTestRow(t, db)
+
+	row := paginationTestRow{
+		ID:        42,
+		Name:      "Keyboard",
+		CreatedAt: time.Date(2024, 5, 17, 8, 30, 15, 123456000, time.UTC),
+	}
+
+	tests := []struct {
+		field     string
+		direction string
+		want      interface{}
+	}{
+		{field: "created_at", direction: "DESC", want: row.CreatedAt},
+		{field: "name", direction: "ASC", want: row.Name},
+	}
+
+	for _, tt := range tests {
+		sortColumn := stmt.Schema.LookUpField(tt.field)
+
+		token, err := encodeCursor(stmt, row, sortColumn, stmt.Schema.PrioritizedPrimaryField, tt.direction)
+		if err != nil {
+			t.Fatalf("encodeCursor(%s %s) returned error: %v", tt.field, tt.direction, err)
+		}
+
+		value, id, err := decodeCursor(token, sortColumn, tt.direction)
+		if err != nil {
+			t.Fatalf("decodeCursor(%s %s) returned error: %v", tt.field, tt.direction, err)
+		}
+
+		if id != row.ID {
+			t.Errorf("decodeCursor(%s %s) id = %d, want %d", tt.field, tt.direction, id, row.ID)
+		}
+
+		if want, ok := tt.want.(time.Time); ok {
+			got, ok := value.(time.Time)
+			if !ok || !got.Equal(want) {
+				t.Errorf("decodeCursor(%s %s) value = %v, want %v", tt.field, tt.direction, value, want)
+			}
+			continue
+		}
+		if value != tt.want {
+			t.Errorf("decodeCursor(%s %s) value = %v, want %v", tt.field, tt.direction, value, tt.want)
+		}
+	}
+}
+
+func TestDecodeCursorRejectsOtherSort(t *testing.T) {
+	db, _ := newPaginationTestDB(t)
+	stmt := parsePaginationTestRow(t, db)
+
+	createdAt := stmt.Schema.LookUpField("created_at")
+	name := stmt.Schema.LookUpField("name")
+
+	token, err := encodeCursor(stmt, &paginationTestRow{ID: 7, CreatedAt: time.Now()}, createdAt, stmt.Schema.PrioritizedPrimaryField, "DESC")
+	if err != nil {
+		t.Fatalf("encodeCursor returned error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		sortColumn *schema.Field
+		direction  string
+	}{
+		{name: "other field", token: token, sortColumn: name, direction: "DESC"},
+		{name: "other direction", token: token, sortColumn: createdAt, direction: "ASC"},
+		{name: "not base64", token: "not a cursor!", sortColumn: createdAt, direction: "DESC"},
+		{name: "not json", token: "bm90IGpzb24", sortColumn: createdAt, direction: "DESC"},
+	}
+
+	for _, tt := range tests {
+		_, _, err := decodeCursor(tt.token, tt.sortColumn, tt.direction)
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: decodeCursor error = %v, want ErrInvalidCursor", tt.name, err)
+		}
+	}
+}
+
+func TestPaginateByCursor(t *testing.T) {
+	db, queries := newPaginationTestDB(t)
+	stmt := parsePaginationTestRow(t, db)
+
+	token, err := encodeCursor(stmt, &paginationTestRow{ID: 7, Name: "Mouse"}, stmt.Schema.LookUpField("name"), stmt.Schema.PrioritizedPrimaryField, "ASC")
+	if err != nil {
+		t.Fatalf("encodeCursor returned error: %v", err)
+	}
+
+	_, response, err := paginate[paginationTestRow](db.Model(&paginationTestRow{}), &common.PaginationRequest{
+		PerPage:        5,
+		Sort:           &common.PaginationSortRequest{Field: "name", Direction: "asc"},
+		Cursor:         token,
+		SkipTotalCount: true,
+	}, paginationTestSorts)
+	if err != nil {
+		t.Fatalf("paginate returned error: %v", err)
+	}
+
+	if response.CurrentPage != 0 {
+		t.Errorf("CurrentPage = %d, want 0 for a page reached by cursor", response.CurrentPage)
+	}
+
+	query := lastQuery(t, *queries)
+	for _, want := range []string{"(name, id) > ('Mouse', 7)", "ORDER BY name ASC, id ASC", "LIMIT 6"} {
+		if !strings.Contains(query, want) {
+			t.Errorf("page query %q does not contain %q", query, want)
+		}
+	}
+	if strings.Contains(query, "OFFSET") {
+		t.Errorf("page query %q uses an offset", query)
+	}
+}
+
+func TestPaginateNullableSortFallsBackToPages(t *testing.T) {
+	db, queries := newPaginationTestDB(t)
+
+	sort := &common.PaginationSortRequest{Field: "shipped_at", Direction: "desc"}
+
+	_, _, err := paginate[paginationTestRow](db.Model(&paginationTestRow{}), &common.PaginationRequest{
+		PerPage:        10,
+		Sort:           sort,
+		Cursor:         "anything",
+		SkipTotalCount: true,
+	}, paginationTestSorts)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("paginate with a cursor on a nullable sort: error = %v, want ErrInvalidCursor", err)
+	}
+
+	_, response, err := paginate[paginationTestRow](db.Model(&paginationTestRow{}), &common.PaginationRequest{
+		CurrentPage:    3,
+		PerPage:        10,
+		Sort:           sort,
+		SkipTotalCount: true,
+	}, paginationTestSorts)
+	if err != nil {
+		t.Fatalf("paginate returned error: %v", err)
+	}
+
+	if response.CurrentPage != 3 {
+		t.Errorf("CurrentPage = %d, want 3", response.CurrentPage)
+	}
+	if response.NextCursor != "" {
+		t.Errorf("NextCursor = %q, want none for a nullable sort", response.NextCursor)
+	}
+
+	query := lastQuery(t, *queries)
+	for _, want := range []string{"ORDER BY shipped_at DESC, id DESC", "LIMIT 11", "OFFSET 20"} {
+		if !strings.Contains(query, want) {
+			t.Errorf("page query %q does not contain %q", query, want)
+		}
+	}
+}
+
+func TestPaginateUnknownSortUsesDefault(t *testing.T) {
+	db, queries := newPaginationTestDB(t)
+
+	_, _, err := paginate[paginationTestRow](db.Model(&paginationTestRow{}), &common.PaginationRequest{
+		PerPage:        500,
+		Sort:           &common.PaginationSortRequest{Field: "password", Direction: "asc"},
+		SkipTotalCount: true,
+	}, paginationTestSorts)
+	if err != nil {
+		t.Fatalf("paginate returned error: %v", err)
+	}
+
+	query := lastQuery(t, *queries)
+	for _, want := range []string{"ORDER BY created_at DESC, id DESC", "LIMIT 101"} {
+		if !strings.Contains(query, want) {
+			t.Errorf("page query %q does not contain %q", query, want)
+		}
+	}
+}
+
+func lastQuery(t *testing.T, queries []string) string {
+	t.Helper()
+
+	if len(queries) == 0 {
+		t.Fatal("no query was built")
+	}
+	return queries[len(queries)-1]
+}
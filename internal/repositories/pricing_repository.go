@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IPricingRepository interface {
+	GetProductPrices(ctx context.Context, productIDs []uint, currencyCode string) ([]*models.ProductPrice, error)
+	GetPricesByProductID(ctx context.Context, productID uint) ([]*models.ProductPrice, error)
+	UpsertProductPrice(ctx context.Context, price *models.ProductPrice) error
+	DeleteProductPrice(ctx context.Context, productID uint, currencyCode string, deletedBy string) error
+	DeriveProductPrices(ctx context.Context, currencyCode string, rate float64, overwrite bool, createdBy string) (int64, error)
+	RefreshDerivedPrices(ctx context.Context, productID uint, currencyCode string, updatedBy string) (int64, error)
+	GetExchangeRate(ctx context.Context, currencyCode string) (*models.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context) ([]*models.ExchangeRate, error)
+	UpsertExchangeRate(ctx context.Context, rate *models.ExchangeRate) error
+	WithTx(tx *gorm.DB) IPricingRepository
+}
+
+type pricingRepository struct {
+	db *gorm.DB
+}
+
+func (pr *pricingRepository) GetProductPrices(ctx context.Context, productIDs []uint, currencyCode string) ([]*models.ProductPrice, error) {
+	var prices []*models.ProductPrice
+
+	err := pr.db.WithContext(ctx).
+		Where("product_id IN ?", productIDs).
+		Where("currency_code = ?", currencyCode).
+		Where("is_deleted = ?", false).
+		Find(&prices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+func (pr *pricingRepository) GetPricesByProductID(ctx context.Context, productID uint) ([]*models.ProductPrice, error) {
+	var prices []*models.ProductPrice
+
+	err := pr.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Where("is_deleted = ?", false).
+		Order("currency_code ASC").
+		Find(&prices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// UpsertProductPrice creates the price or replaces the existing one for the
+// same product and currency, reviving it if it was deleted.
+func (pr *pricingRepository) UpsertProductPrice(ctx context.Context, price *models.ProductPrice) error {
+	return pr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "product_id"}, {Name: "currency_code"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"price":      price.Price,
+				"is_derived": price.IsDerived,
+				"is_deleted": false,
+				"deleted_at": nil,
+				"deleted_by": nil,
+				"updated_at": time.Now(),
+				"updated_by": price.CreatedBy,
+			}),
+		}).
+		Create(price).Error
+}
+
+func (pr *pricingRepository) DeleteProductPrice(ctx context.Context, productID uint, currencyCode string, deletedBy string) error {
+	return pr.db.WithContext(ctx).
+		Model(&models.ProductPrice{}).
+		Where("product_id = ? AND currency_code = ?", productID, currencyCode).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+		}).Error
+}
+
+// DeriveProductPrices fills in prices for every product from its store
+// currency price. Prices entered by an admin are only replaced when
+// overwrite is set. It returns the number of prices written.
+func (pr *pricingRepository) DeriveProductPrices(ctx context.Context, currencyCode string, rate float64, overwrite bool, createdBy string) (int64, error) {
+	conflict := "DO UPDATE SET price = EXCLUDED.price, is_derived = TRUE, is_deleted = FALSE, deleted_at = NULL, deleted_by = NULL, updated_at = EXCLUDED.created_at, updated_by = EXCLUDED.created_by"
+	if !overwrite {
+		conflict += " WHERE product_price.is_derived OR product_price.is_deleted"
+	}
+
+	res := pr.db.WithContext(ctx).Exec(`
+		INSERT INTO product_price (product_id, currency_code, price, is_derived, created_at, created_by, is_deleted)
+		SELECT id, ?, ROUND(price * ?, 2), TRUE, ?, ?, FALSE
+		FROM product
+		WHERE is_deleted = FALSE AND deleted_at IS NULL
+		ON CONFLICT (product_id, currency_code) `+conflict,
+		currencyCode, rate, time.Now(), createdBy)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
+// RefreshDerivedPrices recomputes derived prices from the current store
+// currency price and exchange rate, so they follow changes to either.
+// Prices entered by an admin are left alone. A zero productID or empty
+// currencyCode does not filter. It returns the number of prices written.
+func (pr *pricingRepository) RefreshDerivedPrices(ctx context.Context, productID uint, currencyCode string, updatedBy string) (int64, error) {
+	query := `
+		UPDATE product_price
+		SET price = ROUND(product.price * exchange_rate.rate, 2), updated_at = ?, updated_by = ?
+		FROM product, exchange_rate
+		WHERE product.id = product_price.product_id
+		AND exchange_rate.currency_code = product_price.currency_code
+		AND exchange_rate.is_deleted = FALSE
+		AND product_price.is_derived AND product_price.is_deleted = FALSE`
+	args := []interface{}{time.Now(), updatedBy}
+
+	if productID != 0 {
+		query += " AND product_price.product_id = ?"
+		args = append(args, productID)
+	}
+	if currencyCode != "" {
+		query += " AND product_price.currency_code = ?"
+		args = append(args, currencyCode)
+	}
+
+	res := pr.db.WithContext(ctx).Exec(query, args...)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
+func (pr *pricingRepository) GetExchangeRate(ctx context.Context, currencyCode string) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+
+	err := pr.db.WithContext(ctx).
+		Where("currency_code = ?", currencyCode).
+		Where("is_deleted = ?", false).
+		First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (pr *pricingRepository) GetExchangeRates(ctx context.Context) ([]*models.ExchangeRate, error) {
+	var rates []*models.ExchangeRate
+
+	err := pr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Order("currency_code ASC").
+		Find(&rates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (pr *pricingRepository) UpsertExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	return pr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "currency_code"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"rate":       rate.Rate,
+				"is_deleted": false,
+				"updated_at": time.Now(),
+				"updated_by": rate.CreatedBy,
+			}),
+		}).
+		Create(rate).Error
+}
+
+func (pr *pricingRepository) WithTx(tx *gorm.DB) IPricingRepository {
+	return &pricingRepository{
+		db: tx,
+	}
+}
+
+func NewPricingRepository(db *gorm.DB) IPricingRepository {
+	return &pricingRepository{
+		db: db,
+	}
+}
@@ -19,6 +19,11 @@ type IProductRepository interface {
 	GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]models.Product, *common.PaginationResponse, error)
 	GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]models.Product, *common.PaginationResponse, error)
 	GetHighlightedProducts(ctx context.Context) ([]models.Product, error)
+	ClearMaxPerOrder(ctx context.Context, id uint) error
+	ClearWeight(ctx context.Context, id uint) error
+	DecrementStock(ctx context.Context, id uint, quantity int) (bool, error)
+	IncrementStock(ctx context.Context, id uint, quantity int) error
+	WithTx(tx *gorm.DB) IProductRepository
 }
 
 type productRepository struct {
@@ -51,7 +56,7 @@ func (pr *productRepository) GetProductsByIDs(ctx context.Context, ids []string)
 	var products []*models.Product
 
 	err := pr.db.WithContext(ctx).
-		Select("id", "name", "price", "image_url").
+		Select("id", "name", "price", "image_url", "category", "stock", "max_per_order", "weight_grams").
 		Where("id IN ?", ids).
 		Where("is_deleted = ?", false).
 		Find(&products).Error
@@ -88,87 +93,17 @@ func (pr *productRepository) DeleteProduct(ctx context.Context, product *models.
 }
 
 func (pr *productRepository) GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]models.Product, *common.PaginationResponse, error) {
-	var products []models.Product
-	var totalItems int64
-
-	page := pagination.CurrentPage
-	if page < 1 {
-		page = 1
-	}
-
-	perPage := pagination.PerPage
-	if perPage < 1 {
-		perPage = 10
-	}
-	if perPage > 100 {
-		perPage = 100
-	}
-
-	err := pr.db.WithContext(ctx).
+	query := pr.db.WithContext(ctx).
 		Model(&models.Product{}).
-		Where("is_deleted = ?", false).
-		Count(&totalItems).Error
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	offset := (page - 1) * perPage
-
-	err = pr.db.WithContext(ctx).
-		Where("is_deleted = ?", false).
-		Order("created_at DESC").
-		Limit(int(perPage)).
-		Offset(int(offset)).
-		Find(&products).Error
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	totalPages := int32(totalItems) / perPage
-	if int32(totalItems)%perPage > 0 {
-		totalPages++
-	}
-
-	paginationResponse := &common.PaginationResponse{
-		CurrentPage:    page,
-		TotalPageCount: totalPages,
-		PerPage:        perPage,
-		TotalItemCount: int32(totalItems),
-	}
+		Where("is_deleted = ?", false)
 
-	return products, paginationResponse, nil
+	return paginate[models.Product](query, pagination, nil)
 }
 
 func (pr *productRepository) GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]models.Product, *common.PaginationResponse, error) {
-	var products []models.Product
-	var totalItems int64
-
-	page := pagination.CurrentPage
-	if page < 1 {
-		page = 1
-	}
-
-	perPage := pagination.PerPage
-	if perPage < 1 {
-		perPage = 10
-	}
-	if perPage > 100 {
-		perPage = 100
-	}
-
-	// ✅ FIX: Tambahkan filter is_deleted = false
-	err := pr.db.WithContext(ctx).
+	query := pr.db.WithContext(ctx).
 		Model(&models.Product{}).
-		Where("is_deleted = ?", false).
-		Count(&totalItems).Error
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	offset := (page - 1) * perPage
+		Where("is_deleted = ?", false)
 
 	allowedSorts := map[string]bool{
 		"name":        true,
@@ -178,66 +113,83 @@ func (pr *productRepository) GetProductsPaginationAdmin(ctx context.Context, pag
 		"updated_at":  true,
 	}
 
-	sortClause := "created_at DESC"
-
-	if pagination.Sort != nil {
-		sortField := pagination.Sort.Field
-		sortDirection := pagination.Sort.Direction
-
-		if sortField != "" && allowedSorts[sortField] {
-
-			if sortDirection == "asc" || sortDirection == "ASC" {
-				sortClause = sortField + " ASC"
-			} else if sortDirection == "desc" || sortDirection == "DESC" {
-				sortClause = sortField + " DESC"
-			} else {
+	return paginate[models.Product](query, pagination, allowedSorts)
+}
 
-				sortClause = sortField + " DESC"
-			}
-		}
-	}
+func (pr *productRepository) GetHighlightedProducts(ctx context.Context) ([]models.Product, error) {
+	var products []models.Product
 
-	// ✅ FIX: Tambahkan filter is_deleted = false
-	err = pr.db.WithContext(ctx).
+	err := pr.db.WithContext(ctx).
 		Where("is_deleted = ?", false).
-		Order(sortClause).
-		Limit(int(perPage)).
-		Offset(int(offset)).
+		Order("created_at DESC").
+		Limit(10).
 		Find(&products).Error
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	totalPages := int32(totalItems) / perPage
-	if int32(totalItems)%perPage > 0 {
-		totalPages++
-	}
+	return products, nil
+}
 
-	paginationResponse := &common.PaginationResponse{
-		CurrentPage:    page,
-		TotalPageCount: totalPages,
-		PerPage:        perPage,
-		TotalItemCount: int32(totalItems),
-	}
+func (pr *productRepository) ClearMaxPerOrder(ctx context.Context, id uint) error {
+	return pr.db.WithContext(ctx).
+		Model(&models.Product{}).
+		Where("id = ?", id).
+		Update("max_per_order", nil).Error
+}
 
-	return products, paginationResponse, nil
+func (pr *productRepository) ClearWeight(ctx context.Context, id uint) error {
+	return pr.db.WithContext(ctx).
+		Model(&models.Product{}).
+		Where("id = ?", id).
+		Update("weight_grams", 0).Error
 }
 
-func (pr *productRepository) GetHighlightedProducts(ctx context.Context) ([]models.Product, error) {
-	var products []models.Product
+// IncrementStock puts quantity back into a tracked stock. Untracked stock is
+// left alone. Deleted products are restocked too, so restoring them keeps
+// the count right.
+func (pr *productRepository) IncrementStock(ctx context.Context, id uint, quantity int) error {
+	return pr.db.WithContext(ctx).
+		Unscoped().
+		Model(&models.Product{}).
+		Where("id = ?", id).
+		Where("stock IS NOT NULL").
+		UpdateColumn("stock", gorm.Expr("stock + ?", quantity)).Error
+}
 
-	err := pr.db.WithContext(ctx).
-		Where("is_deleted = ?", false).
-		Order("created_at DESC").
-		Limit(10).
-		Find(&products).Error
+// DecrementStock takes quantity out of a tracked stock. It reports false when
+// the stock is tracked and too low, leaving the row untouched.
+func (pr *productRepository) DecrementStock(ctx context.Context, id uint, quantity int) (bool, error) {
+	res := pr.db.WithContext(ctx).
+		Model(&models.Product{}).
+		Where("id = ?", id).
+		Where("stock IS NOT NULL AND stock >= ?", quantity).
+		UpdateColumn("stock", gorm.Expr("stock - ?", quantity))
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected > 0 {
+		return true, nil
+	}
 
+	var untracked int64
+	err := pr.db.WithContext(ctx).
+		Model(&models.Product{}).
+		Where("id = ?", id).
+		Where("stock IS NULL").
+		Count(&untracked).Error
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return products, nil
+	return untracked > 0, nil
+}
+
+func (pr *productRepository) WithTx(tx *gorm.DB) IProductRepository {
+	return &productRepository{
+		db: tx,
+	}
 }
 
 func NewProductRepository(db *gorm.DB) IProductRepository {
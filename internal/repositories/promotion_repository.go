@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IPromotionRepository interface {
+	CreatePromotion(ctx context.Context, promotion *models.Promotion) error
+	UpdatePromotion(ctx context.Context, promotion *models.Promotion) error
+	DeletePromotion(ctx context.Context, promotion *models.Promotion) error
+	GetPromotionByID(ctx context.Context, id uint) (*models.Promotion, error)
+	GetPromotionByCode(ctx context.Context, code string) (*models.Promotion, error)
+	GetPromotionsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*models.Promotion, *common.PaginationResponse, error)
+	CountUsageByUser(ctx context.Context, promotionID uint, userID uint) (int64, error)
+	IncrementUsage(ctx context.Context, promotionID uint, userID uint) (bool, error)
+	CreatePromotionUsage(ctx context.Context, usage *models.PromotionUsage) error
+	ReleaseOrderUsage(ctx context.Context, orderID uint, releasedBy string) error
+	WithTx(tx *gorm.DB) IPromotionRepository
+}
+
+type promotionRepository struct {
+	db *gorm.DB
+}
+
+func (pr *promotionRepository) CreatePromotion(ctx context.Context, promotion *models.Promotion) error {
+	return pr.db.WithContext(ctx).Create(promotion).Error
+}
+
+// UpdatePromotion saves everything but used_count, which only checkout and
+// cancellation change.
+func (pr *promotionRepository) UpdatePromotion(ctx context.Context, promotion *models.Promotion) error {
+	return pr.db.WithContext(ctx).Omit("used_count").Save(promotion).Error
+}
+
+func (pr *promotionRepository) DeletePromotion(ctx context.Context, promotion *models.Promotion) error {
+	now := time.Now()
+
+	return pr.db.WithContext(ctx).
+		Model(&models.Promotion{}).
+		Where("id = ?", promotion.ID).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"deleted_by": promotion.DeletedBy,
+		}).Error
+}
+
+func (pr *promotionRepository) GetPromotionByID(ctx context.Context, id uint) (*models.Promotion, error) {
+	var promotion models.Promotion
+
+	err := pr.db.WithContext(ctx).
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		First(&promotion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &promotion, nil
+}
+
+func (pr *promotionRepository) GetPromotionByCode(ctx context.Context, code string) (*models.Promotion, error) {
+	var promotion models.Promotion
+
+	err := pr.db.WithContext(ctx).
+		Where("code = ?", code).
+		Where("is_deleted = ?", false).
+		First(&promotion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &promotion, nil
+}
+
+func (pr *promotionRepository) GetPromotionsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*models.Promotion, *common.PaginationResponse, error) {
+	query := pr.db.WithContext(ctx).
+		Model(&models.Promotion{}).
+		Where("is_deleted = ?", false)
+
+	return paginate[*models.Promotion](query, pagination, nil)
+}
+
+func (pr *promotionRepository) CountUsageByUser(ctx context.Context, promotionID uint, userID uint) (int64, error) {
+	var count int64
+
+	err := pr.db.WithContext(ctx).
+		Model(&models.PromotionUsage{}).
+		Where("promotion_id = ? AND user_id = ?", promotionID, userID).
+		Where("is_deleted = ?", false).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// IncrementUsage counts one more use of the promotion by the user. It
+// reports false when the global or per-user usage limit has already been
+// reached. The promotion row stays locked until the transaction ends, so
+// it has to run in one and concurrent checkouts are checked one at a time.
+func (pr *promotionRepository) IncrementUsage(ctx context.Context, promotionID uint, userID uint) (bool, error) {
+	var promotion models.Promotion
+
+	err := pr.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", promotionID).
+		First(&promotion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if promotion.UsageLimit != nil && promotion.UsedCount >= *promotion.UsageLimit {
+		return false, nil
+	}
+
+	if promotion.PerUserLimit != nil {
+		used, err := pr.CountUsageByUser(ctx, promotionID, userID)
+		if err != nil {
+			return false, err
+		}
+		if used >= int64(*promotion.PerUserLimit) {
+			return false, nil
+		}
+	}
+
+	err = pr.db.WithContext(ctx).
+		Model(&models.Promotion{}).
+		Where("id = ?", promotionID).
+		UpdateColumn("used_count", gorm.Expr("used_count + 1")).Error
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (pr *promotionRepository) CreatePromotionUsage(ctx context.Context, usage *models.PromotionUsage) error {
+	return pr.db.WithContext(ctx).Create(usage).Error
+}
+
+// ReleaseOrderUsage gives back the coupon uses of an order that will not be
+// paid for, so they count against neither the global nor the per-user
+// limit.
+func (pr *promotionRepository) ReleaseOrderUsage(ctx context.Context, orderID uint, releasedBy string) error {
+	var usages []*models.PromotionUsage
+
+	err := pr.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Where("is_deleted = ?", false).
+		Find(&usages).Error
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, usage := range usages {
+		err = pr.db.WithContext(ctx).
+			Model(&models.PromotionUsage{}).
+			Where("id = ?", usage.ID).
+			Updates(map[string]interface{}{
+				"is_deleted": true,
+				"deleted_at": now,
+				"deleted_by": releasedBy,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		err = pr.db.WithContext(ctx).
+			Model(&models.Promotion{}).
+			Where("id = ?", usage.PromotionID).
+			UpdateColumn("used_count", gorm.Expr("GREATEST(used_count - 1, 0)")).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pr *promotionRepository) WithTx(tx *gorm.DB) IPromotionRepository {
+	return &promotionRepository{
+		db: tx,
+	}
+}
+
+func NewPromotionRepository(db *gorm.DB) IPromotionRepository {
+	return &promotionRepository{
+		db: db,
+	}
+}
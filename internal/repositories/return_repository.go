@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"gorm.io/gorm"
+)
+
+// ErrReturnStatusChanged is returned when a return is no longer in the
+// status a change was made from, because another request changed it first.
+var ErrReturnStatusChanged = errors.New("return status changed")
+
+// returnStatusColumns are the columns a status change may set.
+var returnStatusColumns = []string{
+	"status",
+	"admin_note",
+	"refund_status",
+	"refund_amount",
+	"updated_at",
+	"updated_by",
+}
+
+// RefundTotal is the sum of the succeeded refunds in one currency.
+type RefundTotal struct {
+	CurrencyCode string
+	Total        money.Amount
+	Count        int64
+}
+
+type IReturnRepository interface {
+	CreateReturnRequest(ctx context.Context, returnRequest *models.ReturnRequest) error
+	UpdateReturnRequest(ctx context.Context, returnRequest *models.ReturnRequest) error
+	UpdateReturnStatus(ctx context.Context, returnRequest *models.ReturnRequest, fromStatus string) error
+	UpdateReturnItem(ctx context.Context, item *models.ReturnItem) error
+	GetReturnRequestByID(ctx context.Context, id uint) (*models.ReturnRequest, error)
+	GetReturnRequestByRefundID(ctx context.Context, refundID string) (*models.ReturnRequest, error)
+	GetReturnRequestsByOrderID(ctx context.Context, orderID uint) ([]*models.ReturnRequest, error)
+	GetReturnRequestsPagination(ctx context.Context, status string, pagination *common.PaginationRequest) ([]*models.ReturnRequest, *common.PaginationResponse, error)
+	GetOrderRefundTotals(ctx context.Context, start time.Time, end time.Time) ([]*RefundTotal, error)
+	GetReturnRefundTotals(ctx context.Context, start time.Time, end time.Time) ([]*RefundTotal, error)
+	BeginTransaction(ctx context.Context) (*gorm.DB, error)
+	WithTx(tx *gorm.DB) IReturnRepository
+}
+
+type returnRepository struct {
+	db *gorm.DB
+}
+
+// CreateReturnRequest saves the return together with its items.
+func (rr *returnRepository) CreateReturnRequest(ctx context.Context, returnRequest *models.ReturnRequest) error {
+	return rr.db.WithContext(ctx).Omit("Order", "Items.OrderItem").Create(returnRequest).Error
+}
+
+func (rr *returnRepository) UpdateReturnRequest(ctx context.Context, returnRequest *models.ReturnRequest) error {
+	return rr.db.WithContext(ctx).Omit("Order", "Items").Save(returnRequest).Error
+}
+
+// UpdateReturnStatus saves the status change of the return. It returns
+// ErrReturnStatusChanged, writing nothing, when the return is no longer in
+// fromStatus.
+func (rr *returnRepository) UpdateReturnStatus(ctx context.Context, returnRequest *models.ReturnRequest, fromStatus string) error {
+	res := rr.db.WithContext(ctx).
+		Model(returnRequest).
+		Select(returnStatusColumns).
+		Where("status = ?", fromStatus).
+		Updates(returnRequest)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrReturnStatusChanged
+	}
+
+	return nil
+}
+
+func (rr *returnRepository) UpdateReturnItem(ctx context.Context, item *models.ReturnItem) error {
+	return rr.db.WithContext(ctx).Omit("OrderItem").Save(item).Error
+}
+
+func (rr *returnRepository) GetReturnRequestByID(ctx context.Context, id uint) (*models.ReturnRequest, error) {
+	var returnRequest models.ReturnRequest
+
+	err := rr.db.WithContext(ctx).
+		Preload("Order").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("Items.OrderItem").
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		First(&returnRequest).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &returnRequest, nil
+}
+
+func (rr *returnRepository) GetReturnRequestByRefundID(ctx context.Context, refundID string) (*models.ReturnRequest, error) {
+	var returnRequest models.ReturnRequest
+
+	err := rr.db.WithContext(ctx).
+		Where("refund_id = ?", refundID).
+		Where("is_deleted = ?", false).
+		First(&returnRequest).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &returnRequest, nil
+}
+
+func (rr *returnRepository) GetReturnRequestsByOrderID(ctx context.Context, orderID uint) ([]*models.ReturnRequest, error) {
+	var returnRequests []*models.ReturnRequest
+
+	err := rr.db.WithContext(ctx).
+		Preload("Items").
+		Where("order_id = ?", orderID).
+		Where("is_deleted = ?", false).
+		Order("id ASC").
+		Find(&returnRequests).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return returnRequests, nil
+}
+
+func (rr *returnRepository) GetReturnRequestsPagination(ctx context.Context, status string, pagination *common.PaginationRequest) ([]*models.ReturnRequest, *common.PaginationResponse, error) {
+	query := rr.db.WithContext(ctx).
+		Model(&models.ReturnRequest{}).
+		Where("is_deleted = ?", false)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	return paginate[*models.ReturnRequest](query, pagination, nil, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Order")
+	})
+}
+
+// GetOrderRefundTotals sums the succeeded refunds of canceled orders per
+// currency, by the time the refund went through.
+func (rr *returnRepository) GetOrderRefundTotals(ctx context.Context, start time.Time, end time.Time) ([]*RefundTotal, error) {
+	return rr.getRefundTotals(ctx, &models.Order{}, models.OrderRefundStatusSucceeded, start, end)
+}
+
+// GetReturnRefundTotals sums the succeeded refunds of returns per currency,
+// by the time the refund went through.
+func (rr *returnRepository) GetReturnRefundTotals(ctx context.Context, start time.Time, end time.Time) ([]*RefundTotal, error) {
+	return rr.getRefundTotals(ctx, &models.ReturnRequest{}, models.OrderRefundStatusSucceeded, start, end)
+}
+
+func (rr *returnRepository) getRefundTotals(ctx context.Context, model interface{}, refundStatus string, start time.Time, end time.Time) ([]*RefundTotal, error) {
+	var totals []*RefundTotal
+
+	err := rr.db.WithContext(ctx).
+		Model(model).
+		Select("currency_code, SUM(refund_amount) AS total, COUNT(*) AS count").
+		Where("refund_status = ?", refundStatus).
+		Where("refunded_at >= ? AND refunded_at < ?", start, end).
+		Where("is_deleted = ?", false).
+		Group("currency_code").
+		Order("currency_code ASC").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (rr *returnRepository) BeginTransaction(ctx context.Context) (*gorm.DB, error) {
+	tx := rr.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return tx, nil
+}
+
+func (rr *returnRepository) WithTx(tx *gorm.DB) IReturnRepository {
+	return &returnRepository{
+		db: tx,
+	}
+}
+
+func NewReturnRepository(db *gorm.DB) IReturnRepository {
+	return &returnRepository{
+		db: db,
+	}
+}
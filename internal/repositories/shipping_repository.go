@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+)
+
+type IShippingRepository interface {
+	CreateShippingMethod(ctx context.Context, method *models.ShippingMethod) error
+	UpdateShippingMethod(ctx context.Context, method *models.ShippingMethod) error
+	DeleteShippingMethod(ctx context.Context, method *models.ShippingMethod) error
+	GetShippingMethodByID(ctx context.Context, id uint) (*models.ShippingMethod, error)
+	GetShippingMethodByCode(ctx context.Context, code string) (*models.ShippingMethod, error)
+	GetShippingMethods(ctx context.Context, activeOnly bool) ([]*models.ShippingMethod, error)
+	ReplaceShippingRates(ctx context.Context, methodID uint, rates []*models.ShippingRate, deletedBy string) error
+}
+
+type shippingRepository struct {
+	db *gorm.DB
+}
+
+func (sr *shippingRepository) CreateShippingMethod(ctx context.Context, method *models.ShippingMethod) error {
+	return sr.db.WithContext(ctx).Omit("Rates").Create(method).Error
+}
+
+func (sr *shippingRepository) UpdateShippingMethod(ctx context.Context, method *models.ShippingMethod) error {
+	return sr.db.WithContext(ctx).Omit("Rates").Save(method).Error
+}
+
+func (sr *shippingRepository) DeleteShippingMethod(ctx context.Context, method *models.ShippingMethod) error {
+	now := time.Now()
+
+	return sr.db.WithContext(ctx).
+		Model(&models.ShippingMethod{}).
+		Where("id = ?", method.ID).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"deleted_by": method.DeletedBy,
+		}).Error
+}
+
+func (sr *shippingRepository) GetShippingMethodByID(ctx context.Context, id uint) (*models.ShippingMethod, error) {
+	return sr.getShippingMethod(ctx, "id = ?", id)
+}
+
+func (sr *shippingRepository) GetShippingMethodByCode(ctx context.Context, code string) (*models.ShippingMethod, error) {
+	return sr.getShippingMethod(ctx, "code = ?", code)
+}
+
+func (sr *shippingRepository) getShippingMethod(ctx context.Context, query string, arg interface{}) (*models.ShippingMethod, error) {
+	var method models.ShippingMethod
+
+	err := sr.db.WithContext(ctx).
+		Preload("Rates", "is_deleted = ?", false).
+		Where(query, arg).
+		Where("is_deleted = ?", false).
+		First(&method).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &method, nil
+}
+
+func (sr *shippingRepository) GetShippingMethods(ctx context.Context, activeOnly bool) ([]*models.ShippingMethod, error) {
+	var methods []*models.ShippingMethod
+
+	query := sr.db.WithContext(ctx).
+		Preload("Rates", "is_deleted = ?", false).
+		Where("is_deleted = ?", false)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := query.Order("name ASC").Find(&methods).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return methods, nil
+}
+
+// ReplaceShippingRates swaps a method's whole rate table for the given rates.
+func (sr *shippingRepository) ReplaceShippingRates(ctx context.Context, methodID uint, rates []*models.ShippingRate, deletedBy string) error {
+	return sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.ShippingRate{}).
+			Where("shipping_method_id = ?", methodID).
+			Where("is_deleted = ?", false).
+			Updates(map[string]interface{}{
+				"is_deleted": true,
+				"deleted_at": time.Now(),
+				"deleted_by": deletedBy,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(rates) == 0 {
+			return nil
+		}
+
+		for _, rate := range rates {
+			rate.ShippingMethodID = methodID
+		}
+
+		return tx.Create(&rates).Error
+	})
+}
+
+func NewShippingRepository(db *gorm.DB) IShippingRepository {
+	return &shippingRepository{
+		db: db,
+	}
+}
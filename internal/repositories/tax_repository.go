@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"gorm.io/gorm"
+)
+
+// ErrActiveTaxRuleExists is returned when a write would leave two active tax
+// rules for the same currency.
+var ErrActiveTaxRuleExists = errors.New("an active tax rule already exists for this currency")
+
+type ITaxRepository interface {
+	CreateTaxRule(ctx context.Context, rule *models.TaxRule) error
+	UpdateTaxRule(ctx context.Context, rule *models.TaxRule) error
+	DeleteTaxRule(ctx context.Context, rule *models.TaxRule) error
+	GetTaxRuleByID(ctx context.Context, id uint) (*models.TaxRule, error)
+	GetTaxRules(ctx context.Context) ([]*models.TaxRule, error)
+	GetActiveTaxRule(ctx context.Context, currencyCode string) (*models.TaxRule, error)
+}
+
+type taxRepository struct {
+	db *gorm.DB
+}
+
+func (tr *taxRepository) CreateTaxRule(ctx context.Context, rule *models.TaxRule) error {
+	return translateTaxRuleError(tr.db.WithContext(ctx).Create(rule).Error)
+}
+
+func (tr *taxRepository) UpdateTaxRule(ctx context.Context, rule *models.TaxRule) error {
+	return translateTaxRuleError(tr.db.WithContext(ctx).Save(rule).Error)
+}
+
+// translateTaxRuleError maps a violation of the active currency index to
+// ErrActiveTaxRuleExists.
+func translateTaxRuleError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrActiveTaxRuleExists
+	}
+	return err
+}
+
+func (tr *taxRepository) DeleteTaxRule(ctx context.Context, rule *models.TaxRule) error {
+	return tr.db.WithContext(ctx).
+		Model(&models.TaxRule{}).
+		Where("id = ?", rule.ID).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": time.Now(),
+			"deleted_by": rule.DeletedBy,
+		}).Error
+}
+
+func (tr *taxRepository) GetTaxRuleByID(ctx context.Context, id uint) (*models.TaxRule, error) {
+	var rule models.TaxRule
+
+	err := tr.db.WithContext(ctx).
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		First(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (tr *taxRepository) GetTaxRules(ctx context.Context) ([]*models.TaxRule, error) {
+	var rules []*models.TaxRule
+
+	err := tr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Order("currency_code ASC, created_at DESC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// GetActiveTaxRule returns the active rule for the currency, falling back to
+// the active rule that applies to every currency.
+func (tr *taxRepository) GetActiveTaxRule(ctx context.Context, currencyCode string) (*models.TaxRule, error) {
+	var rule models.TaxRule
+
+	err := tr.db.WithContext(ctx).
+		Where("currency_code IN ?", []string{currencyCode, ""}).
+		Where("is_active = ?", true).
+		Where("is_deleted = ?", false).
+		Order("currency_code DESC").
+		First(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func NewTaxRepository(db *gorm.DB) ITaxRepository {
+	return &taxRepository{
+		db: db,
+	}
+}
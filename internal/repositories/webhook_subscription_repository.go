@@ -0,0 +1,221 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var webhookDeliverySorts = map[string]bool{
+	"created_at":      true,
+	"next_attempt_at": true,
+}
+
+// WebhookDeliveryFilter narrows the delivery log. Zero fields do not filter.
+type WebhookDeliveryFilter struct {
+	SubscriptionID uint
+	Status         string
+	EventType      string
+}
+
+type IWebhookSubscriptionRepository interface {
+	CreateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	UpdateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	GetSubscriptionByID(ctx context.Context, id uint) (*models.WebhookSubscription, error)
+	GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	GetActiveSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	GetDeliveryByID(ctx context.Context, id uint) (*models.WebhookDelivery, error)
+	GetDeliveriesPagination(ctx context.Context, filter *WebhookDeliveryFilter, pagination *common.PaginationRequest) ([]*models.WebhookDelivery, *common.PaginationResponse, error)
+	WithTx(tx *gorm.DB) IWebhookSubscriptionRepository
+}
+
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func (wr *webhookSubscriptionRepository) CreateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	return wr.db.WithContext(ctx).Create(subscription).Error
+}
+
+func (wr *webhookSubscriptionRepository) UpdateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	return wr.db.WithContext(ctx).Save(subscription).Error
+}
+
+func (wr *webhookSubscriptionRepository) DeleteSubscription(ctx context.Context, subscription *models.WebhookSubscription) error {
+	return wr.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ?", subscription.ID).
+		Where("is_deleted = ?", false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": time.Now(),
+			"deleted_by": subscription.DeletedBy,
+		}).Error
+}
+
+func (wr *webhookSubscriptionRepository) GetSubscriptionByID(ctx context.Context, id uint) (*models.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+
+	err := wr.db.WithContext(ctx).
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		First(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (wr *webhookSubscriptionRepository) GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	var subscriptions []*models.WebhookSubscription
+
+	err := wr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Order("created_at DESC").
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (wr *webhookSubscriptionRepository) GetActiveSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	var subscriptions []*models.WebhookSubscription
+
+	err := wr.db.WithContext(ctx).
+		Where("is_deleted = ?", false).
+		Where("is_active = ?", true).
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// CreateDeliveries saves new deliveries, skipping events a subscription
+// already has a delivery for.
+func (wr *webhookSubscriptionRepository) CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return wr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "outbox_event_id"}},
+			DoNothing: true,
+		}).
+		Create(&deliveries).Error
+}
+
+// ClaimDueDeliveries returns pending deliveries whose next attempt is due,
+// with their event and subscription. Each one is pushed back by lease so no
+// other worker picks it up while it is being sent.
+func (wr *webhookSubscriptionRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+
+	err := wr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", models.WebhookDeliveryStatusPending).
+			Where("next_attempt_at <= ?", now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(deliveries))
+		for _, d := range deliveries {
+			ids = append(ids, d.ID)
+		}
+
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+	}
+
+	deliveries = nil
+	err = wr.db.WithContext(ctx).
+		Preload("OutboxEvent").
+		Preload("Subscription").
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (wr *webhookSubscriptionRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	return wr.db.WithContext(ctx).Omit("Subscription", "OutboxEvent").Save(delivery).Error
+}
+
+func (wr *webhookSubscriptionRepository) GetDeliveryByID(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+
+	err := wr.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&delivery).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (wr *webhookSubscriptionRepository) GetDeliveriesPagination(ctx context.Context, filter *WebhookDeliveryFilter, pagination *common.PaginationRequest) ([]*models.WebhookDelivery, *common.PaginationResponse, error) {
+	query := wr.db.WithContext(ctx).Model(&models.WebhookDelivery{})
+
+	if filter.SubscriptionID != 0 {
+		query = query.Where("subscription_id = ?", filter.SubscriptionID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EventType != "" {
+		query = query.Where("event_type = ?", filter.EventType)
+	}
+
+	return paginate[*models.WebhookDelivery](query, pagination, webhookDeliverySorts)
+}
+
+func (wr *webhookSubscriptionRepository) WithTx(tx *gorm.DB) IWebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{
+		db: tx,
+	}
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) IWebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"gorm.io/gorm"
+)
+
+type WishlistProductCount struct {
+	ProductID     uint
+	ProductName   string
+	WishlistCount int64
+}
+
+type IWishlistRepository interface {
+	GetWishlistByProductUserID(ctx context.Context, productId uint, userId uint) (*models.Wishlist, error)
+	CreateWishlist(ctx context.Context, wishlist *models.Wishlist) error
+	DeleteWishlist(ctx context.Context, wishlistId uint, deletedBy string) error
+	GetListWishlist(ctx context.Context, userId uint, pagination *common.PaginationRequest) ([]*models.Wishlist, *common.PaginationResponse, error)
+	GetMostWishlistedProducts(ctx context.Context, limit int) ([]*WishlistProductCount, error)
+}
+
+type wishlistRepository struct {
+	db *gorm.DB
+}
+
+func (wr *wishlistRepository) GetWishlistByProductUserID(ctx context.Context, productId uint, userId uint) (*models.Wishlist, error) {
+	var wishlist models.Wishlist
+
+	err := wr.db.WithContext(ctx).
+		Where("product_id = ? AND user_id = ?", productId, userId).
+		Where("is_deleted = ?", false).
+		First(&wishlist).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &wishlist, nil
+}
+
+func (wr *wishlistRepository) CreateWishlist(ctx context.Context, wishlist *models.Wishlist) error {
+	return wr.db.WithContext(ctx).Create(wishlist).Error
+}
+
+func (wr *wishlistRepository) DeleteWishlist(ctx context.Context, wishlistId uint, deletedBy string) error {
+	return wr.db.WithContext(ctx).
+		Model(&models.Wishlist{}).
+		Where("id = ?", wishlistId).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_by": deletedBy,
+		}).Error
+}
+
+func (wr *wishlistRepository) GetListWishlist(ctx context.Context, userId uint, pagination *common.PaginationRequest) ([]*models.Wishlist, *common.PaginationResponse, error) {
+	query := wr.db.WithContext(ctx).
+		Model(&models.Wishlist{}).
+		Where("user_id = ?", userId).
+		Where("is_deleted = ?", false)
+
+	// Deleted products are still loaded so the item can be reported as unavailable.
+	return paginate[*models.Wishlist](query, pagination, nil, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Product", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		})
+	})
+}
+
+func (wr *wishlistRepository) GetMostWishlistedProducts(ctx context.Context, limit int) ([]*WishlistProductCount, error) {
+	var counts []*WishlistProductCount
+
+	err := wr.db.WithContext(ctx).
+		Table("wishlist").
+		Select("wishlist.product_id AS product_id, product.name AS product_name, COUNT(wishlist.id) AS wishlist_count").
+		Joins("JOIN product ON product.id = wishlist.product_id").
+		Where("wishlist.is_deleted = ?", false).
+		Where("product.is_deleted = ?", false).
+		Group("wishlist.product_id, product.name").
+		Order("wishlist_count DESC").
+		Limit(limit).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func NewWishlistRepository(db *gorm.DB) IWishlistRepository {
+	return &wishlistRepository{
+		db: db,
+	}
+}
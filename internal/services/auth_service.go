@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"log"
 	"strconv"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
@@ -27,6 +28,7 @@ type IAuthService interface {
 
 type authService struct {
 	authRepository repositories.IAuthRepository
+	cartService    ICartService
 	cacheService   *gocache.Cache
 }
 
@@ -86,6 +88,8 @@ func (as *authService) Register(ctx context.Context, req *auth.RegisterRequest)
 		}, nil
 	}
 
+	as.mergeGuestCart(ctx, req.GetGuestCartToken(), newUser)
+
 	return &auth.RegisterResponse{
 		Base: utils.SuccessResponse("Registration successful."),
 	}, nil
@@ -116,6 +120,8 @@ func (as *authService) Login(ctx context.Context, req *auth.LoginRequest) (*auth
 		return nil, err
 	}
 
+	as.mergeGuestCart(ctx, req.GetGuestCartToken(), user)
+
 	return &auth.LoginResponse{
 		Base:  utils.SuccessResponse("Login successful."),
 		Token: token,
@@ -252,9 +258,22 @@ func (as *authService) GetProfile(ctx context.Context, req *auth.GetProfileReque
 
 }
 
-func NewAuthService(authRepository repositories.IAuthRepository, cacheService *gocache.Cache) IAuthService {
+// mergeGuestCart merges the guest cart into the user's cart. A failed merge
+// must not fail the login, so errors are only logged.
+func (as *authService) mergeGuestCart(ctx context.Context, guestCartToken string, user *models.User) {
+	if guestCartToken == "" {
+		return
+	}
+
+	if err := as.cartService.MergeGuestCart(ctx, guestCartToken, user.ID, user.FullName); err != nil {
+		log.Printf("failed to merge guest cart for user %d: %v", user.ID, err)
+	}
+}
+
+func NewAuthService(authRepository repositories.IAuthRepository, cartService ICartService, cacheService *gocache.Cache) IAuthService {
 	return &authService{
 		authRepository: authRepository,
+		cartService:    cartService,
 		cacheService:   cacheService,
 	}
 }
@@ -2,12 +2,17 @@ package services
 
 import (
 	"context"
+	"fmt"
+	stdos "os"
+	"strconv"
 	"time"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
 	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/cart"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,17 +22,48 @@ type ICartService interface {
 	ListCart(ctx context.Context, req *cart.ListCartRequest) (*cart.ListCartResponse, error)
 	DeleteCart(ctx context.Context, req *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error)
 	UpdateCartQty(ctx context.Context, req *cart.UpdateCartQtyRequest) (*cart.UpdateCartQtyResponse, error)
+	BulkUpdateCart(ctx context.Context, req *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error)
+	ClearCart(ctx context.Context, req *cart.ClearCartRequest) (*cart.ClearCartResponse, error)
+	CreateGuestCart(ctx context.Context, req *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error)
+	MergeGuestCart(ctx context.Context, guestCartToken string, userID uint, mergedBy string) error
 }
 
 type cartService struct {
 	productRepository repositories.IProductRepository
 	cartRepository    repositories.ICartRepository
+	pricingService    IPricingService
 }
 
-func (cs *cartService) AddToCart(ctx context.Context, req *cart.AddToCartRequest) (*cart.AddToCartResponse, error) {
+const guestCartActor = "Guest"
+
+// resolveOwner returns the cart owner of the caller: the logged in user when
+// claims are present, otherwise the guest cart named by the cart token header.
+func (cs *cartService) resolveOwner(ctx context.Context) (repositories.CartOwner, string, error) {
 	claims, err := utils.GetClaimsFromContext(ctx)
+	if err == nil {
+		return repositories.CartOwner{UserID: claims.UserID}, claims.FullName, nil
+	}
+
+	token, err := utils.ExtractCartTokenFromContext(ctx)
+	if err != nil {
+		return repositories.CartOwner{}, "", status.Error(codes.Unauthenticated, "login or provide a guest cart token")
+	}
+
+	guestCart, err := cs.cartRepository.GetGuestCartByToken(ctx, token)
+	if err != nil {
+		return repositories.CartOwner{}, "", err
+	}
+	if guestCart == nil {
+		return repositories.CartOwner{}, "", status.Error(codes.Unauthenticated, "guest cart not found or expired")
+	}
+
+	return repositories.CartOwner{GuestCartID: guestCart.ID}, guestCartActor, nil
+}
+
+func (cs *cartService) AddToCart(ctx context.Context, req *cart.AddToCartRequest) (*cart.AddToCartResponse, error) {
+	owner, actor, err := cs.resolveOwner(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get user info")
+		return nil, err
 	}
 
 	product, err := cs.productRepository.GetProductByID(ctx, uint(req.ProductId))
@@ -41,16 +77,30 @@ func (cs *cartService) AddToCart(ctx context.Context, req *cart.AddToCartRequest
 		}, nil
 	}
 
-	existingCart, err := cs.cartRepository.GetCartByProductUserID(ctx, uint(req.ProductId), claims.UserID)
+	existingCart, err := cs.cartRepository.GetCartByProductOwner(ctx, uint(req.ProductId), owner)
 	if err != nil {
 		return nil, err
 	}
 
+	newQuantity := int(req.Quantity)
+	if existingCart != nil {
+		newQuantity += existingCart.Quantity
+	}
+
+	if msg := maxPerOrderError(product, newQuantity); msg != "" {
+		return &cart.AddToCartResponse{
+			BaseResponse: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
 	if existingCart != nil {
 		now := time.Now()
-		existingCart.Quantity += int(req.Quantity)
+		existingCart.Quantity = newQuantity
+		if req.AcceptCurrentPrice || existingCart.Price == 0 {
+			existingCart.Price = product.Price
+		}
 		existingCart.UpdatedAt = &now
-		updatedBy := claims.FullName
+		updatedBy := actor
 		existingCart.UpdatedBy = &updatedBy
 
 		err = cs.cartRepository.UpdateCart(ctx, existingCart)
@@ -58,21 +108,34 @@ func (cs *cartService) AddToCart(ctx context.Context, req *cart.AddToCartRequest
 			return nil, err
 		}
 
+		msg := "Added to cart successfully"
+		priceChanged := existingCart.Price != product.Price
+		if priceChanged {
+			msg = "Added to cart successfully, the price has changed since the product was first added"
+		}
+
 		return &cart.AddToCartResponse{
-			BaseResponse: utils.SuccessResponse("Added to cart successfully"),
+			BaseResponse: utils.SuccessResponse(msg),
 			Id:           uint64(existingCart.ID),
+			PriceAtAdd:   utils.ConvertMoneyToProto(existingCart.Price, money.DefaultCurrency),
+			PriceChanged: priceChanged,
 		}, nil
 	}
 
 	newCart := &models.Cart{
 		ProductID: uint(req.ProductId),
-		UserID:    claims.UserID,
 		Quantity:  int(req.Quantity),
+		Price:     product.Price,
 		BaseModel: models.BaseModel{
 			CreatedAt: time.Now(),
-			CreatedBy: claims.FullName,
+			CreatedBy: actor,
 		},
 	}
+	if owner.IsGuest() {
+		newCart.GuestCartID = &owner.GuestCartID
+	} else {
+		newCart.UserID = &owner.UserID
+	}
 
 	err = cs.cartRepository.CreateNewCart(ctx, newCart)
 	if err != nil {
@@ -82,43 +145,118 @@ func (cs *cartService) AddToCart(ctx context.Context, req *cart.AddToCartRequest
 	return &cart.AddToCartResponse{
 		BaseResponse: utils.SuccessResponse("Added to cart successfully"),
 		Id:           uint64(newCart.ID),
+		PriceAtAdd:   utils.ConvertMoneyToProto(newCart.Price, money.DefaultCurrency),
 	}, nil
 }
 
 func (cs *cartService) ListCart(ctx context.Context, req *cart.ListCartRequest) (*cart.ListCartResponse, error) {
-	claims, err := utils.GetClaimsFromContext(ctx)
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, _, err := cs.resolveOwner(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get user info")
+		return nil, err
 	}
 
-	carts, err := cs.cartRepository.GetListCart(ctx, claims.UserID)
+	carts, err := cs.cartRepository.GetListCartByOwner(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
 
+	products := make([]*models.Product, 0, len(carts))
+	for _, c := range carts {
+		if c.Product != nil {
+			products = append(products, c.Product)
+		}
+	}
+
+	prices, err := cs.pricingService.ResolvePrices(ctx, currencyCode, products)
+	if err != nil {
+		return nil, err
+	}
+
+	var total money.Amount
+	var totalQuantity int32
+	hasWarnings := false
+
 	var cartItems []*cart.ListCartResponseItem = make([]*cart.ListCartResponseItem, 0)
 	for _, cartItem := range carts {
 		item := &cart.ListCartResponseItem{
-			CartId:          uint64(cartItem.ID),
-			ProductId:       uint64(cartItem.ProductID),
-			ProductName:     cartItem.Product.Name,
-			ProductImageUrl: cartItem.Product.ImageURL,
-			ProductPrice:    cartItem.Product.Price,
-			Quantity:        int32(cartItem.Quantity),
+			CartId:      uint64(cartItem.ID),
+			ProductId:   uint64(cartItem.ProductID),
+			Quantity:    int32(cartItem.Quantity),
+			PriceAtAdd:  utils.ConvertMoneyToProto(cartItem.Price, money.DefaultCurrency),
+			Subtotal:    utils.ConvertMoneyToProto(0, currencyCode),
+			IsAvailable: true,
+			Warnings:    make([]*cart.ListCartResponseItemWarning, 0),
+		}
+
+		p := cartItem.Product
+		if p != nil {
+			item.ProductName = p.Name
+			item.ProductImageUrl = p.ImageURL
+			item.ProductPrice = optionalPriceToProto(prices, p.ID, currencyCode)
 		}
+
+		if p == nil || p.IsDeleted || p.DeletedAt.Valid {
+			item.IsAvailable = false
+			item.Warnings = append(item.Warnings, &cart.ListCartResponseItemWarning{
+				Code:    models.CartWarningCodeProductUnavailable,
+				Message: "Product is no longer available",
+			})
+		} else {
+			// Lines added before prices were recorded have no snapshot to compare against.
+			if cartItem.Price > 0 && cartItem.Price != p.Price {
+				item.Warnings = append(item.Warnings, &cart.ListCartResponseItemWarning{
+					Code:    models.CartWarningCodePriceChanged,
+					Message: fmt.Sprintf("Price changed from %s to %s", cartItem.Price, p.Price),
+				})
+			}
+
+			if p.Stock != nil && *p.Stock < cartItem.Quantity {
+				item.Warnings = append(item.Warnings, &cart.ListCartResponseItemWarning{
+					Code:    models.CartWarningCodeInsufficientStock,
+					Message: fmt.Sprintf("Only %d left in stock", *p.Stock),
+				})
+			}
+
+			price, priced := prices[p.ID]
+			if !priced {
+				item.IsAvailable = false
+				item.Warnings = append(item.Warnings, &cart.ListCartResponseItemWarning{
+					Code:    models.CartWarningCodeCurrencyNotPriced,
+					Message: fmt.Sprintf("Product is not sold in %s", currencyCode),
+				})
+			} else {
+				subtotal := price.Mul(cartItem.Quantity)
+				item.Subtotal = utils.ConvertMoneyToProto(subtotal, currencyCode)
+				total += subtotal
+				totalQuantity += item.Quantity
+			}
+		}
+
+		if len(item.Warnings) > 0 {
+			hasWarnings = true
+		}
+
 		cartItems = append(cartItems, item)
 	}
 
 	return &cart.ListCartResponse{
-		BaseResponse: utils.SuccessResponse("List cart fetched successfully"),
-		Items:        cartItems,
+		BaseResponse:  utils.SuccessResponse("List cart fetched successfully"),
+		Items:         cartItems,
+		Total:         utils.ConvertMoneyToProto(total, currencyCode),
+		TotalQuantity: totalQuantity,
+		HasWarnings:   hasWarnings,
 	}, nil
 }
 
 func (cs *cartService) DeleteCart(ctx context.Context, req *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error) {
-	claims, err := utils.GetClaimsFromContext(ctx)
+	owner, actor, err := cs.resolveOwner(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get user info")
+		return nil, err
 	}
 
 	existingCart, err := cs.cartRepository.GetCartById(ctx, uint(req.CartId))
@@ -132,13 +270,13 @@ func (cs *cartService) DeleteCart(ctx context.Context, req *cart.DeleteCartReque
 		}, nil
 	}
 
-	if existingCart.UserID != claims.UserID {
+	if !owner.Owns(existingCart) {
 		return &cart.DeleteCartResponse{
 			BaseResponse: utils.UnauthorizedResponse("You are not authorized to delete this cart"),
 		}, nil
 	}
 
-	err = cs.cartRepository.DeleteCart(ctx, uint(req.CartId), claims.FullName)
+	err = cs.cartRepository.DeleteCart(ctx, uint(req.CartId), actor)
 	if err != nil {
 		return nil, err
 	}
@@ -149,9 +287,9 @@ func (cs *cartService) DeleteCart(ctx context.Context, req *cart.DeleteCartReque
 }
 
 func (cs *cartService) UpdateCartQty(ctx context.Context, req *cart.UpdateCartQtyRequest) (*cart.UpdateCartQtyResponse, error) {
-	claims, err := utils.GetClaimsFromContext(ctx)
+	owner, actor, err := cs.resolveOwner(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get user info")
+		return nil, err
 	}
 
 	existingCart, err := cs.cartRepository.GetCartById(ctx, uint(req.CartId))
@@ -165,13 +303,35 @@ func (cs *cartService) UpdateCartQty(ctx context.Context, req *cart.UpdateCartQt
 		}, nil
 	}
 
-	if existingCart.UserID != claims.UserID {
+	if !owner.Owns(existingCart) {
 		return &cart.UpdateCartQtyResponse{
 			BaseResponse: utils.UnauthorizedResponse("You are not authorized to update this cart"),
 		}, nil
 	}
 
+	if req.NewQuantity == 0 {
+		err = cs.cartRepository.DeleteCart(ctx, existingCart.ID, actor)
+		if err != nil {
+			return nil, err
+		}
+
+		return &cart.UpdateCartQtyResponse{
+			BaseResponse: utils.SuccessResponse("Cart item removed successfully"),
+		}, nil
+	}
+
+	if existingCart.Product != nil {
+		if msg := maxPerOrderError(existingCart.Product, int(req.NewQuantity)); msg != "" {
+			return &cart.UpdateCartQtyResponse{
+				BaseResponse: utils.BadRequestResponse(msg),
+			}, nil
+		}
+	}
+
+	now := time.Now()
 	existingCart.Quantity = int(req.NewQuantity)
+	existingCart.UpdatedAt = &now
+	existingCart.UpdatedBy = &actor
 	err = cs.cartRepository.UpdateCart(ctx, existingCart)
 	if err != nil {
 		return nil, err
@@ -182,9 +342,335 @@ func (cs *cartService) UpdateCartQty(ctx context.Context, req *cart.UpdateCartQt
 	}, nil
 }
 
-func NewCartService(productRepository repositories.IProductRepository, cartRepository repositories.ICartRepository) ICartService {
+// BulkUpdateCart sets the quantity of several lines at once. Every item is
+// validated before anything is written, and all changes share one transaction.
+func (cs *cartService) BulkUpdateCart(ctx context.Context, req *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error) {
+	owner, actor, err := cs.resolveOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productIds := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		productIds[i] = fmt.Sprint(item.ProductId)
+	}
+
+	products, err := cs.productRepository.GetProductsByIDs(ctx, productIds)
+	if err != nil {
+		return nil, err
+	}
+
+	productMap := make(map[uint64]*models.Product)
+	for i := range products {
+		productMap[uint64(products[i].ID)] = products[i]
+	}
+
+	validationErrors := make([]*common.ValidationError, 0)
+	seen := make(map[uint64]bool)
+	for i, item := range req.Items {
+		if seen[item.ProductId] {
+			validationErrors = append(validationErrors, &common.ValidationError{
+				Field:   fmt.Sprintf("items[%d].product_id", i),
+				Message: "Product is listed more than once",
+			})
+			continue
+		}
+		seen[item.ProductId] = true
+
+		if item.Quantity == 0 {
+			continue
+		}
+
+		product, exists := productMap[item.ProductId]
+		if !exists {
+			validationErrors = append(validationErrors, &common.ValidationError{
+				Field:   fmt.Sprintf("items[%d].product_id", i),
+				Message: "Product not found",
+			})
+			continue
+		}
+
+		if msg := maxPerOrderError(product, int(item.Quantity)); msg != "" {
+			validationErrors = append(validationErrors, &common.ValidationError{
+				Field:   fmt.Sprintf("items[%d].quantity", i),
+				Message: msg,
+			})
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return &cart.BulkUpdateCartResponse{
+			BaseResponse: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	tx, err := cs.cartRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	txCartRepo := cs.cartRepository.WithTx(tx)
+	now := time.Now()
+
+	for _, item := range req.Items {
+		existingCart, err := txCartRepo.GetCartByProductOwner(ctx, uint(item.ProductId), owner)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if item.Quantity == 0 {
+			if existingCart != nil {
+				err = txCartRepo.DeleteCart(ctx, existingCart.ID, actor)
+				if err != nil {
+					tx.Rollback()
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		product := productMap[item.ProductId]
+		if existingCart != nil {
+			existingCart.Quantity = int(item.Quantity)
+			if item.AcceptCurrentPrice || existingCart.Price == 0 {
+				existingCart.Price = product.Price
+			}
+			existingCart.Product = nil
+			existingCart.User = nil
+			existingCart.UpdatedAt = &now
+			existingCart.UpdatedBy = &actor
+
+			err = txCartRepo.UpdateCart(ctx, existingCart)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			continue
+		}
+
+		newCart := &models.Cart{
+			ProductID: uint(item.ProductId),
+			Quantity:  int(item.Quantity),
+			Price:     product.Price,
+			BaseModel: models.BaseModel{
+				CreatedAt: now,
+				CreatedBy: actor,
+			},
+		}
+		if owner.IsGuest() {
+			newCart.GuestCartID = &owner.GuestCartID
+		} else {
+			newCart.UserID = &owner.UserID
+		}
+
+		err = txCartRepo.CreateNewCart(ctx, newCart)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
+	return &cart.BulkUpdateCartResponse{
+		BaseResponse: utils.SuccessResponse("Cart updated successfully"),
+	}, nil
+}
+
+func (cs *cartService) ClearCart(ctx context.Context, req *cart.ClearCartRequest) (*cart.ClearCartResponse, error) {
+	owner, actor, err := cs.resolveOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cs.cartRepository.DeleteCartsByOwner(ctx, owner, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cart.ClearCartResponse{
+		BaseResponse: utils.SuccessResponse("Cart cleared successfully"),
+	}, nil
+}
+
+func (cs *cartService) CreateGuestCart(ctx context.Context, req *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error) {
+	token, err := utils.GenerateCartToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate cart token")
+	}
+
+	now := time.Now()
+	guestCart := &models.GuestCart{
+		Token:     token,
+		ExpiresAt: now.Add(guestCartTTL()),
+		BaseModel: models.BaseModel{
+			CreatedAt: now,
+			CreatedBy: guestCartActor,
+		},
+	}
+
+	err = cs.cartRepository.CreateGuestCart(ctx, guestCart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cart.CreateGuestCartResponse{
+		BaseResponse: utils.SuccessResponse("Guest cart created successfully"),
+		CartToken:    token,
+		ExpiresAt:    utils.ConvertTimeToTimestamp(guestCart.ExpiresAt),
+	}, nil
+}
+
+// MergeGuestCart moves the lines of a guest cart into the user's cart. Products
+// present in both carts are resolved with the configured merge strategy.
+func (cs *cartService) MergeGuestCart(ctx context.Context, guestCartToken string, userID uint, mergedBy string) error {
+	guestCart, err := cs.cartRepository.GetGuestCartByToken(ctx, guestCartToken)
+	if err != nil {
+		return err
+	}
+	if guestCart == nil {
+		return nil
+	}
+
+	guestLines, err := cs.cartRepository.GetListCartByOwner(ctx, repositories.CartOwner{GuestCartID: guestCart.ID})
+	if err != nil {
+		return err
+	}
+
+	tx, err := cs.cartRepository.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	txCartRepo := cs.cartRepository.WithTx(tx)
+	strategy := cartMergeStrategy()
+	now := time.Now()
+	userOwner := repositories.CartOwner{UserID: userID}
+
+	for _, guestLine := range guestLines {
+		userLine, err := txCartRepo.GetCartByProductOwner(ctx, guestLine.ProductID, userOwner)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if userLine == nil {
+			guestLine.Quantity = clampCartQuantity(guestLine.Product, guestLine.Quantity)
+			guestLine.UserID = &userID
+			guestLine.GuestCartID = nil
+			guestLine.Product = nil
+			guestLine.UpdatedAt = &now
+			guestLine.UpdatedBy = &mergedBy
+
+			if err := txCartRepo.UpdateCart(ctx, guestLine); err != nil {
+				tx.Rollback()
+				return err
+			}
+			continue
+		}
+
+		switch strategy {
+		case models.CartMergeStrategyMax:
+			if guestLine.Quantity > userLine.Quantity {
+				userLine.Quantity = guestLine.Quantity
+			}
+		case models.CartMergeStrategyKeepUser:
+		case models.CartMergeStrategyKeepGuest:
+			userLine.Quantity = guestLine.Quantity
+			userLine.Price = guestLine.Price
+		default:
+			userLine.Quantity += guestLine.Quantity
+		}
+		// A merged line obeys the same limits as one added to the cart.
+		userLine.Quantity = clampCartQuantity(guestLine.Product, userLine.Quantity)
+		userLine.Product = nil
+		userLine.User = nil
+		userLine.UpdatedAt = &now
+		userLine.UpdatedBy = &mergedBy
+
+		if err := txCartRepo.UpdateCart(ctx, userLine); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := txCartRepo.DeleteCart(ctx, guestLine.ID, mergedBy); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	guestCart.MergedAt = &now
+	guestCart.MergedTo = &userID
+	guestCart.UpdatedAt = &now
+	guestCart.UpdatedBy = &mergedBy
+	if err := txCartRepo.UpdateGuestCart(ctx, guestCart); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func guestCartTTL() time.Duration {
+	hours, err := strconv.Atoi(stdos.Getenv("GUEST_CART_TTL_HOURS"))
+	if err != nil || hours <= 0 {
+		hours = 24 * 30
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+func cartMergeStrategy() string {
+	switch strategy := stdos.Getenv("CART_MERGE_STRATEGY"); strategy {
+	case models.CartMergeStrategyMax, models.CartMergeStrategyKeepUser, models.CartMergeStrategyKeepGuest:
+		return strategy
+	default:
+		return models.CartMergeStrategySum
+	}
+}
+
+// maxPerOrderError returns a message when quantity exceeds the product's
+// per-order limit, or an empty string when the quantity is allowed.
+func maxPerOrderError(product *models.Product, quantity int) string {
+	if product.MaxPerOrder == nil || quantity <= *product.MaxPerOrder {
+		return ""
+	}
+	return fmt.Sprintf("Maximum %d of %s allowed per order", *product.MaxPerOrder, product.Name)
+}
+
+// clampCartQuantity lowers quantity to the product's per-order limit and to
+// its stock. An out of stock line is left for ListCart to warn about.
+func clampCartQuantity(product *models.Product, quantity int) int {
+	if product == nil {
+		return quantity
+	}
+	if product.MaxPerOrder != nil && quantity > *product.MaxPerOrder {
+		quantity = *product.MaxPerOrder
+	}
+	if product.Stock != nil && *product.Stock > 0 && quantity > *product.Stock {
+		quantity = *product.Stock
+	}
+	return quantity
+}
+
+func NewCartService(productRepository repositories.IProductRepository, cartRepository repositories.ICartRepository, pricingService IPricingService) ICartService {
 	return &cartService{
 		productRepository: productRepository,
 		cartRepository:    cartRepository,
+		pricingService:    pricingService,
 	}
 }
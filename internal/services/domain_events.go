@@ -0,0 +1,152 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+// DomainEvent is an outbox event once it has been dispatched. It is
+// published on the domain event bus for consumers inside the application.
+type DomainEvent struct {
+	ID            uint
+	Type          string
+	AggregateType string
+	AggregateID   string
+	OccurredAt    time.Time
+	// Payload is the JSON data of the event.
+	Payload []byte
+}
+
+// domainEventEnvelope is the JSON body sent to webhook subscriptions.
+type domainEventEnvelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func domainEventID(id uint) string {
+	return fmt.Sprintf("evt_%d", id)
+}
+
+func newOutboxEvent(eventType string, aggregateType string, aggregateID string, data interface{}) (*models.OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OutboxEvent{
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+		OccurredAt:    time.Now(),
+	}, nil
+}
+
+type orderEventItem struct {
+	ProductID   uint         `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	Price       money.Amount `json:"price"`
+	Subtotal    money.Amount `json:"subtotal"`
+}
+
+type orderEventData struct {
+	OrderID            uint             `json:"order_id"`
+	Number             string           `json:"number"`
+	UserID             uint             `json:"user_id"`
+	StatusCode         string           `json:"status_code"`
+	PreviousStatusCode string           `json:"previous_status_code,omitempty"`
+	Reason             string           `json:"reason,omitempty"`
+	CurrencyCode       string           `json:"currency_code"`
+	Subtotal           money.Amount     `json:"subtotal"`
+	DiscountTotal      money.Amount     `json:"discount_total"`
+	TaxTotal           money.Amount     `json:"tax_total"`
+	ShippingTotal      money.Amount     `json:"shipping_total"`
+	Total              money.Amount     `json:"total"`
+	Items              []orderEventItem `json:"items"`
+	CreatedAt          time.Time        `json:"created_at"`
+}
+
+// newOrderOutboxEvent describes the order as it is now. history is the
+// status change that caused the event, nil for order.created.
+func newOrderOutboxEvent(eventType string, o *models.Order, history *models.OrderStatusHistory) (*models.OutboxEvent, error) {
+	items := make([]orderEventItem, 0, len(o.Items))
+	for _, oi := range o.Items {
+		items = append(items, orderEventItem{
+			ProductID:   oi.ProductID,
+			ProductName: oi.ProductName,
+			Quantity:    oi.Quantity,
+			Price:       oi.ProductPrice,
+			Subtotal:    oi.Subtotal,
+		})
+	}
+
+	data := orderEventData{
+		OrderID:       o.ID,
+		Number:        o.Number,
+		UserID:        o.UserID,
+		StatusCode:    o.OrderStatusCode,
+		CurrencyCode:  o.CurrencyCode,
+		Subtotal:      o.Subtotal,
+		DiscountTotal: o.DiscountTotal,
+		TaxTotal:      o.TaxTotal,
+		ShippingTotal: o.ShippingTotal,
+		Total:         o.Total,
+		Items:         items,
+		CreatedAt:     o.CreatedAt,
+	}
+	if history != nil {
+		data.PreviousStatusCode = history.FromStatusCode
+		data.Reason = history.Reason
+	}
+
+	return newOutboxEvent(eventType, "order", fmt.Sprint(o.ID), data)
+}
+
+// orderStatusEventType returns the event type for an order entering
+// statusCode, or an empty string when entering it is not an event.
+func orderStatusEventType(statusCode string) string {
+	switch statusCode {
+	case models.OrderStatusCodePaid:
+		return models.EventTypeOrderPaid
+	case models.OrderStatusCodeShipped:
+		return models.EventTypeOrderShipped
+	case models.OrderStatusCodeCompleted:
+		return models.EventTypeOrderCompleted
+	case models.OrderStatusCodeCanceled:
+		return models.EventTypeOrderCanceled
+	default:
+		return ""
+	}
+}
+
+type productEventData struct {
+	ProductID   uint         `json:"product_id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Category    string       `json:"category"`
+	Price       money.Amount `json:"price"`
+	ImageURL    string       `json:"image_url"`
+	// Stock is null when the product's stock is not tracked.
+	Stock       *int `json:"stock"`
+	WeightGrams int  `json:"weight_grams"`
+}
+
+func newProductOutboxEvent(eventType string, p *models.Product) (*models.OutboxEvent, error) {
+	return newOutboxEvent(eventType, "product", fmt.Sprint(p.ID), productEventData{
+		ProductID:   p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Category:    p.Category,
+		Price:       p.Price,
+		ImageURL:    p.ImageURL,
+		Stock:       p.Stock,
+		WeightGrams: p.WeightGrams,
+	})
+}
@@ -0,0 +1,508 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/integration"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	webhookDeliveryBatchSize = 20
+	// webhookDeliveryLease keeps a claimed delivery from being picked up
+	// again while it is sent. It outlasts a batch of timed out requests.
+	webhookDeliveryLease = 5 * time.Minute
+	// webhookMaxAttempts is how often a delivery is tried before it goes to
+	// the dead-letter list.
+	webhookMaxAttempts = 10
+	webhookFirstRetry  = 30 * time.Second
+	webhookMaxRetry    = 6 * time.Hour
+)
+
+type IIntegrationService interface {
+	CreateWebhookSubscription(ctx context.Context, req *integration.CreateWebhookSubscriptionRequest) (*integration.CreateWebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(ctx context.Context, req *integration.UpdateWebhookSubscriptionRequest) (*integration.UpdateWebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, req *integration.DeleteWebhookSubscriptionRequest) (*integration.DeleteWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, req *integration.ListWebhookSubscriptionsRequest) (*integration.ListWebhookSubscriptionsResponse, error)
+	PingWebhookSubscription(ctx context.Context, req *integration.PingWebhookSubscriptionRequest) (*integration.PingWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, req *integration.ListWebhookDeliveriesRequest) (*integration.ListWebhookDeliveriesResponse, error)
+	RetryWebhookDelivery(ctx context.Context, req *integration.RetryWebhookDeliveryRequest) (*integration.RetryWebhookDeliveryResponse, error)
+	DeliverWebhooks(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type integrationService struct {
+	webhookSubscriptionRepository repositories.IWebhookSubscriptionRepository
+	outboxRepository              repositories.IOutboxRepository
+	sender                        webhook.Sender
+}
+
+func (is *integrationService) CreateWebhookSubscription(ctx context.Context, req *integration.CreateWebhookSubscriptionRequest) (*integration.CreateWebhookSubscriptionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create webhook subscription")
+	}
+
+	if msg := validateWebhookSubscription(req.Url, req.EventTypes); msg != "" {
+		return &integration.CreateWebhookSubscriptionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate webhook secret")
+	}
+
+	newSubscription := &models.WebhookSubscription{
+		Name:       req.Name,
+		URL:        req.Url,
+		Secret:     secret,
+		EventTypes: req.EventTypes,
+		IsActive:   req.IsActive,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	err = is.webhookSubscriptionRepository.CreateSubscription(ctx, newSubscription)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create webhook subscription: %v", err))
+	}
+
+	return &integration.CreateWebhookSubscriptionResponse{
+		Base:   utils.SuccessResponse("Webhook subscription created successfully"),
+		Id:     uint64(newSubscription.ID),
+		Secret: secret,
+	}, nil
+}
+
+func (is *integrationService) UpdateWebhookSubscription(ctx context.Context, req *integration.UpdateWebhookSubscriptionRequest) (*integration.UpdateWebhookSubscriptionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update webhook subscription")
+	}
+
+	existingSubscription, err := is.webhookSubscriptionRepository.GetSubscriptionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingSubscription == nil {
+		return &integration.UpdateWebhookSubscriptionResponse{
+			Base: utils.NotFoundResponse("Webhook subscription not found"),
+		}, nil
+	}
+
+	if msg := validateWebhookSubscription(req.Url, req.EventTypes); msg != "" {
+		return &integration.UpdateWebhookSubscriptionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	secret := ""
+	if req.RotateSecret {
+		secret, err = webhook.NewSecret()
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to generate webhook secret")
+		}
+		existingSubscription.Secret = secret
+	}
+
+	existingSubscription.Name = req.Name
+	existingSubscription.URL = req.Url
+	existingSubscription.EventTypes = req.EventTypes
+	existingSubscription.IsActive = req.IsActive
+
+	now := time.Now()
+	existingSubscription.UpdatedAt = &now
+	existingSubscription.UpdatedBy = &claims.FullName
+
+	err = is.webhookSubscriptionRepository.UpdateSubscription(ctx, existingSubscription)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update webhook subscription: %v", err))
+	}
+
+	return &integration.UpdateWebhookSubscriptionResponse{
+		Base:   utils.SuccessResponse("Webhook subscription updated successfully"),
+		Secret: secret,
+	}, nil
+}
+
+func (is *integrationService) DeleteWebhookSubscription(ctx context.Context, req *integration.DeleteWebhookSubscriptionRequest) (*integration.DeleteWebhookSubscriptionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can delete webhook subscription")
+	}
+
+	existingSubscription, err := is.webhookSubscriptionRepository.GetSubscriptionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingSubscription == nil {
+		return &integration.DeleteWebhookSubscriptionResponse{
+			Base: utils.NotFoundResponse("Webhook subscription not found"),
+		}, nil
+	}
+
+	existingSubscription.DeletedBy = &claims.FullName
+
+	err = is.webhookSubscriptionRepository.DeleteSubscription(ctx, existingSubscription)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete webhook subscription: %v", err))
+	}
+
+	return &integration.DeleteWebhookSubscriptionResponse{
+		Base: utils.SuccessResponse("Webhook subscription deleted successfully"),
+	}, nil
+}
+
+func (is *integrationService) ListWebhookSubscriptions(ctx context.Context, req *integration.ListWebhookSubscriptionsRequest) (*integration.ListWebhookSubscriptionsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	subscriptions, err := is.webhookSubscriptionRepository.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*integration.WebhookSubscription, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		data = append(data, &integration.WebhookSubscription{
+			Id:         uint64(s.ID),
+			Name:       s.Name,
+			Url:        s.URL,
+			EventTypes: s.EventTypes,
+			IsActive:   s.IsActive,
+			CreatedAt:  utils.ConvertTimeToTimestamp(s.CreatedAt),
+		})
+	}
+
+	return &integration.ListWebhookSubscriptionsResponse{
+		Base:       utils.SuccessResponse("Webhook subscriptions retrieved successfully"),
+		Data:       data,
+		EventTypes: models.EventTypes,
+	}, nil
+}
+
+// PingWebhookSubscription queues a webhook.ping event for one subscription,
+// to check the receiving end without waiting for a real event.
+func (is *integrationService) PingWebhookSubscription(ctx context.Context, req *integration.PingWebhookSubscriptionRequest) (*integration.PingWebhookSubscriptionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can ping webhook subscription")
+	}
+
+	existingSubscription, err := is.webhookSubscriptionRepository.GetSubscriptionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingSubscription == nil {
+		return &integration.PingWebhookSubscriptionResponse{
+			Base: utils.NotFoundResponse("Webhook subscription not found"),
+		}, nil
+	}
+
+	if !existingSubscription.IsActive {
+		return &integration.PingWebhookSubscriptionResponse{
+			Base: utils.BadRequestResponse("Activate the webhook subscription before pinging it"),
+		}, nil
+	}
+
+	event, err := newOutboxEvent(models.EventTypeWebhookPing, "webhook_subscription", fmt.Sprint(existingSubscription.ID), map[string]interface{}{
+		"subscription_id": existingSubscription.ID,
+		"requested_by":    claims.FullName,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to build ping event")
+	}
+	event.SubscriptionID = &existingSubscription.ID
+
+	err = is.outboxRepository.CreateOutboxEvent(ctx, event)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save ping event")
+	}
+
+	return &integration.PingWebhookSubscriptionResponse{
+		Base: utils.SuccessResponse("Ping queued, see the delivery log for the result"),
+	}, nil
+}
+
+func (is *integrationService) ListWebhookDeliveries(ctx context.Context, req *integration.ListWebhookDeliveriesRequest) (*integration.ListWebhookDeliveriesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	filter := &repositories.WebhookDeliveryFilter{
+		SubscriptionID: uint(req.SubscriptionId),
+		Status:         req.Status,
+		EventType:      req.EventType,
+	}
+
+	deliveries, paginationResponse, err := is.webhookSubscriptionRepository.GetDeliveriesPagination(ctx, filter, pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &integration.ListWebhookDeliveriesResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get webhook deliveries")
+	}
+
+	data := make([]*integration.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		item := &integration.WebhookDelivery{
+			Id:             uint64(d.ID),
+			SubscriptionId: uint64(d.SubscriptionID),
+			EventId:        uint64(d.OutboxEventID),
+			EventType:      d.EventType,
+			Status:         d.Status,
+			Attempts:       int32(d.Attempts),
+			LastAttemptAt:  optionalTimeToProto(d.LastAttemptAt),
+			ResponseStatus: int32(d.ResponseStatus),
+			LastError:      d.LastError,
+			DeliveredAt:    optionalTimeToProto(d.DeliveredAt),
+			CreatedAt:      utils.ConvertTimeToTimestamp(d.CreatedAt),
+		}
+		if d.Status == models.WebhookDeliveryStatusPending {
+			item.NextAttemptAt = utils.ConvertTimeToTimestamp(d.NextAttemptAt)
+		}
+		data = append(data, item)
+	}
+
+	return &integration.ListWebhookDeliveriesResponse{
+		Base:       utils.SuccessResponse("Webhook deliveries retrieved successfully"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+// RetryWebhookDelivery takes a delivery off the dead-letter list and gives
+// it a fresh set of attempts.
+func (is *integrationService) RetryWebhookDelivery(ctx context.Context, req *integration.RetryWebhookDeliveryRequest) (*integration.RetryWebhookDeliveryResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can retry webhook delivery")
+	}
+
+	delivery, err := is.webhookSubscriptionRepository.GetDeliveryByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery == nil {
+		return &integration.RetryWebhookDeliveryResponse{
+			Base: utils.NotFoundResponse("Webhook delivery not found"),
+		}, nil
+	}
+
+	if delivery.Status != models.WebhookDeliveryStatusDead {
+		return &integration.RetryWebhookDeliveryResponse{
+			Base: utils.BadRequestResponse("Only dead deliveries can be retried"),
+		}, nil
+	}
+
+	delivery.Status = models.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+
+	err = is.webhookSubscriptionRepository.UpdateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retry webhook delivery: %v", err))
+	}
+
+	return &integration.RetryWebhookDeliveryResponse{
+		Base: utils.SuccessResponse("Webhook delivery queued for retry"),
+	}, nil
+}
+
+// DeliverWebhooks sends one batch of due deliveries and returns how many it
+// tried.
+func (is *integrationService) DeliverWebhooks(ctx context.Context) (int, error) {
+	deliveries, err := is.webhookSubscriptionRepository.ClaimDueDeliveries(ctx, time.Now(), webhookDeliveryLease, webhookDeliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		is.deliver(ctx, delivery)
+
+		err = is.webhookSubscriptionRepository.UpdateDelivery(ctx, delivery)
+		if err != nil {
+			log.Printf("failed to save webhook delivery %d: %v", delivery.ID, err)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver makes one attempt and records its outcome on the delivery.
+func (is *integrationService) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	subscription := delivery.Subscription
+	if subscription == nil || subscription.IsDeleted || !subscription.IsActive || delivery.OutboxEvent == nil {
+		delivery.Status = models.WebhookDeliveryStatusDead
+		delivery.LastError = "subscription was deleted or deactivated"
+		return
+	}
+
+	body, err := json.Marshal(domainEventEnvelope{
+		ID:         domainEventID(delivery.OutboxEvent.ID),
+		Type:       delivery.OutboxEvent.EventType,
+		OccurredAt: delivery.OutboxEvent.OccurredAt,
+		Data:       delivery.OutboxEvent.Payload,
+	})
+	if err != nil {
+		delivery.Status = models.WebhookDeliveryStatusDead
+		delivery.LastError = fmt.Sprintf("failed to build body: %v", err)
+		return
+	}
+
+	responseStatus, err := is.sender.Send(ctx, webhook.Delivery{
+		URL:       subscription.URL,
+		Secret:    subscription.Secret,
+		EventID:   domainEventID(delivery.OutboxEvent.ID),
+		EventType: delivery.OutboxEvent.EventType,
+		Body:      body,
+	})
+	delivery.ResponseStatus = responseStatus
+	if err == nil {
+		delivery.Status = models.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if len(delivery.LastError) > 1000 {
+		delivery.LastError = delivery.LastError[:1000]
+	}
+
+	if delivery.Attempts >= webhookMaxAttempts {
+		delivery.Status = models.WebhookDeliveryStatusDead
+		return
+	}
+	delivery.NextAttemptAt = now.Add(webhookRetryDelay(delivery.Attempts))
+}
+
+// Run sends due deliveries every interval until ctx is done.
+func (is *integrationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				delivered, err := is.DeliverWebhooks(ctx)
+				if err != nil {
+					log.Printf("failed to deliver webhooks: %v", err)
+				}
+				if err != nil || delivered < webhookDeliveryBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// webhookRetryDelay doubles the wait after every failed attempt, starting
+// at webhookFirstRetry and capped at webhookMaxRetry.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookFirstRetry
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookMaxRetry {
+			return webhookMaxRetry
+		}
+	}
+	return delay
+}
+
+// validateWebhookSubscription returns a message when the URL or event types
+// cannot be used, or an empty string when they can.
+func validateWebhookSubscription(rawURL string, eventTypes []string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "Webhook URL must be an http or https URL"
+	}
+
+	known := make(map[string]bool)
+	for _, eventType := range models.EventTypes {
+		known[eventType] = true
+	}
+	for _, eventType := range eventTypes {
+		if !known[eventType] {
+			return fmt.Sprintf("Unknown event type %s", eventType)
+		}
+	}
+
+	return ""
+}
+
+func NewIntegrationService(webhookSubscriptionRepository repositories.IWebhookSubscriptionRepository, outboxRepository repositories.IOutboxRepository, sender webhook.Sender) IIntegrationService {
+	return &integrationService{
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		outboxRepository:              outboxRepository,
+		sender:                        sender,
+	}
+}
@@ -0,0 +1,579 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	stdos "os"
+	"strconv"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/newsletter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// newsletterCampaignLease is how long a claimed delivery is kept from
+	// other workers. A batch is sized to go out well within it.
+	newsletterCampaignLease = 5 * time.Minute
+	newsletterCampaignBatch = 100
+	// newsletterDefaultSendRate is how many campaign emails go out per
+	// minute when NEWSLETTER_SEND_RATE is not set.
+	newsletterDefaultSendRate = 60
+)
+
+var (
+	// editableCampaignStatuses are the statuses of a campaign that has not
+	// started sending, so it can still be changed or rescheduled.
+	editableCampaignStatuses = []string{models.NewsletterCampaignStatusDraft, models.NewsletterCampaignStatusScheduled}
+	// openCampaignStatuses are the statuses of a campaign that can still be
+	// canceled.
+	openCampaignStatuses = []string{models.NewsletterCampaignStatusDraft, models.NewsletterCampaignStatusScheduled, models.NewsletterCampaignStatusSending}
+)
+
+func (ns *newsletterService) CreateCampaign(ctx context.Context, req *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create campaign")
+	}
+
+	if msg := validateCampaignContent(req.Content); msg != "" {
+		return &newsletter.CreateCampaignResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	campaign := &models.NewsletterCampaign{
+		Status: models.NewsletterCampaignStatusDraft,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+	setCampaignContent(campaign, req.Content)
+
+	err = ns.campaignRepository.CreateCampaign(ctx, campaign)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create campaign")
+	}
+
+	return &newsletter.CreateCampaignResponse{
+		Base: utils.SuccessResponse("Campaign created successfully"),
+		Id:   uint64(campaign.ID),
+	}, nil
+}
+
+// UpdateCampaign changes a campaign that has not started sending.
+func (ns *newsletterService) UpdateCampaign(ctx context.Context, req *newsletter.UpdateCampaignRequest) (*newsletter.UpdateCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update campaign")
+	}
+
+	campaign, err := ns.campaignRepository.GetCampaignByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return &newsletter.UpdateCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	if campaign.Status != models.NewsletterCampaignStatusDraft && campaign.Status != models.NewsletterCampaignStatusScheduled {
+		return &newsletter.UpdateCampaignResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Campaign is %s and can no longer be changed", campaign.Status)),
+		}, nil
+	}
+
+	if msg := validateCampaignContent(req.Content); msg != "" {
+		return &newsletter.UpdateCampaignResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	fromStatus := campaign.Status
+	now := time.Now()
+	setCampaignContent(campaign, req.Content)
+	campaign.UpdatedAt = &now
+	campaign.UpdatedBy = &claims.FullName
+
+	updated, err := ns.campaignRepository.UpdateCampaign(ctx, campaign, editableCampaignStatuses)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update campaign")
+	}
+	if !updated {
+		return nil, status.Errorf(codes.Aborted, "campaign status was changed from %s by another request", fromStatus)
+	}
+
+	return &newsletter.UpdateCampaignResponse{
+		Base: utils.SuccessResponse("Campaign updated successfully"),
+	}, nil
+}
+
+// SendTestCampaign mails the campaign to one address so it can be checked
+// before it is scheduled. The links in it do not name a subscriber.
+func (ns *newsletterService) SendTestCampaign(ctx context.Context, req *newsletter.SendTestCampaignRequest) (*newsletter.SendTestCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can send test campaign")
+	}
+
+	campaign, err := ns.campaignRepository.GetCampaignByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return &newsletter.SendTestCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	tmpl, err := parseCampaignEmail(campaign.Subject, campaign.HTMLBody, campaign.TextBody)
+	if err != nil {
+		return &newsletter.SendTestCampaignResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Campaign body is not a valid template: %v", err)),
+		}, nil
+	}
+
+	locale := campaign.Locale
+	if locale == "" {
+		locale = models.NotificationLocaleIndonesian
+	}
+
+	message, err := renderCampaignEmail(tmpl, locale, campaignRecipient{
+		Name:           claims.FullName,
+		Email:          req.Email,
+		PreferencesURL: newsletterFrontendURL("/newsletter/preferences", ""),
+		UnsubscribeURL: ns.UnsubscribePageURL(""),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to render campaign")
+	}
+	message.To = req.Email
+	message.Subject = "[TEST] " + message.Subject
+
+	err = ns.transport.Send(ctx, *message)
+	if err != nil {
+		log.Printf("failed to send test of newsletter campaign %d to %s: %v", campaign.ID, req.Email, err)
+		return nil, status.Error(codes.Internal, "failed to send test email")
+	}
+
+	return &newsletter.SendTestCampaignResponse{
+		Base: utils.SuccessResponse("Test email sent"),
+	}, nil
+}
+
+// ScheduleCampaign sets when a draft or scheduled campaign starts sending.
+// Its recipients are the subscribers in its segment at that time.
+func (ns *newsletterService) ScheduleCampaign(ctx context.Context, req *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can schedule campaign")
+	}
+
+	campaign, err := ns.campaignRepository.GetCampaignByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return &newsletter.ScheduleCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	if campaign.Status != models.NewsletterCampaignStatusDraft && campaign.Status != models.NewsletterCampaignStatusScheduled {
+		return &newsletter.ScheduleCampaignResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Campaign is %s and can no longer be scheduled", campaign.Status)),
+		}, nil
+	}
+
+	fromStatus := campaign.Status
+	now := time.Now()
+	sendAt := now
+	if req.SendAt != nil && req.SendAt.AsTime().After(now) {
+		sendAt = req.SendAt.AsTime()
+	}
+
+	campaign.Status = models.NewsletterCampaignStatusScheduled
+	campaign.ScheduledAt = &sendAt
+	campaign.UpdatedAt = &now
+	campaign.UpdatedBy = &claims.FullName
+
+	updated, err := ns.campaignRepository.UpdateCampaign(ctx, campaign, editableCampaignStatuses)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to schedule campaign")
+	}
+	if !updated {
+		return nil, status.Errorf(codes.Aborted, "campaign status was changed from %s by another request", fromStatus)
+	}
+
+	return &newsletter.ScheduleCampaignResponse{
+		Base: utils.SuccessResponse("Campaign scheduled successfully"),
+	}, nil
+}
+
+// CancelCampaign stops a campaign. One that is sending stops after the
+// emails already on their way.
+func (ns *newsletterService) CancelCampaign(ctx context.Context, req *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can cancel campaign")
+	}
+
+	campaign, err := ns.campaignRepository.GetCampaignByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return &newsletter.CancelCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	if campaign.Status == models.NewsletterCampaignStatusSent || campaign.Status == models.NewsletterCampaignStatusCanceled {
+		return &newsletter.CancelCampaignResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Campaign is already %s", campaign.Status)),
+		}, nil
+	}
+
+	fromStatus := campaign.Status
+	now := time.Now()
+	campaign.Status = models.NewsletterCampaignStatusCanceled
+	campaign.FinishedAt = &now
+	campaign.UpdatedAt = &now
+	campaign.UpdatedBy = &claims.FullName
+
+	updated, err := ns.campaignRepository.UpdateCampaign(ctx, campaign, openCampaignStatuses)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to cancel campaign")
+	}
+	if !updated {
+		return nil, status.Errorf(codes.Aborted, "campaign status was changed from %s by another request", fromStatus)
+	}
+
+	return &newsletter.CancelCampaignResponse{
+		Base: utils.SuccessResponse("Campaign canceled successfully"),
+	}, nil
+}
+
+func (ns *newsletterService) ListCampaigns(ctx context.Context, req *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	filter := &repositories.NewsletterCampaignFilter{
+		Status: req.Status,
+	}
+
+	campaigns, paginationResponse, err := ns.campaignRepository.GetCampaignsPagination(ctx, filter, pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &newsletter.ListCampaignsResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get campaigns")
+	}
+
+	ids := make([]uint, 0, len(campaigns))
+	for _, c := range campaigns {
+		ids = append(ids, c.ID)
+	}
+
+	counts, err := ns.campaignRepository.CountDeliveries(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get campaign stats")
+	}
+
+	data := make([]*newsletter.Campaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		data = append(data, campaignToProto(c, counts[c.ID]))
+	}
+
+	return &newsletter.ListCampaignsResponse{
+		Base:       utils.SuccessResponse("Campaigns retrieved successfully"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+func (ns *newsletterService) DetailCampaign(ctx context.Context, req *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	campaign, err := ns.campaignRepository.GetCampaignByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign == nil {
+		return &newsletter.DetailCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	counts, err := ns.campaignRepository.CountDeliveries(ctx, []uint{campaign.ID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get campaign stats")
+	}
+
+	return &newsletter.DetailCampaignResponse{
+		Base: utils.SuccessResponse("Get campaign detail success"),
+		Data: campaignToProto(campaign, counts[campaign.ID]),
+	}, nil
+}
+
+// SendCampaigns starts the campaigns that are due and sends one batch of
+// their emails, no faster than the send rate. It returns how many
+// deliveries it handled.
+func (ns *newsletterService) SendCampaigns(ctx context.Context) (int, error) {
+	_, err := ns.campaignRepository.StartDueCampaigns(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	rate := newsletterSendRate()
+	batch := rate
+	if batch > newsletterCampaignBatch {
+		batch = newsletterCampaignBatch
+	}
+
+	deliveries, err := ns.campaignRepository.ClaimPendingDeliveries(ctx, time.Now(), newsletterCampaignLease, batch)
+	if err != nil {
+		return 0, err
+	}
+
+	pace := time.NewTicker(time.Minute / time.Duration(rate))
+	defer pace.Stop()
+
+	templates := make(map[uint]*campaignEmailTemplates)
+	for _, delivery := range deliveries {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-pace.C:
+		}
+
+		ns.deliverCampaign(ctx, delivery, templates)
+
+		err = ns.campaignRepository.UpdateDelivery(ctx, delivery)
+		if err != nil {
+			log.Printf("failed to save newsletter delivery %d: %v", delivery.ID, err)
+		}
+	}
+
+	_, err = ns.campaignRepository.FinishSentCampaigns(ctx, time.Now())
+	if err != nil {
+		return len(deliveries), err
+	}
+
+	return len(deliveries), nil
+}
+
+// deliverCampaign sends the campaign to one recipient and records the
+// outcome on the delivery. Recipients who unsubscribed, or dropped the
+// campaign's topic, since it started are skipped.
+func (ns *newsletterService) deliverCampaign(ctx context.Context, delivery *models.NewsletterDelivery, templates map[uint]*campaignEmailTemplates) {
+	delivery.LeaseUntil = nil
+
+	subscriber := delivery.Newsletter
+	campaign := delivery.Campaign
+	if subscriber == nil || subscriber.IsDeleted || subscriber.Status != models.NewsletterStatusSubscribed || !wantsNewsletterTopic(subscriber, campaign.Topic) {
+		delivery.Status = models.NewsletterDeliveryStatusUnsubscribed
+		return
+	}
+
+	tmpl, exists := templates[campaign.ID]
+	if !exists {
+		var err error
+		tmpl, err = parseCampaignEmail(campaign.Subject, campaign.HTMLBody, campaign.TextBody)
+		if err != nil {
+			delivery.Status = models.NewsletterDeliveryStatusFailed
+			delivery.Error = fmt.Sprintf("failed to parse campaign: %v", err)
+			return
+		}
+		templates[campaign.ID] = tmpl
+	}
+
+	token := ns.signToken(subscriber.ID)
+	message, err := renderCampaignEmail(tmpl, subscriber.Locale, campaignRecipient{
+		Name:           subscriber.FullName,
+		Email:          subscriber.Email,
+		PreferencesURL: newsletterFrontendURL("/newsletter/preferences", token),
+		UnsubscribeURL: ns.UnsubscribePageURL(token),
+	})
+	if err != nil {
+		delivery.Status = models.NewsletterDeliveryStatusFailed
+		delivery.Error = fmt.Sprintf("failed to render campaign: %v", err)
+		return
+	}
+	message.To = subscriber.Email
+	message.ToName = subscriber.FullName
+	message.Headers = ns.unsubscribeHeaders(token)
+
+	err = ns.transport.Send(ctx, *message)
+	if err != nil {
+		delivery.Status = models.NewsletterDeliveryStatusFailed
+		delivery.Error = err.Error()
+		if len(delivery.Error) > 1000 {
+			delivery.Error = delivery.Error[:1000]
+		}
+		return
+	}
+
+	now := time.Now()
+	delivery.Status = models.NewsletterDeliveryStatusSent
+	delivery.SentAt = &now
+	delivery.Error = ""
+}
+
+// Run sends campaign emails every interval until ctx is done, draining full
+// batches before waiting for the next tick.
+func (ns *newsletterService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				handled, err := ns.SendCampaigns(ctx)
+				if err != nil {
+					log.Printf("failed to send newsletter campaigns: %v", err)
+				}
+				if err != nil || handled == 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// validateCampaignContent returns a message when the campaign cannot be
+// sent as written, or an empty string when it can.
+func validateCampaignContent(content *newsletter.CampaignContent) string {
+	if content.Topic != "" {
+		_, msg := normalizeNewsletterTopics([]string{content.Topic})
+		if msg != "" {
+			return msg
+		}
+	}
+
+	_, err := parseCampaignEmail(content.Subject, content.HtmlBody, content.TextBody)
+	if err != nil {
+		return fmt.Sprintf("Campaign body is not a valid template: %v", err)
+	}
+
+	return ""
+}
+
+func setCampaignContent(campaign *models.NewsletterCampaign, content *newsletter.CampaignContent) {
+	campaign.Name = content.Name
+	campaign.Subject = content.Subject
+	campaign.HTMLBody = content.HtmlBody
+	campaign.TextBody = content.TextBody
+	campaign.Topic = content.Topic
+	campaign.Locale = content.Locale
+}
+
+func campaignToProto(c *models.NewsletterCampaign, counts map[string]int64) *newsletter.Campaign {
+	stats := &newsletter.CampaignStats{
+		Pending:      counts[models.NewsletterDeliveryStatusPending],
+		Sent:         counts[models.NewsletterDeliveryStatusSent],
+		Failed:       counts[models.NewsletterDeliveryStatusFailed],
+		Unsubscribed: counts[models.NewsletterDeliveryStatusUnsubscribed],
+	}
+	stats.Recipients = stats.Pending + stats.Sent + stats.Failed + stats.Unsubscribed
+
+	return &newsletter.Campaign{
+		Id:          uint64(c.ID),
+		Name:        c.Name,
+		Subject:     c.Subject,
+		HtmlBody:    c.HTMLBody,
+		TextBody:    c.TextBody,
+		Topic:       c.Topic,
+		Locale:      c.Locale,
+		Status:      c.Status,
+		Stats:       stats,
+		ScheduledAt: optionalTimeToProto(c.ScheduledAt),
+		StartedAt:   optionalTimeToProto(c.StartedAt),
+		FinishedAt:  optionalTimeToProto(c.FinishedAt),
+		CreatedAt:   utils.ConvertTimeToTimestamp(c.CreatedAt),
+	}
+}
+
+// wantsNewsletterTopic reports whether the subscriber gets emails about the
+// topic. An empty topic is for everyone.
+func wantsNewsletterTopic(n *models.Newsletter, topic string) bool {
+	if topic == "" {
+		return true
+	}
+	for _, t := range subscriberTopics(n) {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// newsletterSendRate is how many campaign emails may go out per minute,
+// read from NEWSLETTER_SEND_RATE.
+func newsletterSendRate() int {
+	rate, err := strconv.Atoi(stdos.Getenv("NEWSLETTER_SEND_RATE"))
+	if err != nil || rate <= 0 {
+		rate = newsletterDefaultSendRate
+	}
+	return rate
+}
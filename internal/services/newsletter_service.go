@@ -1,51 +1,647 @@
 package services
 
 import (
+	"bufio"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	stdos "os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
 	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
 	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/newsletter"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/export"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/mail"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	newsletterConfirmationTTL = 48 * time.Hour
+	// newsletterResendInterval keeps Subscribe from mailing the same address
+	// over and over.
+	newsletterResendInterval = time.Minute
+	newsletterExportBatch    = 500
+	newsletterExportChunk    = 32 * 1024
+)
+
+// newsletterSubscribeMessage is the answer to every Subscribe, so it does
+// not tell who is subscribed already.
+const newsletterSubscribeMessage = "Check your inbox to confirm your subscription"
+
+var newsletterExportHeader = []string{
+	"Email",
+	"Name",
+	"Status",
+	"Topics",
+	"Locale",
+	"Confirmed At",
+	"Unsubscribed At",
+	"Created At",
+}
+
 type INewsletterService interface {
 	Subscribe(ctx context.Context, req *newsletter.SubscribeRequest) (*newsletter.SubscribeResponse, error)
+	ConfirmSubscription(ctx context.Context, req *newsletter.ConfirmSubscriptionRequest) (*newsletter.ConfirmSubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, req *newsletter.UnsubscribeRequest) (*newsletter.UnsubscribeResponse, error)
+	GetPreferences(ctx context.Context, req *newsletter.GetPreferencesRequest) (*newsletter.GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, req *newsletter.UpdatePreferencesRequest) (*newsletter.UpdatePreferencesResponse, error)
+	ListSubscribers(ctx context.Context, req *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error)
+	ExportSubscribers(req *newsletter.ExportSubscribersRequest, stream newsletter.NewsletterService_ExportSubscribersServer) error
+	WriteSubscriberExport(ctx context.Context, req *newsletter.ExportSubscribersRequest, w io.Writer) error
+	UnsubscribePageURL(token string) string
+	CreateCampaign(ctx context.Context, req *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error)
+	UpdateCampaign(ctx context.Context, req *newsletter.UpdateCampaignRequest) (*newsletter.UpdateCampaignResponse, error)
+	SendTestCampaign(ctx context.Context, req *newsletter.SendTestCampaignRequest) (*newsletter.SendTestCampaignResponse, error)
+	ScheduleCampaign(ctx context.Context, req *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error)
+	CancelCampaign(ctx context.Context, req *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error)
+	ListCampaigns(ctx context.Context, req *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error)
+	DetailCampaign(ctx context.Context, req *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error)
+	SendCampaigns(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
 }
 
 type newsletterService struct {
 	newsletterRepository repositories.INewsletterRepository
+	campaignRepository   repositories.INewsletterCampaignRepository
+	transport            mail.Transport
+	signingKey           []byte
 }
 
+// Subscribe sends a confirmation email. The address only gets newsletters
+// once the link in it is opened.
 func (ns *newsletterService) Subscribe(ctx context.Context, req *newsletter.SubscribeRequest) (*newsletter.SubscribeResponse, error) {
-	newsletterEntity, err := ns.newsletterRepository.GetNewsletterByEmail(ctx, req.Email)
+	topics, msg := normalizeNewsletterTopics(req.Topics)
+	if msg != "" {
+		return &newsletter.SubscribeResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	locale := req.Locale
+	if locale == "" {
+		locale = models.NotificationLocaleIndonesian
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	newsletterEntity, err := ns.newsletterRepository.GetNewsletterByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
+
 	if newsletterEntity != nil {
-		return &newsletter.SubscribeResponse{
-			Base: utils.SuccessResponse("Subscribe newsletter success"),
+		if newsletterEntity.Status == models.NewsletterStatusSubscribed {
+			return &newsletter.SubscribeResponse{
+				Base: utils.SuccessResponse(newsletterSubscribeMessage),
+			}, nil
+		}
+
+		sentAt := newsletterEntity.ConfirmationSentAt
+		if newsletterEntity.Status == models.NewsletterStatusPending && sentAt != nil && time.Since(*sentAt) < newsletterResendInterval {
+			return &newsletter.SubscribeResponse{
+				Base: utils.SuccessResponse(newsletterSubscribeMessage),
+			}, nil
+		}
+	} else {
+		newsletterEntity = &models.Newsletter{
+			Email: email,
+			BaseModel: models.BaseModel{
+				CreatedAt: time.Now(),
+				CreatedBy: "System",
+			},
+		}
+	}
+
+	token, err := newConfirmationToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create confirmation token")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(newsletterConfirmationTTL)
+	newsletterEntity.FullName = req.Name
+	newsletterEntity.Status = models.NewsletterStatusPending
+	newsletterEntity.Topics = topics
+	newsletterEntity.Locale = locale
+	newsletterEntity.ConfirmationTokenHash = hashConfirmationToken(token)
+	newsletterEntity.ConfirmationSentAt = &now
+	newsletterEntity.ConfirmationExpiresAt = &expiresAt
+
+	if newsletterEntity.ID == 0 {
+		created, err := ns.newsletterRepository.CreateNewsletter(ctx, newsletterEntity)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			// Another request subscribed the address first and mails it.
+			return &newsletter.SubscribeResponse{
+				Base: utils.SuccessResponse(newsletterSubscribeMessage),
+			}, nil
+		}
+	} else {
+		newsletterEntity.UpdatedAt = &now
+		newsletterEntity.UpdatedBy = &newsletterEntity.Email
+		err = ns.newsletterRepository.UpdateNewsletter(ctx, newsletterEntity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := renderNoticeEmail(locale, emailLocaleFor(locale).newsletterConfirm, newsletterEntity.FullName, newsletterFrontendURL("/newsletter/confirm", token), nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to render confirmation email")
+	}
+	message.To = newsletterEntity.Email
+	message.ToName = newsletterEntity.FullName
+
+	err = ns.transport.Send(ctx, *message)
+	if err != nil {
+		log.Printf("failed to send newsletter confirmation to %s: %v", newsletterEntity.Email, err)
+		return nil, status.Error(codes.Internal, "failed to send confirmation email")
+	}
+
+	return &newsletter.SubscribeResponse{
+		Base: utils.SuccessResponse(newsletterSubscribeMessage),
+	}, nil
+}
+
+func (ns *newsletterService) ConfirmSubscription(ctx context.Context, req *newsletter.ConfirmSubscriptionRequest) (*newsletter.ConfirmSubscriptionResponse, error) {
+	newsletterEntity, err := ns.newsletterRepository.GetNewsletterByConfirmationToken(ctx, hashConfirmationToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if newsletterEntity == nil ||
+		newsletterEntity.Status != models.NewsletterStatusPending ||
+		newsletterEntity.ConfirmationExpiresAt == nil ||
+		now.After(*newsletterEntity.ConfirmationExpiresAt) {
+		return &newsletter.ConfirmSubscriptionResponse{
+			Base: utils.BadRequestResponse("Confirmation link is invalid or has expired"),
 		}, nil
 	}
 
-	newNewsletterEntity := &models.Newsletter{
-		ID:       0,
-		FullName: req.Name,
-		Email:    req.Email,
+	newsletterEntity.Status = models.NewsletterStatusSubscribed
+	newsletterEntity.ConfirmationTokenHash = ""
+	newsletterEntity.ConfirmationExpiresAt = nil
+	newsletterEntity.ConfirmedAt = &now
+	newsletterEntity.UnsubscribedAt = nil
+	newsletterEntity.UpdatedAt = &now
+	newsletterEntity.UpdatedBy = &newsletterEntity.Email
+
+	err = ns.newsletterRepository.UpdateNewsletter(ctx, newsletterEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	// The subscription stands even when the welcome email does not go out.
+	err = ns.sendWelcome(ctx, newsletterEntity)
+	if err != nil {
+		log.Printf("failed to send newsletter welcome to %s: %v", newsletterEntity.Email, err)
 	}
 
-	err = ns.newsletterRepository.CreateNewsletter(ctx, newNewsletterEntity)
+	return &newsletter.ConfirmSubscriptionResponse{
+		Base: utils.SuccessResponse("Subscription confirmed"),
+	}, nil
+}
+
+func (ns *newsletterService) Unsubscribe(ctx context.Context, req *newsletter.UnsubscribeRequest) (*newsletter.UnsubscribeResponse, error) {
+	newsletterEntity, err := ns.getNewsletterByToken(ctx, req.Token)
 	if err != nil {
 		return nil, err
 	}
 
-	return &newsletter.SubscribeResponse{
-		Base: utils.SuccessResponse("Subscribe newsletter success"),
+	if newsletterEntity == nil {
+		return &newsletter.UnsubscribeResponse{
+			Base: utils.BadRequestResponse("Unsubscribe link is invalid"),
+		}, nil
+	}
+
+	if newsletterEntity.Status != models.NewsletterStatusUnsubscribed {
+		now := time.Now()
+		newsletterEntity.Status = models.NewsletterStatusUnsubscribed
+		newsletterEntity.UnsubscribedAt = &now
+		newsletterEntity.ConfirmationTokenHash = ""
+		newsletterEntity.ConfirmationExpiresAt = nil
+		newsletterEntity.UpdatedAt = &now
+		newsletterEntity.UpdatedBy = &newsletterEntity.Email
+
+		err = ns.newsletterRepository.UpdateNewsletter(ctx, newsletterEntity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &newsletter.UnsubscribeResponse{
+		Base: utils.SuccessResponse("You have been unsubscribed"),
+	}, nil
+}
+
+func (ns *newsletterService) GetPreferences(ctx context.Context, req *newsletter.GetPreferencesRequest) (*newsletter.GetPreferencesResponse, error) {
+	newsletterEntity, err := ns.getNewsletterByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	if newsletterEntity == nil {
+		return &newsletter.GetPreferencesResponse{
+			Base: utils.BadRequestResponse("Preferences link is invalid"),
+		}, nil
+	}
+
+	return &newsletter.GetPreferencesResponse{
+		Base:            utils.SuccessResponse("Get newsletter preferences success"),
+		Email:           newsletterEntity.Email,
+		Name:            newsletterEntity.FullName,
+		Status:          newsletterEntity.Status,
+		Topics:          subscriberTopics(newsletterEntity),
+		AvailableTopics: models.NewsletterTopics,
+	}, nil
+}
+
+// UpdatePreferences replaces the subscriber's topics. Someone who
+// unsubscribed is subscribed again, the token shows they read the address.
+func (ns *newsletterService) UpdatePreferences(ctx context.Context, req *newsletter.UpdatePreferencesRequest) (*newsletter.UpdatePreferencesResponse, error) {
+	topics, msg := normalizeNewsletterTopics(req.Topics)
+	if msg != "" {
+		return &newsletter.UpdatePreferencesResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	newsletterEntity, err := ns.getNewsletterByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	if newsletterEntity == nil {
+		return &newsletter.UpdatePreferencesResponse{
+			Base: utils.BadRequestResponse("Preferences link is invalid"),
+		}, nil
+	}
+
+	now := time.Now()
+	newsletterEntity.Topics = topics
+	if newsletterEntity.Status == models.NewsletterStatusUnsubscribed {
+		newsletterEntity.Status = models.NewsletterStatusSubscribed
+		newsletterEntity.UnsubscribedAt = nil
+	}
+	newsletterEntity.UpdatedAt = &now
+	newsletterEntity.UpdatedBy = &newsletterEntity.Email
+
+	err = ns.newsletterRepository.UpdateNewsletter(ctx, newsletterEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newsletter.UpdatePreferencesResponse{
+		Base: utils.SuccessResponse("Newsletter preferences updated successfully"),
 	}, nil
 }
 
-func NewNewsletterService(newsletterRepository repositories.INewsletterRepository) INewsletterService {
+func (ns *newsletterService) ListSubscribers(ctx context.Context, req *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	subscribers, paginationResponse, err := ns.newsletterRepository.GetNewslettersPagination(ctx, newsletterFilter(req), pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &newsletter.ListSubscribersResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get subscribers")
+	}
+
+	data := make([]*newsletter.Subscriber, 0, len(subscribers))
+	for _, n := range subscribers {
+		data = append(data, &newsletter.Subscriber{
+			Id:             uint64(n.ID),
+			Email:          n.Email,
+			Name:           n.FullName,
+			Status:         n.Status,
+			Topics:         subscriberTopics(n),
+			Locale:         n.Locale,
+			ConfirmedAt:    optionalTimeToProto(n.ConfirmedAt),
+			UnsubscribedAt: optionalTimeToProto(n.UnsubscribedAt),
+			CreatedAt:      utils.ConvertTimeToTimestamp(n.CreatedAt),
+		})
+	}
+
+	return &newsletter.ListSubscribersResponse{
+		Base:       utils.SuccessResponse("Subscribers retrieved successfully"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+// ExportSubscribers streams the export file in chunks. The first message
+// carries the file name and content type, and is only sent once the
+// request has been accepted.
+func (ns *newsletterService) ExportSubscribers(req *newsletter.ExportSubscribersRequest, stream newsletter.NewsletterService_ExportSubscribersServer) error {
+	filter, err := checkSubscriberExport(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&newsletter.ExportSubscribersResponse{
+		FileName:    SubscriberExportFileName(req.Format, time.Now()),
+		ContentType: export.ContentType(req.Format),
+	})
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&subscriberExportStreamWriter{stream: stream}, newsletterExportChunk)
+
+	err = ns.writeSubscriberExport(stream.Context(), filter, req.Format, w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// WriteSubscriberExport writes every subscriber matching the filter to w.
+// Nothing is written when the request is refused.
+func (ns *newsletterService) WriteSubscriberExport(ctx context.Context, req *newsletter.ExportSubscribersRequest, w io.Writer) error {
+	filter, err := checkSubscriberExport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ns.writeSubscriberExport(ctx, filter, req.Format, w)
+}
+
+// checkSubscriberExport refuses an export request from anyone but an admin,
+// or with an unsupported format, and returns the filter to export with.
+func checkSubscriberExport(ctx context.Context, req *newsletter.ExportSubscribersRequest) (*repositories.NewsletterFilter, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can export subscribers")
+	}
+
+	err = export.CheckFormat(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filterReq := req.Filter
+	if filterReq == nil {
+		filterReq = &newsletter.ListSubscribersRequest{}
+	}
+
+	return newsletterFilter(filterReq), nil
+}
+
+func (ns *newsletterService) writeSubscriberExport(ctx context.Context, filter *repositories.NewsletterFilter, format string, w io.Writer) error {
+	rows, err := export.NewRowWriter(format, w)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = rows.WriteRow(newsletterExportHeader)
+	if err != nil {
+		return err
+	}
+
+	pagination := &common.PaginationRequest{
+		PerPage:        newsletterExportBatch,
+		SkipTotalCount: true,
+	}
+
+	for {
+		subscribers, metadata, err := ns.newsletterRepository.GetNewslettersPagination(ctx, filter, pagination)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to get subscribers")
+		}
+
+		for _, n := range subscribers {
+			err = rows.WriteRow([]string{
+				n.Email,
+				n.FullName,
+				n.Status,
+				strings.Join(subscriberTopics(n), " "),
+				n.Locale,
+				formatOptionalTime(n.ConfirmedAt),
+				formatOptionalTime(n.UnsubscribedAt),
+				n.CreatedAt.Format(time.RFC3339),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if metadata.NextCursor == "" {
+			break
+		}
+		pagination.Cursor = metadata.NextCursor
+	}
+
+	return rows.Close()
+}
+
+// UnsubscribePageURL is where a browser opening an unsubscribe link is sent
+// to confirm it.
+func (ns *newsletterService) UnsubscribePageURL(token string) string {
+	return newsletterFrontendURL("/newsletter/unsubscribe", token)
+}
+
+func (ns *newsletterService) sendWelcome(ctx context.Context, n *models.Newsletter) error {
+	el := emailLocaleFor(n.Locale)
+	token := ns.signToken(n.ID)
+
+	message, err := renderNoticeEmail(n.Locale, el.newsletterWelcome, n.FullName, "", []noticeEmailLink{
+		{Label: el.labels.ManagePreferences, URL: newsletterFrontendURL("/newsletter/preferences", token)},
+		{Label: el.labels.Unsubscribe, URL: ns.UnsubscribePageURL(token)},
+	})
+	if err != nil {
+		return err
+	}
+	message.To = n.Email
+	message.ToName = n.FullName
+	message.Headers = ns.unsubscribeHeaders(token)
+
+	return ns.transport.Send(ctx, *message)
+}
+
+// unsubscribeHeaders lets mail clients offer one-click unsubscribe, see
+// RFC 8058. The link answers a POST without asking anything.
+func (ns *newsletterService) unsubscribeHeaders(token string) map[string]string {
+	apiURL := stdos.Getenv("API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return map[string]string{
+		"List-Unsubscribe":      fmt.Sprintf("<%s/newsletter/unsubscribe?token=%s>", strings.TrimRight(apiURL, "/"), url.QueryEscape(token)),
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+}
+
+// signToken returns a token naming the subscriber that cannot be forged
+// without the signing key. It does not expire, unsubscribe links have to
+// keep working.
+func (ns *newsletterService) signToken(id uint) string {
+	return fmt.Sprintf("%d.%s", id, base64.RawURLEncoding.EncodeToString(ns.tokenMAC(id)))
+}
+
+func (ns *newsletterService) tokenMAC(id uint) []byte {
+	mac := hmac.New(sha256.New, ns.signingKey)
+	fmt.Fprintf(mac, "newsletter:%d", id)
+	return mac.Sum(nil)
+}
+
+// getNewsletterByToken returns the subscriber a signed token names, or nil
+// when the token is not valid.
+func (ns *newsletterService) getNewsletterByToken(ctx context.Context, token string) (*models.Newsletter, error) {
+	idPart, signaturePart, found := strings.Cut(token, ".")
+	if !found {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseUint(idPart, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(signaturePart)
+	if err != nil || !hmac.Equal(signature, ns.tokenMAC(uint(id))) {
+		return nil, nil
+	}
+
+	return ns.newsletterRepository.GetNewsletterByID(ctx, uint(id))
+}
+
+func newsletterFilter(req *newsletter.ListSubscribersRequest) *repositories.NewsletterFilter {
+	return &repositories.NewsletterFilter{
+		Status: req.Status,
+		Topic:  req.Topic,
+		Email:  req.Email,
+	}
+}
+
+// normalizeNewsletterTopics checks the topics and drops repeats. No topics
+// means every topic.
+func normalizeNewsletterTopics(topics []string) ([]string, string) {
+	if len(topics) == 0 {
+		return models.NewsletterTopics, ""
+	}
+
+	known := make(map[string]bool)
+	for _, topic := range models.NewsletterTopics {
+		known[topic] = true
+	}
+
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if !known[topic] {
+			return nil, fmt.Sprintf("Unknown newsletter topic %s", topic)
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			normalized = append(normalized, topic)
+		}
+	}
+
+	return normalized, ""
+}
+
+// subscriberTopics returns the topics the subscriber gets.
+func subscriberTopics(n *models.Newsletter) []string {
+	if len(n.Topics) == 0 {
+		return models.NewsletterTopics
+	}
+	return n.Topics
+}
+
+func newsletterFrontendURL(path string, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", stdos.Getenv("FRONTEND_URL"), path, url.QueryEscape(token))
+}
+
+func newConfirmationToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashConfirmationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// SubscriberExportFileName names the export file after the time it was
+// made.
+func SubscriberExportFileName(format string, now time.Time) string {
+	return fmt.Sprintf("newsletter-subscribers-%s.%s", now.Format("20060102-150405"), format)
+}
+
+// subscriberExportStreamWriter sends every write as a chunk of the export
+// stream.
+type subscriberExportStreamWriter struct {
+	stream newsletter.NewsletterService_ExportSubscribersServer
+}
+
+func (sw *subscriberExportStreamWriter) Write(p []byte) (int, error) {
+	err := sw.stream.Send(&newsletter.ExportSubscribersResponse{
+		Chunk: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// NewNewsletterService signs unsubscribe and preference links with
+// signingKey.
+func NewNewsletterService(newsletterRepository repositories.INewsletterRepository, campaignRepository repositories.INewsletterCampaignRepository, transport mail.Transport, signingKey []byte) INewsletterService {
 	return &newsletterService{
 		newsletterRepository: newsletterRepository,
+		campaignRepository:   campaignRepository,
+		transport:            transport,
+		signingKey:           signingKey,
 	}
 }
@@ -0,0 +1,378 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/notification"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/mail"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	notificationBatchSize = 20
+	// notificationLease keeps a claimed notification from being picked up
+	// again while it is sent.
+	notificationLease = 5 * time.Minute
+	// notificationMaxAttempts is how often a notification is tried before it
+	// stays failed.
+	notificationMaxAttempts = 10
+	notificationFirstRetry  = time.Minute
+	notificationMaxRetry    = 6 * time.Hour
+)
+
+type INotificationService interface {
+	GetNotificationPreferences(ctx context.Context, req *notification.GetNotificationPreferencesRequest) (*notification.GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, req *notification.UpdateNotificationPreferencesRequest) (*notification.UpdateNotificationPreferencesResponse, error)
+	ListNotifications(ctx context.Context, req *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error)
+	SendNotifications(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type notificationService struct {
+	notificationRepository repositories.INotificationRepository
+	authRepository         repositories.IAuthRepository
+	orderRepository        repositories.IOrderRepository
+	transport              mail.Transport
+}
+
+func (ns *notificationService) GetNotificationPreferences(ctx context.Context, req *notification.GetNotificationPreferencesRequest) (*notification.GetNotificationPreferencesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	preference, err := ns.getPreference(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get notification preferences")
+	}
+
+	events := make([]*notification.NotificationEventPreference, 0, len(models.NotificationEventTypes))
+	for _, eventType := range models.NotificationEventTypes {
+		events = append(events, &notification.NotificationEventPreference{
+			EventType: eventType,
+			Email:     !isEventMuted(preference, eventType),
+		})
+	}
+
+	return &notification.GetNotificationPreferencesResponse{
+		Base:   utils.SuccessResponse("Get notification preferences success"),
+		Locale: preference.Locale,
+		Events: events,
+	}, nil
+}
+
+func (ns *notificationService) UpdateNotificationPreferences(ctx context.Context, req *notification.UpdateNotificationPreferencesRequest) (*notification.UpdateNotificationPreferencesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	preference, err := ns.getPreference(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get notification preferences")
+	}
+
+	wanted := make(map[string]bool)
+	for _, eventType := range models.NotificationEventTypes {
+		wanted[eventType] = !isEventMuted(preference, eventType)
+	}
+	for _, event := range req.Events {
+		if _, exists := wanted[event.EventType]; !exists {
+			return &notification.UpdateNotificationPreferencesResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Unknown notification event type %s", event.EventType)),
+			}, nil
+		}
+		wanted[event.EventType] = event.Email
+	}
+
+	preference.Locale = req.Locale
+	preference.MutedEventTypes = make([]string, 0)
+	for _, eventType := range models.NotificationEventTypes {
+		if !wanted[eventType] {
+			preference.MutedEventTypes = append(preference.MutedEventTypes, eventType)
+		}
+	}
+	preference.UpdatedAt = time.Now()
+
+	err = ns.notificationRepository.SavePreference(ctx, preference)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save notification preferences")
+	}
+
+	return &notification.UpdateNotificationPreferencesResponse{
+		Base: utils.SuccessResponse("Notification preferences updated successfully"),
+	}, nil
+}
+
+func (ns *notificationService) ListNotifications(ctx context.Context, req *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	filter := &repositories.NotificationFilter{
+		UserID:    uint(req.UserId),
+		Status:    req.Status,
+		EventType: req.EventType,
+	}
+
+	notifications, paginationResponse, err := ns.notificationRepository.GetNotificationsPagination(ctx, filter, pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &notification.ListNotificationsResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get notifications")
+	}
+
+	data := make([]*notification.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		data = append(data, &notification.Notification{
+			Id:        uint64(n.ID),
+			UserId:    uint64(n.UserID),
+			EventId:   uint64(n.OutboxEventID),
+			EventType: n.EventType,
+			Channel:   n.Channel,
+			Recipient: n.Recipient,
+			Subject:   n.Subject,
+			Locale:    n.Locale,
+			Status:    n.Status,
+			Error:     n.Error,
+			SentAt:    optionalTimeToProto(n.SentAt),
+			CreatedAt: utils.ConvertTimeToTimestamp(n.CreatedAt),
+		})
+	}
+
+	return &notification.ListNotificationsResponse{
+		Base:       utils.SuccessResponse("Notifications retrieved successfully"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+// SendNotifications sends one batch of due notifications and returns how
+// many it tried.
+func (ns *notificationService) SendNotifications(ctx context.Context) (int, error) {
+	notifications, err := ns.notificationRepository.ClaimDueNotifications(ctx, time.Now(), notificationLease, notificationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range notifications {
+		ns.send(ctx, n)
+
+		err = ns.notificationRepository.UpdateNotification(ctx, n)
+		if err != nil {
+			log.Printf("failed to save notification %d: %v", n.ID, err)
+		}
+	}
+
+	return len(notifications), nil
+}
+
+// send makes one attempt and records its outcome on the notification. A
+// failed attempt is tried again later, until notificationMaxAttempts.
+func (ns *notificationService) send(ctx context.Context, n *models.Notification) {
+	now := time.Now()
+	n.Attempts++
+
+	err := ns.notifyOrderEvent(ctx, n)
+	if err == nil {
+		n.Error = ""
+		n.NextAttemptAt = nil
+		return
+	}
+
+	n.Status = models.NotificationStatusFailed
+	n.Error = err.Error()
+	if len(n.Error) > 1000 {
+		n.Error = n.Error[:1000]
+	}
+
+	if n.Attempts >= notificationMaxAttempts {
+		n.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(notificationRetryDelay(n.Attempts))
+	n.NextAttemptAt = &next
+}
+
+// notifyOrderEvent emails the order's owner about the event, unless they
+// muted its type.
+func (ns *notificationService) notifyOrderEvent(ctx context.Context, n *models.Notification) error {
+	if n.OutboxEvent == nil {
+		return fmt.Errorf("event %d not found", n.OutboxEventID)
+	}
+
+	var data orderEventData
+	if err := json.Unmarshal(n.OutboxEvent.Payload, &data); err != nil {
+		return fmt.Errorf("failed to read event %d: %w", n.OutboxEventID, err)
+	}
+
+	preference, err := ns.getPreference(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+
+	n.Locale = preference.Locale
+	if isEventMuted(preference, n.EventType) {
+		n.Status = models.NotificationStatusSkipped
+		return nil
+	}
+
+	user, err := ns.authRepository.GetUserByID(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	n.Recipient = user.Email
+
+	orderEntity, err := ns.orderRepository.GetOrderByID(ctx, data.OrderID)
+	if err != nil {
+		return err
+	}
+
+	message, err := renderOrderEmail(n.EventType, preference.Locale, orderEntity, data.Reason)
+	if err != nil {
+		return err
+	}
+	n.Subject = message.Subject
+
+	message.To = user.Email
+	message.ToName = user.FullName
+
+	err = ns.transport.Send(ctx, *message)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	n.Status = models.NotificationStatusSent
+	n.SentAt = &now
+	return nil
+}
+
+// Run sends due notifications every interval until ctx is done.
+func (ns *notificationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				sent, err := ns.SendNotifications(ctx)
+				if err != nil {
+					log.Printf("failed to send notifications: %v", err)
+				}
+				if err != nil || sent < notificationBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// getPreference returns the user's preference, or the default one when they
+// never saved any.
+func (ns *notificationService) getPreference(ctx context.Context, userID uint) (*models.NotificationPreference, error) {
+	preference, err := ns.notificationRepository.GetPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if preference == nil {
+		preference = &models.NotificationPreference{
+			UserID:          userID,
+			Locale:          models.NotificationLocaleIndonesian,
+			MutedEventTypes: make([]string, 0),
+		}
+	}
+
+	return preference, nil
+}
+
+func isEventMuted(preference *models.NotificationPreference, eventType string) bool {
+	for _, muted := range preference.MutedEventTypes {
+		if muted == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// newOrderNotification queues the notification of the event for the order's
+// owner, or returns nil when users are not notified of the event's type.
+func newOrderNotification(event *models.OutboxEvent, now time.Time) (*models.Notification, error) {
+	notifiable := false
+	for _, eventType := range models.NotificationEventTypes {
+		if eventType == event.EventType {
+			notifiable = true
+			break
+		}
+	}
+	if !notifiable {
+		return nil, nil
+	}
+
+	var data orderEventData
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to read event %d: %w", event.ID, err)
+	}
+
+	return &models.Notification{
+		UserID:        data.UserID,
+		OutboxEventID: event.ID,
+		EventType:     event.EventType,
+		Channel:       models.NotificationChannelEmail,
+		Status:        models.NotificationStatusPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// notificationRetryDelay doubles the wait after every failed attempt,
+// starting at notificationFirstRetry and capped at notificationMaxRetry.
+func notificationRetryDelay(attempts int) time.Duration {
+	delay := notificationFirstRetry
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= notificationMaxRetry {
+			return notificationMaxRetry
+		}
+	}
+	return delay
+}
+
+func NewNotificationService(notificationRepository repositories.INotificationRepository, authRepository repositories.IAuthRepository, orderRepository repositories.IOrderRepository, transport mail.Transport) INotificationService {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		authRepository:         authRepository,
+		orderRepository:        orderRepository,
+		transport:              transport,
+	}
+}
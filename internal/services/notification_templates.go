@@ -0,0 +1,510 @@
+package services
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/mail"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+//go:embed templates/email/*.tmpl
+var emailTemplates embed.FS
+
+var (
+	orderEmailHTML  = htmltemplate.Must(htmltemplate.ParseFS(emailTemplates, "templates/email/order.html.tmpl"))
+	orderEmailText  = texttemplate.Must(texttemplate.ParseFS(emailTemplates, "templates/email/order.txt.tmpl"))
+	noticeEmailHTML = htmltemplate.Must(htmltemplate.ParseFS(emailTemplates, "templates/email/notice.html.tmpl"))
+	noticeEmailText = texttemplate.Must(texttemplate.ParseFS(emailTemplates, "templates/email/notice.txt.tmpl"))
+
+	campaignLayoutHTML = htmltemplate.Must(htmltemplate.ParseFS(emailTemplates, "templates/email/campaign.html.tmpl"))
+	campaignLayoutText = texttemplate.Must(texttemplate.ParseFS(emailTemplates, "templates/email/campaign.txt.tmpl"))
+)
+
+// emailLabels are the fixed words of the emails.
+type emailLabels struct {
+	Greeting       string
+	OrderNumber    string
+	OrderDate      string
+	Item           string
+	Quantity       string
+	Subtotal       string
+	Discount       string
+	Tax            string
+	Shipping       string
+	Total          string
+	PayNow         string
+	Carrier        string
+	TrackingNumber string
+	Reason         string
+	Footer         string
+
+	// Links at the bottom of newsletter emails.
+	ManagePreferences string
+	Unsubscribe       string
+	NewsletterFooter  string
+}
+
+// orderEmailCopy is what an order email says about one event. Subject takes
+// the order number.
+type orderEmailCopy struct {
+	Subject string
+	Heading string
+	Intro   string
+}
+
+// noticeEmailCopy is the text of a short email with at most one button.
+type noticeEmailCopy struct {
+	Subject    string
+	Heading    string
+	Paragraphs []string
+	Button     string
+	Footer     string
+}
+
+type emailLocale struct {
+	labels            emailLabels
+	orders            map[string]orderEmailCopy
+	newsletterConfirm noticeEmailCopy
+	newsletterWelcome noticeEmailCopy
+	months            [12]string
+	thousands         string
+	decimal           string
+}
+
+var emailLocales = map[string]emailLocale{
+	models.NotificationLocaleIndonesian: {
+		labels: emailLabels{
+			Greeting:       "Halo",
+			OrderNumber:    "Nomor pesanan",
+			OrderDate:      "Tanggal pesanan",
+			Item:           "Produk",
+			Quantity:       "Jml",
+			Subtotal:       "Subtotal",
+			Discount:       "Diskon",
+			Tax:            "Pajak",
+			Shipping:       "Ongkos kirim",
+			Total:          "Total",
+			PayNow:         "Bayar sekarang",
+			Carrier:        "Kurir",
+			TrackingNumber: "Nomor resi",
+			Reason:         "Alasan",
+			Footer:         "Anda menerima email ini karena berbelanja di toko kami. Email pesanan dapat dimatikan di pengaturan notifikasi.",
+
+			ManagePreferences: "Atur preferensi",
+			Unsubscribe:       "Berhenti berlangganan",
+			NewsletterFooter:  "Anda menerima email ini karena berlangganan newsletter kami.",
+		},
+		orders: map[string]orderEmailCopy{
+			models.EventTypeOrderCreated: {
+				Subject: "Pesanan %s telah kami terima",
+				Heading: "Terima kasih atas pesanan Anda",
+				Intro:   "Kami telah menerima pesanan Anda. Silakan selesaikan pembayaran agar pesanan dapat segera kami proses.",
+			},
+			models.EventTypeOrderPaid: {
+				Subject: "Pembayaran pesanan %s telah diterima",
+				Heading: "Pembayaran diterima",
+				Intro:   "Kami telah menerima pembayaran Anda dan sedang menyiapkan pesanan Anda.",
+			},
+			models.EventTypeOrderShipped: {
+				Subject: "Pesanan %s telah dikirim",
+				Heading: "Pesanan Anda dalam perjalanan",
+				Intro:   "Pesanan Anda telah diserahkan ke kurir. Anda dapat melacaknya dengan nomor resi di bawah ini.",
+			},
+			models.EventTypeOrderCanceled: {
+				Subject: "Pesanan %s dibatalkan",
+				Heading: "Pesanan dibatalkan",
+				Intro:   "Pesanan Anda telah dibatalkan. Jika Anda sudah membayar, dana akan dikembalikan ke metode pembayaran Anda.",
+			},
+		},
+		newsletterConfirm: noticeEmailCopy{
+			Subject: "Konfirmasi langganan newsletter Anda",
+			Heading: "Satu langkah lagi",
+			Paragraphs: []string{
+				"Terima kasih telah berlangganan newsletter kami. Klik tombol di bawah ini untuk mengonfirmasi alamat email Anda.",
+				"Tautan ini berlaku selama 48 jam.",
+			},
+			Button: "Konfirmasi langganan",
+			Footer: "Jika Anda tidak merasa mendaftar, abaikan email ini dan Anda tidak akan menerima email lain dari kami.",
+		},
+		newsletterWelcome: noticeEmailCopy{
+			Subject: "Selamat datang di newsletter kami",
+			Heading: "Langganan Anda sudah aktif",
+			Paragraphs: []string{
+				"Terima kasih telah mengonfirmasi alamat email Anda. Kami akan mengirimkan kabar terbaru sesuai topik yang Anda pilih.",
+			},
+			Footer: "Anda dapat mengubah topik atau berhenti berlangganan kapan saja.",
+		},
+		months:    [12]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"},
+		thousands: ".",
+		decimal:   ",",
+	},
+	models.NotificationLocaleEnglish: {
+		labels: emailLabels{
+			Greeting:       "Hi",
+			OrderNumber:    "Order number",
+			OrderDate:      "Order date",
+			Item:           "Item",
+			Quantity:       "Qty",
+			Subtotal:       "Subtotal",
+			Discount:       "Discount",
+			Tax:            "Tax",
+			Shipping:       "Shipping",
+			Total:          "Total",
+			PayNow:         "Pay now",
+			Carrier:        "Carrier",
+			TrackingNumber: "Tracking number",
+			Reason:         "Reason",
+			Footer:         "You get this email because you shopped with us. Order emails can be turned off in your notification settings.",
+
+			ManagePreferences: "Manage preferences",
+			Unsubscribe:       "Unsubscribe",
+			NewsletterFooter:  "You get this email because you subscribed to our newsletter.",
+		},
+		orders: map[string]orderEmailCopy{
+			models.EventTypeOrderCreated: {
+				Subject: "We received your order %s",
+				Heading: "Thank you for your order",
+				Intro:   "We have received your order. Please complete the payment so we can start processing it.",
+			},
+			models.EventTypeOrderPaid: {
+				Subject: "Payment received for order %s",
+				Heading: "Payment received",
+				Intro:   "We have received your payment and are getting your order ready.",
+			},
+			models.EventTypeOrderShipped: {
+				Subject: "Your order %s has shipped",
+				Heading: "Your order is on its way",
+				Intro:   "Your order has been handed to the carrier. You can track it with the number below.",
+			},
+			models.EventTypeOrderCanceled: {
+				Subject: "Your order %s was canceled",
+				Heading: "Order canceled",
+				Intro:   "Your order has been canceled. If you already paid, the money will be refunded to your payment method.",
+			},
+		},
+		newsletterConfirm: noticeEmailCopy{
+			Subject: "Confirm your newsletter subscription",
+			Heading: "One more step",
+			Paragraphs: []string{
+				"Thank you for subscribing to our newsletter. Click the button below to confirm your email address.",
+				"This link is valid for 48 hours.",
+			},
+			Button: "Confirm subscription",
+			Footer: "If you did not sign up, ignore this email and you will not get any other email from us.",
+		},
+		newsletterWelcome: noticeEmailCopy{
+			Subject: "Welcome to our newsletter",
+			Heading: "You are subscribed",
+			Paragraphs: []string{
+				"Thank you for confirming your email address. We will send you news on the topics you picked.",
+			},
+			Footer: "You can change your topics or unsubscribe at any time.",
+		},
+		months:    [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		thousands: ",",
+		decimal:   ".",
+	},
+}
+
+// emailLocaleFor returns the locale, falling back to Indonesian.
+func emailLocaleFor(locale string) emailLocale {
+	el, exists := emailLocales[locale]
+	if !exists {
+		return emailLocales[models.NotificationLocaleIndonesian]
+	}
+	return el
+}
+
+func (el emailLocale) formatDate(t time.Time) string {
+	return fmt.Sprintf("%d %s %d", t.Day(), el.months[t.Month()-1], t.Year())
+}
+
+// formatAmount writes the amount the way the locale does, leaving out the
+// cents of whole amounts, e.g. "Rp 150.000" or "SGD 12.50".
+func (el emailLocale) formatAmount(currencyCode string, amount money.Amount) string {
+	minor := amount.Minor()
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	digits := fmt.Sprint(minor / 100)
+	var whole strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			whole.WriteString(el.thousands)
+		}
+		whole.WriteRune(digit)
+	}
+	if cents := minor % 100; cents != 0 {
+		whole.WriteString(fmt.Sprintf("%s%02d", el.decimal, cents))
+	}
+
+	symbol := currencyCode
+	if currencyCode == money.DefaultCurrency {
+		symbol = "Rp"
+	}
+
+	return fmt.Sprintf("%s%s %s", sign, symbol, whole.String())
+}
+
+type orderEmailItem struct {
+	Name     string
+	Quantity int
+	Subtotal string
+}
+
+type orderEmailTotal struct {
+	Label  string
+	Amount string
+	Bold   bool
+}
+
+type orderEmailShipment struct {
+	Carrier        string
+	TrackingNumber string
+}
+
+type orderEmailData struct {
+	Locale       string
+	Subject      string
+	Copy         orderEmailCopy
+	Labels       emailLabels
+	StoreName    string
+	CustomerName string
+	OrderNumber  string
+	OrderDate    string
+	Reason       string
+	PaymentURL   string
+	Items        []orderEmailItem
+	Totals       []orderEmailTotal
+	Shipments    []orderEmailShipment
+}
+
+// renderOrderEmail writes the email about eventType for o in the user's
+// locale. reason explains a status change and may be empty.
+func renderOrderEmail(eventType string, locale string, o *models.Order, reason string) (*mail.Message, error) {
+	el := emailLocaleFor(locale)
+
+	orderCopy, exists := el.orders[eventType]
+	if !exists {
+		return nil, fmt.Errorf("no email for event type %s", eventType)
+	}
+
+	data := orderEmailData{
+		Locale:       locale,
+		Subject:      fmt.Sprintf(orderCopy.Subject, o.Number),
+		Copy:         orderCopy,
+		Labels:       el.labels,
+		StoreName:    storeFromEnv().Name,
+		CustomerName: o.UserFullName,
+		OrderNumber:  o.Number,
+		OrderDate:    el.formatDate(o.CreatedAt),
+		Reason:       reason,
+	}
+
+	if eventType == models.EventTypeOrderCreated && o.XenditPaidAt == nil {
+		data.PaymentURL = o.XenditInvoiceUrl
+	}
+
+	for _, oi := range o.Items {
+		data.Items = append(data.Items, orderEmailItem{
+			Name:     oi.ProductName,
+			Quantity: oi.Quantity,
+			Subtotal: el.formatAmount(o.CurrencyCode, oi.Subtotal),
+		})
+	}
+
+	data.Totals = append(data.Totals, orderEmailTotal{Label: el.labels.Subtotal, Amount: el.formatAmount(o.CurrencyCode, o.Subtotal)})
+	if o.DiscountTotal > 0 {
+		data.Totals = append(data.Totals, orderEmailTotal{Label: el.labels.Discount, Amount: el.formatAmount(o.CurrencyCode, -o.DiscountTotal)})
+	}
+	if o.TaxTotal > 0 && !o.TaxInclusive {
+		data.Totals = append(data.Totals, orderEmailTotal{Label: el.labels.Tax, Amount: el.formatAmount(o.CurrencyCode, o.TaxTotal)})
+	}
+	if o.ShippingTotal > 0 {
+		data.Totals = append(data.Totals, orderEmailTotal{Label: el.labels.Shipping, Amount: el.formatAmount(o.CurrencyCode, o.ShippingTotal)})
+	}
+	data.Totals = append(data.Totals, orderEmailTotal{Label: el.labels.Total, Amount: el.formatAmount(o.CurrencyCode, o.Total), Bold: true})
+
+	if eventType == models.EventTypeOrderShipped {
+		for _, s := range o.Shipments {
+			data.Shipments = append(data.Shipments, orderEmailShipment{
+				Carrier:        s.Carrier,
+				TrackingNumber: s.TrackingNumber,
+			})
+		}
+	}
+
+	var html, text bytes.Buffer
+	if err := orderEmailHTML.Execute(&html, data); err != nil {
+		return nil, err
+	}
+	if err := orderEmailText.Execute(&text, data); err != nil {
+		return nil, err
+	}
+
+	return &mail.Message{
+		Subject: data.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+type noticeEmailLink struct {
+	Label string
+	URL   string
+}
+
+type noticeEmailData struct {
+	Locale     string
+	Subject    string
+	StoreName  string
+	Greeting   string
+	Name       string
+	Heading    string
+	Paragraphs []string
+	ButtonURL  string
+	// ButtonLabel is only shown with ButtonURL.
+	ButtonLabel string
+	Footer      string
+	Links       []noticeEmailLink
+}
+
+// renderNoticeEmail writes a short email from noticeCopy. name is left out
+// of the greeting when empty, and the button when buttonURL is.
+func renderNoticeEmail(locale string, noticeCopy noticeEmailCopy, name string, buttonURL string, links []noticeEmailLink) (*mail.Message, error) {
+	data := noticeEmailData{
+		Locale:      locale,
+		Subject:     noticeCopy.Subject,
+		StoreName:   storeFromEnv().Name,
+		Greeting:    emailLocaleFor(locale).labels.Greeting,
+		Name:        name,
+		Heading:     noticeCopy.Heading,
+		Paragraphs:  noticeCopy.Paragraphs,
+		ButtonURL:   buttonURL,
+		ButtonLabel: noticeCopy.Button,
+		Footer:      noticeCopy.Footer,
+		Links:       links,
+	}
+
+	var html, text bytes.Buffer
+	if err := noticeEmailHTML.Execute(&html, data); err != nil {
+		return nil, err
+	}
+	if err := noticeEmailText.Execute(&text, data); err != nil {
+		return nil, err
+	}
+
+	return &mail.Message{
+		Subject: data.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// campaignEmailTemplates are the bodies of a campaign, parsed once for all
+// its recipients.
+type campaignEmailTemplates struct {
+	subject string
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+// campaignRecipient is what campaign bodies can show about the recipient.
+type campaignRecipient struct {
+	Name           string
+	Email          string
+	PreferencesURL string
+	UnsubscribeURL string
+}
+
+type campaignEmailData struct {
+	Locale    string
+	Subject   string
+	StoreName string
+	Body      htmltemplate.HTML
+	Text      string
+	Footer    string
+	Links     []noticeEmailLink
+}
+
+// parseCampaignEmail parses the campaign bodies and renders them once for a
+// sample recipient, so a body naming a field that does not exist is caught
+// before anyone is mailed.
+func parseCampaignEmail(subject string, htmlBody string, textBody string) (*campaignEmailTemplates, error) {
+	html, err := htmltemplate.New("html_body").Parse(htmlBody)
+	if err != nil {
+		return nil, err
+	}
+	text, err := texttemplate.New("text_body").Parse(textBody)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &campaignEmailTemplates{
+		subject: subject,
+		html:    html,
+		text:    text,
+	}
+
+	_, err = renderCampaignEmail(tmpl, models.NotificationLocaleIndonesian, campaignRecipient{
+		Name:           "Subscriber",
+		Email:          "subscriber@example.com",
+		PreferencesURL: "https://example.com/newsletter/preferences",
+		UnsubscribeURL: "https://example.com/newsletter/unsubscribe",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// renderCampaignEmail writes the campaign for one recipient inside the
+// store layout, with the unsubscribe footer in the recipient's language.
+func renderCampaignEmail(tmpl *campaignEmailTemplates, locale string, recipient campaignRecipient) (*mail.Message, error) {
+	var body, textBody bytes.Buffer
+	if err := tmpl.html.Execute(&body, recipient); err != nil {
+		return nil, err
+	}
+	if err := tmpl.text.Execute(&textBody, recipient); err != nil {
+		return nil, err
+	}
+
+	labels := emailLocaleFor(locale).labels
+	data := campaignEmailData{
+		Locale:    locale,
+		Subject:   tmpl.subject,
+		StoreName: storeFromEnv().Name,
+		Body:      htmltemplate.HTML(body.String()),
+		Text:      textBody.String(),
+		Footer:    labels.NewsletterFooter,
+		Links: []noticeEmailLink{
+			{Label: labels.ManagePreferences, URL: recipient.PreferencesURL},
+			{Label: labels.Unsubscribe, URL: recipient.UnsubscribeURL},
+		},
+	}
+
+	var html, text bytes.Buffer
+	if err := campaignLayoutHTML.Execute(&html, data); err != nil {
+		return nil, err
+	}
+	if err := campaignLayoutText.Execute(&text, data); err != nil {
+		return nil, err
+	}
+
+	return &mail.Message{
+		Subject: tmpl.subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
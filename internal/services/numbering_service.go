@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/numbering"
+)
+
+// INumberingService hands out document numbers such as order numbers. A
+// number is taken through the repository of the transaction that stores the
+// document, so a rolled back document leaves no gap.
+type INumberingService interface {
+	Next(ctx context.Context, numberingRepository repositories.INumberingRepository, module string) (string, error)
+}
+
+type numberingService struct {
+	formats map[string]*numbering.Format
+}
+
+func (ns *numberingService) Next(ctx context.Context, numberingRepository repositories.INumberingRepository, module string) (string, error) {
+	format, exists := ns.formats[module]
+	if !exists {
+		return "", fmt.Errorf("no number format for module %s", module)
+	}
+
+	now := time.Now()
+	sequence, err := numberingRepository.NextNumber(ctx, module, format.Period(now))
+	if err != nil {
+		return "", err
+	}
+
+	return format.Number(now, sequence), nil
+}
+
+func NewNumberingService() INumberingService {
+	return &numberingService{
+		formats: numbering.FormatsFromEnv(),
+	}
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/payment"
+	"github.com/xendit/xendit-go/invoice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IOrderCancellationService interface {
+	CancelOrder(ctx context.Context, orderEntity *models.Order, actor OrderActor, reason string) error
+	ApplyRefundStatus(ctx context.Context, orderEntity *models.Order, gatewayStatus string, actor OrderActor) error
+	RefundLatePayment(ctx context.Context, orderEntity *models.Order, actor OrderActor) error
+}
+
+type orderCancellationService struct {
+	orderRepository     repositories.IOrderRepository
+	productRepository   repositories.IProductRepository
+	promotionRepository repositories.IPromotionRepository
+	stateMachine        IOrderStateMachine
+	refundGateway       payment.RefundGateway
+}
+
+// CancelOrder cancels the order, puts its items back in stock and gives back
+// its coupon uses. A paid
+// order is refunded in full. An unpaid order has its invoice expired so it
+// can no longer be paid. The order needs its items loaded.
+func (ocs *orderCancellationService) CancelOrder(ctx context.Context, orderEntity *models.Order, actor OrderActor, reason string) error {
+	wasPaid := orderEntity.OrderStatusCode == models.OrderStatusCodePaid
+
+	err := ocs.stateMachine.CanTransition(ctx, orderEntity.OrderStatusCode, models.OrderStatusCodeCanceled, actor)
+	if err != nil {
+		return err
+	}
+
+	tx, err := ocs.orderRepository.BeginTransaction(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	// A payment or another cancellation may have changed the order since it
+	// was loaded. Nothing is restocked unless it is still in that status.
+	currentStatus, err := ocs.orderRepository.WithTx(tx).LockOrderStatus(ctx, orderEntity.ID)
+	if err != nil {
+		tx.Rollback()
+		return status.Error(codes.Internal, "failed to lock order")
+	}
+	if currentStatus != orderEntity.OrderStatusCode {
+		tx.Rollback()
+		return status.Errorf(codes.Aborted, "order status was changed from %s by another request", orderEntity.OrderStatusCode)
+	}
+
+	quantities := make(map[uint]int)
+	productIds := make([]uint, 0)
+	for _, item := range orderEntity.Items {
+		if _, exists := quantities[item.ProductID]; !exists {
+			productIds = append(productIds, item.ProductID)
+		}
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	// Same lock order as checkout so the two cannot deadlock.
+	sort.Slice(productIds, func(i, j int) bool { return productIds[i] < productIds[j] })
+
+	txProductRepo := ocs.productRepository.WithTx(tx)
+	for _, productId := range productIds {
+		err = txProductRepo.IncrementStock(ctx, productId, quantities[productId])
+		if err != nil {
+			tx.Rollback()
+			return status.Error(codes.Internal, "failed to restock order items")
+		}
+	}
+
+	err = ocs.promotionRepository.WithTx(tx).ReleaseOrderUsage(ctx, orderEntity.ID, actor.Name)
+	if err != nil {
+		tx.Rollback()
+		return status.Error(codes.Internal, "failed to release coupon usage")
+	}
+
+	if wasPaid {
+		orderEntity.RefundStatus = models.OrderRefundStatusPending
+		orderEntity.RefundAmount = orderEntity.Total
+	}
+
+	event, err := ocs.stateMachine.Transition(ctx, ocs.orderRepository.WithTx(tx), orderEntity, models.OrderStatusCodeCanceled, actor, reason)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return status.Error(codes.Internal, "failed to commit transaction")
+	}
+
+	ocs.stateMachine.Publish(event)
+
+	if !wasPaid {
+		ocs.expireInvoice(ctx, orderEntity)
+		return nil
+	}
+
+	return ocs.requestRefund(ctx, orderEntity, actor)
+}
+
+// ApplyRefundStatus records a refund status reported by the gateway. A
+// refund is not a status change, so it leaves no status history entry.
+func (ocs *orderCancellationService) ApplyRefundStatus(ctx context.Context, orderEntity *models.Order, gatewayStatus string, actor OrderActor) error {
+	refundStatus := refundStatusFromGateway(gatewayStatus)
+	if refundStatus == orderEntity.RefundStatus {
+		return nil
+	}
+
+	fromRefundStatus := orderEntity.RefundStatus
+	orderEntity.RefundStatus = refundStatus
+	if refundStatus == models.OrderRefundStatusSucceeded {
+		now := time.Now()
+		orderEntity.RefundedAt = &now
+	}
+
+	return ocs.saveRefund(ctx, orderEntity, fromRefundStatus, actor)
+}
+
+// RefundLatePayment refunds in full an invoice that was paid after its order
+// was canceled. Only the request that starts the refund sends it, so
+// repeated callbacks refund once.
+func (ocs *orderCancellationService) RefundLatePayment(ctx context.Context, orderEntity *models.Order, actor OrderActor) error {
+	if orderEntity.RefundStatus != "" {
+		return nil
+	}
+
+	orderEntity.RefundStatus = models.OrderRefundStatusPending
+	orderEntity.RefundAmount = orderEntity.Total
+
+	err := ocs.saveRefund(ctx, orderEntity, "", actor)
+	if status.Code(err) == codes.Aborted {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return ocs.requestRefund(ctx, orderEntity, actor)
+}
+
+// requestRefund asks the gateway for the refund after the cancellation is
+// saved, so a refund is never sent for an order that stayed open. A failed
+// request is kept on the order for an admin to follow up.
+func (ocs *orderCancellationService) requestRefund(ctx context.Context, orderEntity *models.Order, actor OrderActor) error {
+	refund, err := ocs.refundGateway.Refund(ctx, payment.RefundRequest{
+		InvoiceID:    orderEntity.XenditInvoiceID,
+		ReferenceID:  fmt.Sprintf("order-%d-refund", orderEntity.ID),
+		Amount:       orderEntity.RefundAmount,
+		CurrencyCode: orderEntity.CurrencyCode,
+		Reason:       payment.RefundReasonCancellation,
+	})
+	if err != nil {
+		log.Printf("failed to refund order %d: %v", orderEntity.ID, err)
+		return ocs.ApplyRefundStatus(ctx, orderEntity, payment.RefundStatusFailed, actor)
+	}
+
+	orderEntity.RefundID = refund.ID
+
+	// Pending is already stored, so write the refund id either way.
+	if refundStatusFromGateway(refund.Status) == models.OrderRefundStatusPending {
+		return ocs.saveRefund(ctx, orderEntity, models.OrderRefundStatusPending, actor)
+	}
+
+	return ocs.ApplyRefundStatus(ctx, orderEntity, refund.Status, actor)
+}
+
+// saveRefund writes the order's refund if its refund status is still
+// fromRefundStatus, and returns an Aborted status error when it is not.
+func (ocs *orderCancellationService) saveRefund(ctx context.Context, orderEntity *models.Order, fromRefundStatus string, actor OrderActor) error {
+	now := time.Now()
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &actor.Name
+
+	err := ocs.orderRepository.UpdateOrderRefund(ctx, orderEntity, fromRefundStatus)
+	if errors.Is(err, repositories.ErrOrderStatusChanged) {
+		return status.Errorf(codes.Aborted, "order refund was changed from %q by another request", fromRefundStatus)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "failed to update order refund")
+	}
+
+	return nil
+}
+
+func (ocs *orderCancellationService) expireInvoice(ctx context.Context, orderEntity *models.Order) {
+	if orderEntity.XenditInvoiceID == "" {
+		return
+	}
+
+	_, xenditErr := invoice.ExpireWithContext(ctx, &invoice.ExpireParams{
+		ID: orderEntity.XenditInvoiceID,
+	})
+	if xenditErr != nil {
+		log.Printf("failed to expire xendit invoice for order %d: %v", orderEntity.ID, xenditErr)
+	}
+}
+
+func refundStatusFromGateway(gatewayStatus string) string {
+	switch strings.ToUpper(gatewayStatus) {
+	case payment.RefundStatusSucceeded:
+		return models.OrderRefundStatusSucceeded
+	case payment.RefundStatusFailed:
+		return models.OrderRefundStatusFailed
+	default:
+		return models.OrderRefundStatusPending
+	}
+}
+
+func NewOrderCancellationService(orderRepository repositories.IOrderRepository, productRepository repositories.IProductRepository, promotionRepository repositories.IPromotionRepository, stateMachine IOrderStateMachine, refundGateway payment.RefundGateway) IOrderCancellationService {
+	return &orderCancellationService{
+		orderRepository:     orderRepository,
+		productRepository:   productRepository,
+		promotionRepository: promotionRepository,
+		stateMachine:        stateMachine,
+		refundGateway:       refundGateway,
+	}
+}
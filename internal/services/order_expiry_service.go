@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+)
+
+type IOrderExpiryService interface {
+	ExpireOrders(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type orderExpiryService struct {
+	orderRepository     repositories.IOrderRepository
+	cancellationService IOrderCancellationService
+}
+
+// ExpireOrders cancels unpaid orders whose payment window has passed and
+// puts their stock back.
+func (oes *orderExpiryService) ExpireOrders(ctx context.Context) error {
+	orders, err := oes.orderRepository.GetExpiredUnpaidOrders(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, orderEntity := range orders {
+		err := oes.cancellationService.CancelOrder(ctx, orderEntity, SystemActor, "Payment window expired")
+		if err != nil {
+			log.Printf("failed to expire order %d: %v", orderEntity.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run expires orders every interval until the context is done.
+func (oes *orderExpiryService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := oes.ExpireOrders(ctx); err != nil {
+				log.Printf("failed to expire orders: %v", err)
+			}
+		}
+	}
+}
+
+func NewOrderExpiryService(orderRepository repositories.IOrderRepository, cancellationService IOrderCancellationService) IOrderExpiryService {
+	return &orderExpiryService{
+		orderRepository:     orderRepository,
+		cancellationService: cancellationService,
+	}
+}
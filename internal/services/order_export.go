@@ -0,0 +1,214 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/export"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	orderExportBatchSize = 100
+	orderExportChunkSize = 32 * 1024
+)
+
+var orderExportHeader = []string{
+	"Order Number",
+	"Order Date",
+	"Status",
+	"Customer",
+	"Phone Number",
+	"Currency",
+	"Payment Method",
+	"Payment Channel",
+	"Paid At",
+	"Product",
+	"Quantity",
+	"Price",
+	"Item Subtotal",
+	"Order Subtotal",
+	"Discount",
+	"Tax",
+	"Shipping",
+	"Total",
+}
+
+// ExportOrders streams the export file in chunks. The first message carries
+// the file name and content type, and is only sent once the request has
+// been accepted.
+func (os *orderService) ExportOrders(req *order.ExportOrdersRequest, stream order.OrderService_ExportOrdersServer) error {
+	filter, err := checkOrderExport(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&order.ExportOrdersResponse{
+		FileName:    OrderExportFileName(req.Format, time.Now()),
+		ContentType: export.ContentType(req.Format),
+	})
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, orderExportChunkSize)
+
+	err = os.writeOrderExport(stream.Context(), filter, req.Format, w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// WriteOrderExport writes every order matching the filter to w, one row per
+// order item. Nothing is written when the request is refused.
+func (os *orderService) WriteOrderExport(ctx context.Context, req *order.ExportOrdersRequest, w io.Writer) error {
+	filter, err := checkOrderExport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return os.writeOrderExport(ctx, filter, req.Format, w)
+}
+
+// checkOrderExport refuses an export request from anyone but an admin, or
+// with an invalid filter or format, and returns the filter to export with.
+func checkOrderExport(ctx context.Context, req *order.ExportOrdersRequest) (*repositories.OrderFilter, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can export orders")
+	}
+
+	filterReq := req.Filter
+	if filterReq == nil {
+		filterReq = &order.ListOrderAdminRequest{}
+	}
+
+	filter, msg := adminOrderFilter(filterReq)
+	if msg != "" {
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	err = export.CheckFormat(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return filter, nil
+}
+
+func (os *orderService) writeOrderExport(ctx context.Context, filter *repositories.OrderFilter, format string, w io.Writer) error {
+	rows, err := export.NewRowWriter(format, w)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = rows.WriteRow(orderExportHeader)
+	if err != nil {
+		return err
+	}
+
+	pagination := &common.PaginationRequest{
+		PerPage:        orderExportBatchSize,
+		SkipTotalCount: true,
+	}
+
+	for {
+		orders, metadata, err := os.orderRepository.GetListOrderAdmin(ctx, filter, pagination)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to get orders")
+		}
+
+		for _, o := range orders {
+			for _, row := range orderExportRows(o) {
+				err = rows.WriteRow(row)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if metadata.NextCursor == "" {
+			break
+		}
+		pagination.Cursor = metadata.NextCursor
+	}
+
+	return rows.Close()
+}
+
+// orderExportRows returns one row per item, each repeating the order's own
+// columns. An order without items still gets a row.
+func orderExportRows(o *models.Order) [][]string {
+	paidAt := ""
+	if o.XenditPaidAt != nil {
+		paidAt = o.XenditPaidAt.Format(time.RFC3339)
+	}
+
+	orderCells := []string{
+		o.Number,
+		o.CreatedAt.Format(time.RFC3339),
+		o.OrderStatusCode,
+		o.UserFullName,
+		o.PhoneNumber,
+		o.CurrencyCode,
+		o.XenditPaymentMethod,
+		o.XenditPaymentChannel,
+		paidAt,
+	}
+	totalCells := []string{
+		o.Subtotal.String(),
+		o.DiscountTotal.String(),
+		o.TaxTotal.String(),
+		o.ShippingTotal.String(),
+		o.Total.String(),
+	}
+
+	if len(o.Items) == 0 {
+		row := append(append([]string{}, orderCells...), "", "", "", "")
+		return [][]string{append(row, totalCells...)}
+	}
+
+	rows := make([][]string, 0, len(o.Items))
+	for _, oi := range o.Items {
+		row := append([]string{}, orderCells...)
+		row = append(row, oi.ProductName, fmt.Sprint(oi.Quantity), oi.ProductPrice.String(), oi.Subtotal.String())
+		rows = append(rows, append(row, totalCells...))
+	}
+
+	return rows
+}
+
+// OrderExportFileName names the export file after the time it was made.
+func OrderExportFileName(format string, now time.Time) string {
+	return fmt.Sprintf("orders-%s.%s", now.Format("20060102-150405"), format)
+}
+
+// exportStreamWriter sends every write as a chunk of the export stream.
+type exportStreamWriter struct {
+	stream order.OrderService_ExportOrdersServer
+}
+
+func (ew *exportStreamWriter) Write(p []byte) (int, error) {
+	err := ew.stream.Send(&order.ExportOrdersResponse{
+		Chunk: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdos "os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/pdf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	receiptMargin     = 50.0
+	receiptRight      = pdf.PageWidth - receiptMargin
+	receiptLineHeight = 14.0
+	receiptFontSize   = 10.0
+)
+
+// receiptStore is the seller shown at the top of every receipt.
+type receiptStore struct {
+	Name    string
+	Address string
+	Email   string
+	Phone   string
+}
+
+func (os *orderService) GetOrderReceipt(ctx context.Context, req *order.GetOrderReceiptRequest) (*order.GetOrderReceiptResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderByID(ctx, uint(orderID))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	if claims.RoleCode != "ADMIN" && orderEntity.UserID != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "you can only view your own orders")
+	}
+
+	if orderEntity.XenditPaidAt == nil || orderEntity.OrderStatusCode == models.OrderStatusCodeCanceled {
+		return &order.GetOrderReceiptResponse{
+			Base: utils.BadRequestResponse("Receipts are only available for paid orders"),
+		}, nil
+	}
+
+	var content bytes.Buffer
+	_, err = renderOrderReceipt(orderEntity, storeFromEnv()).WriteTo(&content)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to render receipt")
+	}
+
+	return &order.GetOrderReceiptResponse{
+		Base:        utils.SuccessResponse("Get order receipt success"),
+		FileName:    fmt.Sprintf("receipt-%s.pdf", orderEntity.Number),
+		ContentType: "application/pdf",
+		Content:     content.Bytes(),
+	}, nil
+}
+
+// receiptWriter lays out receipt lines top to bottom, starting a new page
+// when one is full.
+type receiptWriter struct {
+	doc *pdf.Document
+	y   float64
+}
+
+func (rw *receiptWriter) newLine(height float64) {
+	rw.y += height
+	if rw.y > pdf.PageHeight-receiptMargin {
+		rw.doc.AddPage()
+		rw.y = receiptMargin + height
+	}
+}
+
+// wrapped draws s in lines no wider than width. Other columns of the same
+// row go on its first line.
+func (rw *receiptWriter) wrapped(x, width float64, bold bool, s string) {
+	lines := wrapText(s, width, receiptFontSize, bold)
+	for i, line := range lines {
+		if i > 0 {
+			rw.newLine(receiptLineHeight)
+		}
+		rw.doc.Text(x, rw.y, receiptFontSize, bold, line)
+	}
+}
+
+// amountRow draws a label and a right aligned amount, as used for totals.
+func (rw *receiptWriter) amountRow(label string, amount string, bold bool) {
+	rw.newLine(receiptLineHeight)
+	rw.doc.Text(330, rw.y, receiptFontSize, bold, label)
+	rw.doc.TextRight(receiptRight, rw.y, receiptFontSize, bold, amount)
+}
+
+func renderOrderReceipt(o *models.Order, store receiptStore) *pdf.Document {
+	rw := &receiptWriter{doc: pdf.NewDocument(), y: receiptMargin}
+	formatAmount := func(amount money.Amount) string {
+		return fmt.Sprintf("%s %s", o.CurrencyCode, amount)
+	}
+
+	rw.newLine(8)
+	rw.doc.Text(receiptMargin, rw.y, 18, true, store.Name)
+	rw.doc.TextRight(receiptRight, rw.y, 18, true, "RECEIPT")
+	for _, line := range []string{store.Address, store.Email, store.Phone} {
+		if line == "" {
+			continue
+		}
+		rw.newLine(receiptLineHeight)
+		rw.doc.Text(receiptMargin, rw.y, receiptFontSize, false, line)
+	}
+
+	rw.newLine(receiptLineHeight * 2)
+	headerTop := rw.y
+	rw.doc.Text(receiptMargin, rw.y, receiptFontSize, true, "Billed to")
+	rw.newLine(receiptLineHeight)
+	rw.doc.Text(receiptMargin, rw.y, receiptFontSize, false, o.UserFullName)
+	rw.newLine(receiptLineHeight)
+	rw.wrapped(receiptMargin, 240, false, o.Address)
+	rw.newLine(receiptLineHeight)
+	rw.doc.Text(receiptMargin, rw.y, receiptFontSize, false, o.PhoneNumber)
+	billedBottom := rw.y
+
+	payment := o.XenditPaymentMethod
+	if o.XenditPaymentChannel != "" {
+		payment = fmt.Sprintf("%s / %s", o.XenditPaymentMethod, o.XenditPaymentChannel)
+	}
+
+	rw.y = headerTop
+	for _, field := range [][2]string{
+		{"Order Number", o.Number},
+		{"Order Date", o.CreatedAt.Format("02 Jan 2006 15:04")},
+		{"Paid At", o.XenditPaidAt.Format("02 Jan 2006 15:04")},
+		{"Payment", payment},
+	} {
+		rw.doc.Text(330, rw.y, receiptFontSize, true, field[0])
+		rw.doc.TextRight(receiptRight, rw.y, receiptFontSize, false, field[1])
+		rw.newLine(receiptLineHeight)
+	}
+	if billedBottom > rw.y {
+		rw.y = billedBottom
+	}
+
+	rw.newLine(receiptLineHeight * 2)
+	rw.doc.Text(receiptMargin, rw.y, receiptFontSize, true, "Product")
+	rw.doc.TextRight(340, rw.y, receiptFontSize, true, "Qty")
+	rw.doc.TextRight(430, rw.y, receiptFontSize, true, "Price")
+	rw.doc.TextRight(470, rw.y, receiptFontSize, true, "Tax")
+	rw.doc.TextRight(receiptRight, rw.y, receiptFontSize, true, "Amount")
+	rw.newLine(6)
+	rw.doc.Line(receiptMargin, rw.y, receiptRight, rw.y)
+
+	for _, oi := range o.Items {
+		rw.newLine(receiptLineHeight)
+		rowTop := rw.y
+		rw.doc.TextRight(340, rowTop, receiptFontSize, false, fmt.Sprint(oi.Quantity))
+		rw.doc.TextRight(430, rowTop, receiptFontSize, false, oi.ProductPrice.String())
+		rw.doc.TextRight(470, rowTop, receiptFontSize, false, fmt.Sprintf("%g%%", oi.TaxRate))
+		rw.doc.TextRight(receiptRight, rowTop, receiptFontSize, false, oi.Subtotal.String())
+		rw.wrapped(receiptMargin, 230, false, oi.ProductName)
+	}
+
+	rw.newLine(6)
+	rw.doc.Line(receiptMargin, rw.y, receiptRight, rw.y)
+	rw.newLine(4)
+
+	rw.amountRow("Subtotal", formatAmount(o.Subtotal), false)
+	for _, od := range o.Discounts {
+		rw.amountRow(fmt.Sprintf("Discount (%s)", od.Code), "-"+formatAmount(od.Amount), false)
+	}
+
+	for _, line := range receiptTaxLines(o) {
+		rw.amountRow(line.label, formatAmount(line.amount), false)
+	}
+
+	if o.ShippingMethodName != "" || o.ShippingTotal > 0 {
+		label := "Shipping"
+		if o.ShippingMethodName != "" {
+			label = fmt.Sprintf("Shipping (%s)", o.ShippingMethodName)
+		}
+		rw.amountRow(label, formatAmount(o.ShippingTotal), false)
+	}
+
+	rw.newLine(4)
+	rw.doc.Line(330, rw.y, receiptRight, rw.y)
+	rw.amountRow("Total Paid", formatAmount(o.Total), true)
+
+	rw.newLine(receiptLineHeight * 3)
+	rw.doc.Text(receiptMargin, rw.y, receiptFontSize, false, "Thank you for your order.")
+
+	return rw.doc
+}
+
+type receiptTaxLine struct {
+	label  string
+	amount money.Amount
+}
+
+// receiptTaxLines breaks the order's tax down by rate. Included tax is
+// shown for information only, it is already part of the prices.
+func receiptTaxLines(o *models.Order) []receiptTaxLine {
+	amounts := make(map[float64]money.Amount)
+	bases := make(map[float64]money.Amount)
+	rates := make([]float64, 0)
+	for _, oi := range o.Items {
+		if oi.TaxRate == 0 {
+			continue
+		}
+		if _, exists := amounts[oi.TaxRate]; !exists {
+			rates = append(rates, oi.TaxRate)
+		}
+		amounts[oi.TaxRate] += oi.TaxAmount
+		bases[oi.TaxRate] += oi.Subtotal - oi.DiscountAmount
+	}
+	sort.Float64s(rates)
+
+	lines := make([]receiptTaxLine, 0, len(rates))
+	for _, rate := range rates {
+		label := fmt.Sprintf("Tax %g%% of %s", rate, bases[rate])
+		if o.TaxInclusive {
+			label = fmt.Sprintf("Tax %g%% (included)", rate)
+		}
+		lines = append(lines, receiptTaxLine{label: label, amount: amounts[rate]})
+	}
+
+	return lines
+}
+
+// wrapText splits s into lines that fit width, breaking between words.
+func wrapText(s string, width, size float64, bold bool) []string {
+	lines := make([]string, 0)
+	current := ""
+	for _, word := range strings.Fields(s) {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+
+		if current != "" && pdf.TextWidth(candidate, size, bold) > width {
+			lines = append(lines, current)
+			current = word
+			continue
+		}
+		current = candidate
+	}
+
+	return append(lines, current)
+}
+
+func storeFromEnv() receiptStore {
+	store := receiptStore{
+		Name:    stdos.Getenv("STORE_NAME"),
+		Address: stdos.Getenv("STORE_ADDRESS"),
+		Email:   stdos.Getenv("STORE_EMAIL"),
+		Phone:   stdos.Getenv("STORE_PHONE"),
+	}
+	if store.Name == "" {
+		store.Name = "Store"
+	}
+	return store
+}
@@ -2,8 +2,13 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	stdos "os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,10 +18,13 @@ import (
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/eventbus"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
 	"github.com/xendit/xendit-go"
 	"github.com/xendit/xendit-go/invoice"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type IOrderService interface {
@@ -25,12 +33,33 @@ type IOrderService interface {
 	ListOrder(ctx context.Context, req *order.ListOrderRequest) (*order.ListOrderResponse, error)
 	DetailOrder(ctx context.Context, req *order.DetailOrderRequest) (*order.DetailOrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, req *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error)
+	CreateShipment(ctx context.Context, req *order.CreateShipmentRequest) (*order.CreateShipmentResponse, error)
+	ListOrderStatusHistory(ctx context.Context, req *order.ListOrderStatusHistoryRequest) (*order.ListOrderStatusHistoryResponse, error)
+	ListOrderStatuses(ctx context.Context, req *order.ListOrderStatusesRequest) (*order.ListOrderStatusesResponse, error)
+	CancelOrder(ctx context.Context, req *order.CancelOrderRequest) (*order.CancelOrderResponse, error)
+	ExportOrders(req *order.ExportOrdersRequest, stream order.OrderService_ExportOrdersServer) error
+	WriteOrderExport(ctx context.Context, req *order.ExportOrdersRequest, w io.Writer) error
+	GetOrderReceipt(ctx context.Context, req *order.GetOrderReceiptRequest) (*order.GetOrderReceiptResponse, error)
+	WatchOrder(req *order.WatchOrderRequest, stream order.OrderService_WatchOrderServer) error
+	WatchOrders(req *order.WatchOrdersRequest, stream order.OrderService_WatchOrdersServer) error
 }
 
 type orderService struct {
-	orderRepository   repositories.IOrderRepository
-	productRepository repositories.IProductRepository
-	cartRepository    repositories.ICartRepository
+	orderRepository       repositories.IOrderRepository
+	productRepository     repositories.IProductRepository
+	cartRepository        repositories.ICartRepository
+	promotionRepository   repositories.IPromotionRepository
+	promotionService      IPromotionService
+	pricingService        IPricingService
+	taxService            ITaxService
+	shippingService       IShippingService
+	orderStatusRepository repositories.IOrderStatusRepository
+	stateMachine          IOrderStateMachine
+	cancellationService   IOrderCancellationService
+	numberingRepository   repositories.INumberingRepository
+	numberingService      INumberingService
+	orderEvents           *eventbus.Bus[OrderStatusEvent]
+	outboxRepository      repositories.IOutboxRepository
 }
 
 func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
@@ -39,6 +68,11 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 		return nil, status.Error(codes.Internal, "failed to get user info")
 	}
 
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := os.orderRepository.BeginTransaction(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to begin transaction")
@@ -52,12 +86,6 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 
 	txOrderRepo := os.orderRepository.WithTx(tx)
 
-	numbering, err := txOrderRepo.GetNumbering(ctx, "order")
-	if err != nil {
-		tx.Rollback()
-		return nil, status.Error(codes.Internal, "failed to get order numbering")
-	}
-
 	var productIds = make([]string, len(req.Products))
 	for i := range req.Products {
 		productIds[i] = fmt.Sprint(req.Products[i].ProductId)
@@ -74,27 +102,168 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 		productMap[uint64(products[i].ID)] = products[i]
 	}
 
-	var total float64 = 0
+	prices, err := os.pricingService.ResolvePrices(ctx, currencyCode, products)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get product prices")
+	}
+
+	var subtotal money.Amount = 0
 	for _, p := range req.Products {
 		product, exists := productMap[p.ProductId]
 		if !exists {
 			tx.Rollback()
 			return nil, status.Errorf(codes.InvalidArgument, "product with id %d not found", p.ProductId)
 		}
-		total += product.Price * float64(p.Quantity)
+
+		price, priced := prices[product.ID]
+		if !priced {
+			tx.Rollback()
+			return nil, status.Errorf(codes.InvalidArgument, "%s is not sold in %s", product.Name, currencyCode)
+		}
+		subtotal += price.Mul(int(p.Quantity))
+	}
+
+	var coupon *PromotionResult
+	if req.CouponCode != "" {
+		lines := make([]PromotionLine, 0, len(req.Products))
+		for _, p := range req.Products {
+			product := productMap[p.ProductId]
+			lines = append(lines, PromotionLine{
+				ProductID: product.ID,
+				Category:  product.Category,
+				Price:     prices[product.ID],
+				Quantity:  int(p.Quantity),
+			})
+		}
+
+		coupon, err = os.promotionService.EvaluateCoupon(ctx, req.CouponCode, claims.UserID, currencyCode, lines)
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, "failed to validate coupon")
+		}
+		if !coupon.IsValid() {
+			tx.Rollback()
+			return nil, status.Error(codes.InvalidArgument, coupon.Reason)
+		}
+	}
+
+	var discountTotal money.Amount = 0
+	if coupon != nil {
+		discountTotal = coupon.Discount
+	}
+
+	lineSubtotals := make([]money.Amount, len(req.Products))
+	for i, p := range req.Products {
+		lineSubtotals[i] = prices[productMap[p.ProductId].ID].Mul(int(p.Quantity))
+	}
+
+	lineDiscounts := make([]money.Amount, len(req.Products))
+	if coupon != nil {
+		eligibleIds := make(map[uint]bool)
+		for _, id := range coupon.EligibleProductIDs {
+			eligibleIds[id] = true
+		}
+
+		eligible := make([]bool, len(req.Products))
+		for i, p := range req.Products {
+			eligible[i] = eligibleIds[uint(p.ProductId)]
+		}
+		lineDiscounts = allocateDiscount(lineSubtotals, eligible, discountTotal)
+	}
+
+	taxLines := make([]TaxLine, len(req.Products))
+	for i, p := range req.Products {
+		taxLines[i] = TaxLine{
+			Category: productMap[p.ProductId].Category,
+			Amount:   lineSubtotals[i] - lineDiscounts[i],
+		}
+	}
+
+	taxResult, err := os.taxService.CalculateTax(ctx, currencyCode, taxLines)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to calculate tax")
+	}
+
+	weightGrams := 0
+	for _, p := range req.Products {
+		weightGrams += productMap[p.ProductId].WeightGrams * int(p.Quantity)
+	}
+
+	shippingQuote, err := os.shippingService.QuoteMethod(ctx, req.ShippingMethodCode, req.ShippingRegion, currencyCode, weightGrams)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get shipping cost")
+	}
+	if !shippingQuote.IsValid() {
+		tx.Rollback()
+		return nil, status.Error(codes.InvalidArgument, shippingQuote.Reason)
+	}
+
+	// Inclusive tax is already part of the prices, so only exclusive tax is added on top.
+	total := subtotal - discountTotal + shippingQuote.Cost
+	if !taxResult.Inclusive {
+		total += taxResult.Total
+	}
+
+	quantities := make(map[uint64]int)
+	orderedProductIds := make([]uint64, 0)
+	for _, p := range req.Products {
+		if _, exists := quantities[p.ProductId]; !exists {
+			orderedProductIds = append(orderedProductIds, p.ProductId)
+		}
+		quantities[p.ProductId] += int(p.Quantity)
+	}
+
+	// Stock rows are locked in id order so concurrent checkouts cannot deadlock.
+	sort.Slice(orderedProductIds, func(i, j int) bool { return orderedProductIds[i] < orderedProductIds[j] })
+
+	txProductRepo := os.productRepository.WithTx(tx)
+	for _, productId := range orderedProductIds {
+		product := productMap[productId]
+		if msg := maxPerOrderError(product, quantities[productId]); msg != "" {
+			tx.Rollback()
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+
+		ok, err := txProductRepo.DecrementStock(ctx, product.ID, quantities[productId])
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, "failed to update product stock")
+		}
+		if !ok {
+			tx.Rollback()
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient stock for %s", product.Name)
+		}
+	}
+
+	// The real number is taken after the invoice is created, this only
+	// fills the unique column until then.
+	placeholderNumber, err := pendingOrderNumber()
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get order number")
 	}
 
 	now := time.Now()
 	expiredAt := now.Add(24 * time.Hour)
 
 	orderEntity := models.Order{
-		Number:          fmt.Sprintf("ORD-%d%08d", now.Year(), numbering.Number),
+		Number:          placeholderNumber,
 		UserID:          claims.UserID,
 		OrderStatusCode: models.OrderStatusCodeUnpaid,
 		UserFullName:    req.FullName,
 		Address:         req.Address,
 		PhoneNumber:     req.PhoneNumber,
 		Notes:           req.Notes,
+		CurrencyCode:    currencyCode,
+		Subtotal:        subtotal,
+		DiscountTotal:   discountTotal,
+		TaxTotal:        taxResult.Total,
+		TaxInclusive:    taxResult.Inclusive,
+		ShippingRegion:  req.ShippingRegion,
+		ShippingTotal:   shippingQuote.Cost,
 		Total:           total,
 		ExpiredAt:       &expiredAt,
 		BaseModel: models.BaseModel{
@@ -103,35 +272,123 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 		},
 	}
 
+	if shippingQuote.Method != nil {
+		orderEntity.ShippingMethodCode = shippingQuote.Method.Code
+		orderEntity.ShippingMethodName = shippingQuote.Method.Name
+	}
+
 	err = txOrderRepo.CreateOrder(ctx, &orderEntity)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
+	actor := ActorFromClaims(claims)
+	err = txOrderRepo.CreateOrderStatusHistory(ctx, &models.OrderStatusHistory{
+		OrderID:      orderEntity.ID,
+		ToStatusCode: orderEntity.OrderStatusCode,
+		ActorUserID:  actor.UserID,
+		ActorType:    actor.Type,
+		Reason:       "Order placed",
+		BaseModel: models.BaseModel{
+			CreatedAt: now,
+			CreatedBy: actor.Name,
+		},
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	invoiceItems := make([]xendit.InvoiceItem, 0)
 	for _, p := range req.Products {
 		prod := productMap[p.ProductId]
 		if prod != nil {
 			invoiceItems = append(invoiceItems, xendit.InvoiceItem{
 				Name:     prod.Name,
-				Price:    prod.Price,
+				Price:    prices[prod.ID].Float64(),
 				Quantity: int(p.Quantity),
 			})
 		}
 	}
+	if shippingQuote.Method != nil {
+		invoiceItems = append(invoiceItems, xendit.InvoiceItem{
+			Name:     fmt.Sprintf("Shipping - %s", shippingQuote.Method.Name),
+			Price:    shippingQuote.Cost.Float64(),
+			Quantity: 1,
+		})
+	}
+
+	if coupon != nil {
+		ok, err := os.promotionRepository.WithTx(tx).IncrementUsage(ctx, coupon.Promotion.ID, claims.UserID)
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, "failed to update coupon usage")
+		}
+		if !ok {
+			tx.Rollback()
+			return nil, status.Error(codes.InvalidArgument, "Coupon usage limit has been reached")
+		}
+
+		err = txOrderRepo.CreateOrderDiscount(ctx, &models.OrderDiscount{
+			OrderID:     orderEntity.ID,
+			PromotionID: coupon.Promotion.ID,
+			Code:        coupon.Promotion.Code,
+			Description: coupon.Promotion.Name,
+			Amount:      coupon.Discount,
+			BaseModel: models.BaseModel{
+				CreatedAt: now,
+				CreatedBy: claims.FullName,
+			},
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		err = os.promotionRepository.WithTx(tx).CreatePromotionUsage(ctx, &models.PromotionUsage{
+			PromotionID:    coupon.Promotion.ID,
+			UserID:         claims.UserID,
+			OrderID:        orderEntity.ID,
+			DiscountAmount: coupon.Discount,
+			BaseModel: models.BaseModel{
+				CreatedAt: now,
+				CreatedBy: claims.FullName,
+			},
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Discounts are passed to Xendit as negative fees so the invoice lines add up to the total.
+	invoiceFees := make([]xendit.InvoiceFee, 0)
+	if coupon != nil && coupon.Discount > 0 {
+		invoiceFees = append(invoiceFees, xendit.InvoiceFee{
+			Type:  fmt.Sprintf("Discount (%s)", coupon.Promotion.Code),
+			Value: -coupon.Discount.Float64(),
+		})
+	}
+	if !taxResult.Inclusive && taxResult.Total > 0 {
+		invoiceFees = append(invoiceFees, xendit.InvoiceFee{
+			Type:  fmt.Sprintf("%s (%g%%)", taxResult.Rule.Name, taxResult.Rule.Rate),
+			Value: taxResult.Total.Float64(),
+		})
+	}
 
 	frontendURL := stdos.Getenv("FRONTEND_URL")
 
 	xenditInvoice, xenditErr := invoice.CreateWithContext(ctx, &invoice.CreateParams{
 		ExternalID: fmt.Sprint(orderEntity.ID),
-		Amount:     total,
+		Amount:     total.Float64(),
 		Customer: xendit.InvoiceCustomer{
 			GivenNames: claims.FullName,
 		},
-		Currency:           "IDR",
+		Currency:           currencyCode,
 		SuccessRedirectURL: fmt.Sprintf("%s/checkout/%d/success", frontendURL, orderEntity.ID),
 		Items:              invoiceItems,
+		Fees:               invoiceFees,
 	})
 	if xenditErr != nil {
 		tx.Rollback()
@@ -145,24 +402,35 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 		orderEntity.XenditInvoiceUrl = xenditInvoice.InvoiceURL
 	}
 
+	// The sequence row stays locked until the transaction ends, which keeps
+	// numbers gap free, so it is taken after the gateway call and checkouts
+	// only wait on each other for the rest of the transaction.
+	orderEntity.Number, err = os.numberingService.Next(ctx, os.numberingRepository.WithTx(tx), models.NumberingModuleOrder)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get order number")
+	}
+
 	err = txOrderRepo.UpdateOrder(ctx, &orderEntity)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
-	for _, p := range req.Products {
+	for i, p := range req.Products {
 		product := productMap[p.ProductId]
-		subtotal := product.Price * float64(p.Quantity)
 
 		var orderItem = models.OrderItem{
-			ProductID:    uint(p.ProductId),
-			ProductName:  product.Name,
-			ProductImage: product.ImageURL,
-			ProductPrice: product.Price,
-			Quantity:     int(p.Quantity),
-			Subtotal:     subtotal,
-			OrderID:      orderEntity.ID,
+			ProductID:      uint(p.ProductId),
+			ProductName:    product.Name,
+			ProductImage:   product.ImageURL,
+			ProductPrice:   prices[product.ID],
+			Quantity:       int(p.Quantity),
+			Subtotal:       lineSubtotals[i],
+			DiscountAmount: lineDiscounts[i],
+			TaxRate:        taxResult.Rates[i],
+			TaxAmount:      taxResult.Amounts[i],
+			OrderID:        orderEntity.ID,
 			BaseModel: models.BaseModel{
 				CreatedAt: now,
 				CreatedBy: claims.FullName,
@@ -174,6 +442,7 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 			tx.Rollback()
 			return nil, err
 		}
+		orderEntity.Items = append(orderEntity.Items, &orderItem)
 
 		cart, err := os.cartRepository.GetCartByProductUserID(ctx, uint(p.ProductId), claims.UserID)
 		if err != nil {
@@ -190,11 +459,16 @@ func (os *orderService) CreateOrder(ctx context.Context, req *order.CreateOrderR
 		}
 	}
 
-	numbering.Number++
-	err = txOrderRepo.UpdateNumbering(ctx, numbering)
+	createdEvent, err := newOrderOutboxEvent(models.EventTypeOrderCreated, &orderEntity, nil)
 	if err != nil {
 		tx.Rollback()
-		return nil, status.Error(codes.Internal, "failed to update order numbering")
+		return nil, status.Error(codes.Internal, "failed to build order event")
+	}
+
+	err = os.outboxRepository.WithTx(tx).CreateOutboxEvent(ctx, createdEvent)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to save order event")
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -224,7 +498,19 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, req *order.ListOrder
 		}
 	}
 
-	orders, metadata, err := os.orderRepository.GetListOrderAdmin(ctx, req.Pagination)
+	filter, msg := adminOrderFilter(req)
+	if msg != "" {
+		return &order.ListOrderAdminResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	orders, metadata, err := os.orderRepository.GetListOrderAdmin(ctx, filter, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &order.ListOrderAdminResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +523,7 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, req *order.ListOrder
 			products = append(products, &order.ListOrderAdminResponseItemProduct{
 				Id:       uint64(oi.ProductID),
 				Name:     oi.ProductName,
-				Price:    oi.ProductPrice,
+				Price:    utils.ConvertMoneyToProto(oi.ProductPrice, o.CurrencyCode),
 				Quantity: int64(oi.Quantity),
 			})
 		}
@@ -247,7 +533,7 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, req *order.ListOrder
 			Number:     o.Number,
 			Customer:   o.UserFullName,
 			StatusCode: o.OrderStatusCode,
-			Total:      o.Total,
+			Total:      utils.ConvertMoneyToProto(o.Total, o.CurrencyCode),
 			CreatedAt:  utils.ConvertTimeToTimestamp(o.CreatedAt),
 			Products:   products,
 		})
@@ -266,7 +552,19 @@ func (os *orderService) ListOrder(ctx context.Context, req *order.ListOrderReque
 		return nil, status.Error(codes.Internal, "failed to get user info")
 	}
 
-	orders, metadata, err := os.orderRepository.GetListOrder(ctx, claims.UserID, req.Pagination)
+	filter, msg := orderFilterFromProto(req.StatusCodes, req.CreatedFrom, req.CreatedTo)
+	if msg != "" {
+		return &order.ListOrderResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	orders, metadata, err := os.orderRepository.GetListOrder(ctx, claims.UserID, filter, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &order.ListOrderResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +577,7 @@ func (os *orderService) ListOrder(ctx context.Context, req *order.ListOrderReque
 			products = append(products, &order.ListOrderResponseItemProduct{
 				Id:       uint64(oi.ProductID),
 				Name:     oi.ProductName,
-				Price:    oi.ProductPrice,
+				Price:    utils.ConvertMoneyToProto(oi.ProductPrice, o.CurrencyCode),
 				Quantity: int64(oi.Quantity),
 			})
 		}
@@ -289,7 +587,7 @@ func (os *orderService) ListOrder(ctx context.Context, req *order.ListOrderReque
 			Number:           o.Number,
 			Customer:         o.UserFullName,
 			StatusCode:       o.OrderStatusCode,
-			Total:            o.Total,
+			Total:            utils.ConvertMoneyToProto(o.Total, o.CurrencyCode),
 			CreatedAt:        utils.ConvertTimeToTimestamp(o.CreatedAt),
 			Products:         products,
 			XenditInvoiceUrl: o.XenditInvoiceUrl,
@@ -326,25 +624,114 @@ func (os *orderService) DetailOrder(ctx context.Context, req *order.DetailOrderR
 	items := make([]*order.DetailOrderResponseItem, 0)
 	for _, oi := range orderEntity.Items {
 		items = append(items, &order.DetailOrderResponseItem{
-			Id:       uint64(oi.ProductID),
-			Name:     oi.ProductName,
-			Price:    oi.ProductPrice,
-			Quantity: int64(oi.Quantity),
+			Id:          uint64(oi.ProductID),
+			Name:        oi.ProductName,
+			Price:       utils.ConvertMoneyToProto(oi.ProductPrice, orderEntity.CurrencyCode),
+			Quantity:    int64(oi.Quantity),
+			TaxRate:     oi.TaxRate,
+			TaxAmount:   utils.ConvertMoneyToProto(oi.TaxAmount, orderEntity.CurrencyCode),
+			OrderItemId: uint64(oi.ID),
 		})
 	}
 
+	discounts := make([]*order.DetailOrderResponseDiscount, 0)
+	for _, od := range orderEntity.Discounts {
+		discounts = append(discounts, &order.DetailOrderResponseDiscount{
+			Code:        od.Code,
+			Description: od.Description,
+			Amount:      utils.ConvertMoneyToProto(od.Amount, orderEntity.CurrencyCode),
+		})
+	}
+
+	itemNames := make(map[uint]string)
+	for _, oi := range orderEntity.Items {
+		itemNames[oi.ID] = oi.ProductName
+	}
+
+	shipments := make([]*order.DetailOrderResponseShipment, 0)
+	for _, sh := range orderEntity.Shipments {
+		shipmentItems := make([]*order.DetailOrderResponseShipmentItem, 0)
+		for _, si := range sh.Items {
+			shipmentItems = append(shipmentItems, &order.DetailOrderResponseShipmentItem{
+				OrderItemId: uint64(si.OrderItemID),
+				Name:        itemNames[si.OrderItemID],
+				Quantity:    int64(si.Quantity),
+			})
+		}
+
+		shipments = append(shipments, &order.DetailOrderResponseShipment{
+			Id:             uint64(sh.ID),
+			Carrier:        sh.Carrier,
+			TrackingNumber: sh.TrackingNumber,
+			Status:         sh.Status,
+			ShippedAt:      utils.ConvertTimeToTimestamp(sh.ShippedAt),
+			DeliveredAt:    optionalTimeToProto(sh.DeliveredAt),
+			Items:          shipmentItems,
+		})
+	}
+
+	orderReturns := make([]*order.DetailOrderResponseReturn, 0)
+	for _, r := range orderEntity.Returns {
+		returnItems := make([]*order.DetailOrderResponseReturnItem, 0)
+		for _, ri := range r.Items {
+			var accepted *int64
+			if ri.AcceptedQuantity != nil {
+				value := int64(*ri.AcceptedQuantity)
+				accepted = &value
+			}
+
+			returnItems = append(returnItems, &order.DetailOrderResponseReturnItem{
+				OrderItemId:      uint64(ri.OrderItemID),
+				Name:             itemNames[ri.OrderItemID],
+				Quantity:         int64(ri.Quantity),
+				AcceptedQuantity: accepted,
+			})
+		}
+
+		orderReturns = append(orderReturns, &order.DetailOrderResponseReturn{
+			Id:           uint64(r.ID),
+			Number:       r.Number,
+			Status:       r.Status,
+			Reason:       r.Reason,
+			Items:        returnItems,
+			RefundAmount: utils.ConvertMoneyToProto(r.RefundAmount, r.CurrencyCode),
+			RefundStatus: r.RefundStatus,
+			CreatedAt:    utils.ConvertTimeToTimestamp(r.CreatedAt),
+		})
+	}
+
+	// Orders placed before discounts existed have no stored subtotal.
+	subtotal := orderEntity.Subtotal
+	if subtotal == 0 {
+		subtotal = orderEntity.Total
+	}
+
 	return &order.DetailOrderResponse{
-		Base:             utils.SuccessResponse("Detail order success"),
-		Id:               fmt.Sprint(orderEntity.ID),
-		Number:           orderEntity.Number,
-		UserFullName:     orderEntity.UserFullName,
-		Address:          orderEntity.Address,
-		PhoneNumber:      orderEntity.PhoneNumber,
-		Notes:            orderEntity.Notes,
-		OrderStatusCode:  orderEntity.OrderStatusCode,
-		CreatedAt:        utils.ConvertTimeToTimestamp(orderEntity.CreatedAt),
-		XenditInvoiceUrl: orderEntity.XenditInvoiceUrl,
-		Items:            items,
+		Base:               utils.SuccessResponse("Detail order success"),
+		Id:                 fmt.Sprint(orderEntity.ID),
+		Number:             orderEntity.Number,
+		UserFullName:       orderEntity.UserFullName,
+		Address:            orderEntity.Address,
+		PhoneNumber:        orderEntity.PhoneNumber,
+		Notes:              orderEntity.Notes,
+		OrderStatusCode:    orderEntity.OrderStatusCode,
+		CreatedAt:          utils.ConvertTimeToTimestamp(orderEntity.CreatedAt),
+		XenditInvoiceUrl:   orderEntity.XenditInvoiceUrl,
+		Items:              items,
+		Subtotal:           utils.ConvertMoneyToProto(subtotal, orderEntity.CurrencyCode),
+		DiscountTotal:      utils.ConvertMoneyToProto(orderEntity.DiscountTotal, orderEntity.CurrencyCode),
+		TaxTotal:           utils.ConvertMoneyToProto(orderEntity.TaxTotal, orderEntity.CurrencyCode),
+		ShippingTotal:      utils.ConvertMoneyToProto(orderEntity.ShippingTotal, orderEntity.CurrencyCode),
+		TaxInclusive:       orderEntity.TaxInclusive,
+		ShippingMethodName: orderEntity.ShippingMethodName,
+		ShippingRegion:     orderEntity.ShippingRegion,
+		Total:              utils.ConvertMoneyToProto(orderEntity.Total, orderEntity.CurrencyCode),
+		Discounts:          discounts,
+		Shipments:          shipments,
+		StatusHistory:      orderStatusHistoryToProto(orderEntity.StatusHistory),
+		RefundStatus:       orderEntity.RefundStatus,
+		RefundAmount:       utils.ConvertMoneyToProto(orderEntity.RefundAmount, orderEntity.CurrencyCode),
+		Returns:            orderReturns,
 	}, nil
 }
 
@@ -371,69 +758,442 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, req *order.Update
 		return nil, status.Error(codes.PermissionDenied, "you can only update your own orders")
 	}
 
-	currentStatus := orderEntity.OrderStatusCode
-
 	newStatus := strings.ToLower(req.NewStatusCode)
+	actor := ActorFromClaims(claims)
 
-	allowedTransitions := map[string]map[string]bool{
-		models.OrderStatusCodeUnpaid: {
-			models.OrderStatusCodePaid:     true,
-			models.OrderStatusCodeCanceled: true,
-		},
-		models.OrderStatusCodePaid: {
-			models.OrderStatusCodeShipped:  true,
-			models.OrderStatusCodeCanceled: true,
-		},
-		models.OrderStatusCodeShipped: {
-			models.OrderStatusCodeCompleted: true,
-			models.OrderStatusCodeDone:      true,
-		},
-		models.OrderStatusCodeCompleted: {
-			models.OrderStatusCodeDone: true,
-		},
+	err = os.stateMachine.CanTransition(ctx, orderEntity.OrderStatusCode, newStatus, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shipping needs the carrier and the items sent, which only CreateShipment takes.
+	if newStatus == models.OrderStatusCodeShipped {
+		return nil, status.Error(codes.FailedPrecondition, "use CreateShipment to mark order as shipped")
+	}
+
+	// Canceling restocks and refunds, which only CancelOrder does.
+	if newStatus == models.OrderStatusCodeCanceled {
+		return nil, status.Error(codes.FailedPrecondition, "use CancelOrder to cancel order")
+	}
+
+	event, err := os.stateMachine.Transition(ctx, os.orderRepository, orderEntity, newStatus, actor, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	os.stateMachine.Publish(event)
+
+	return &order.UpdateOrderStatusResponse{
+		Base: utils.SuccessResponse("Order status updated successfully"),
+	}, nil
+}
+
+func (os *orderService) CreateShipment(ctx context.Context, req *order.CreateShipmentRequest) (*order.CreateShipmentResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create shipment")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
 	}
 
-	if transitions, exists := allowedTransitions[currentStatus]; !exists || !transitions[newStatus] {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid status transition from %s to %s", currentStatus, newStatus)
+	tx, err := os.orderRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
 	}
 
-	switch newStatus {
-	case models.OrderStatusCodePaid:
-		if !isAdmin {
-			return nil, status.Error(codes.PermissionDenied, "only admin can mark order as paid")
+	txOrderRepo := os.orderRepository.WithTx(tx)
+
+	// The order stays locked until the shipment is saved, so two shipments
+	// cannot both take the same remaining items.
+	_, err = txOrderRepo.LockOrderStatus(ctx, uint(orderID))
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	orderEntity, err := txOrderRepo.GetOrderByID(ctx, uint(orderID))
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	// Later shipments of a partially shipped order keep it in shipped.
+	if orderEntity.OrderStatusCode != models.OrderStatusCodePaid && orderEntity.OrderStatusCode != models.OrderStatusCodeShipped {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot ship an order with status %s", orderEntity.OrderStatusCode)
+	}
+
+	now := time.Now()
+	remaining := unshippedQuantities(orderEntity)
+	shipmentItems := make([]*models.ShipmentItem, 0)
+
+	newShipmentItem := func(orderItemID uint, quantity int) *models.ShipmentItem {
+		return &models.ShipmentItem{
+			OrderItemID: orderItemID,
+			Quantity:    quantity,
+			BaseModel: models.BaseModel{
+				CreatedAt: now,
+				CreatedBy: claims.FullName,
+			},
 		}
-	case models.OrderStatusCodeShipped:
-		if !isAdmin {
-			return nil, status.Error(codes.PermissionDenied, "only admin can mark order as shipped")
+	}
+
+	if len(req.Items) == 0 {
+		for _, oi := range orderEntity.Items {
+			if remaining[oi.ID] > 0 {
+				shipmentItems = append(shipmentItems, newShipmentItem(oi.ID, remaining[oi.ID]))
+			}
 		}
-	case models.OrderStatusCodeCanceled:
+	} else {
+		for _, item := range req.Items {
+			left, exists := remaining[uint(item.OrderItemId)]
+			if !exists {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "order item %d is not part of this order", item.OrderItemId)
+			}
+			if int(item.Quantity) > left {
+				tx.Rollback()
+				return nil, status.Errorf(codes.InvalidArgument, "only %d of order item %d are left to ship", left, item.OrderItemId)
+			}
 
-		if !isAdmin && !isOwner {
-			return nil, status.Error(codes.PermissionDenied, "you can only cancel your own orders")
+			remaining[uint(item.OrderItemId)] -= int(item.Quantity)
+			shipmentItems = append(shipmentItems, newShipmentItem(uint(item.OrderItemId), int(item.Quantity)))
 		}
-	case models.OrderStatusCodeCompleted, models.OrderStatusCodeDone:
+	}
 
+	if len(shipmentItems) == 0 {
+		tx.Rollback()
+		return nil, status.Error(codes.FailedPrecondition, "all items of the order have already been shipped")
 	}
 
-	now := time.Now()
-	orderEntity.OrderStatusCode = newStatus
-	orderEntity.UpdatedAt = &now
-	orderEntity.UpdatedBy = &claims.FullName
+	shippedAt := now
+	if req.ShippedAt != nil {
+		shippedAt = req.ShippedAt.AsTime()
+	}
 
-	err = os.orderRepository.UpdateOrder(ctx, orderEntity)
+	shipment := models.Shipment{
+		OrderID:        orderEntity.ID,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		Status:         models.ShipmentStatusInTransit,
+		ShippedAt:      shippedAt,
+		Items:          shipmentItems,
+		BaseModel: models.BaseModel{
+			CreatedAt: now,
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	err = txOrderRepo.CreateShipment(ctx, &shipment)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to update order status")
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to create shipment")
 	}
 
-	return &order.UpdateOrderStatusResponse{
-		Base: utils.SuccessResponse("Order status updated successfully"),
+	var shippedEvent *OrderStatusEvent
+	if orderEntity.OrderStatusCode == models.OrderStatusCodePaid {
+		reason := fmt.Sprintf("Shipped with %s, tracking number %s", req.Carrier, req.TrackingNumber)
+
+		shippedEvent, err = os.stateMachine.Transition(ctx, txOrderRepo, orderEntity, models.OrderStatusCodeShipped, ActorFromClaims(claims), reason)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
+	if shippedEvent != nil {
+		os.stateMachine.Publish(shippedEvent)
+	}
+
+	return &order.CreateShipmentResponse{
+		Base:       utils.SuccessResponse("Shipment created successfully"),
+		ShipmentId: uint64(shipment.ID),
+	}, nil
+}
+
+func (os *orderService) ListOrderStatusHistory(ctx context.Context, req *order.ListOrderStatusHistoryRequest) (*order.ListOrderStatusHistoryResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	history, err := os.orderRepository.GetOrderStatusHistory(ctx, uint(orderID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get order status history")
+	}
+
+	return &order.ListOrderStatusHistoryResponse{
+		Base: utils.SuccessResponse("Order status history retrieved successfully"),
+		Data: orderStatusHistoryToProto(history),
 	}, nil
 }
 
-func NewOrderService(orderRepository repositories.IOrderRepository, productRepository repositories.IProductRepository, cartRepository repositories.ICartRepository) IOrderService {
+func (os *orderService) CancelOrder(ctx context.Context, req *order.CancelOrderRequest) (*order.CancelOrderResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderByID(ctx, uint(orderID))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	if claims.RoleCode != "ADMIN" && orderEntity.UserID != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "you can only cancel your own orders")
+	}
+
+	err = os.cancellationService.CancelOrder(ctx, orderEntity, ActorFromClaims(claims), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.CancelOrderResponse{
+		Base:         utils.SuccessResponse("Order canceled successfully"),
+		RefundStatus: orderEntity.RefundStatus,
+	}, nil
+}
+
+func (os *orderService) ListOrderStatuses(ctx context.Context, req *order.ListOrderStatusesRequest) (*order.ListOrderStatusesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	statuses, err := os.orderStatusRepository.GetOrderStatuses(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get order statuses")
+	}
+
+	transitions, err := os.orderStatusRepository.GetTransitions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get order status transitions")
+	}
+
+	transitionsByStatus := make(map[string][]*order.ListOrderStatusesResponseTransition)
+	for _, t := range transitions {
+		transitionsByStatus[t.FromStatusCode] = append(transitionsByStatus[t.FromStatusCode], &order.ListOrderStatusesResponseTransition{
+			ToStatusCode:      t.ToStatusCode,
+			AllowedActorTypes: t.AllowedActorTypes,
+		})
+	}
+
+	items := make([]*order.ListOrderStatusesResponseItem, 0)
+	for _, st := range statuses {
+		items = append(items, &order.ListOrderStatusesResponseItem{
+			Code:        st.Code,
+			Name:        st.Name,
+			Description: st.Description,
+			Transitions: transitionsByStatus[st.Code],
+		})
+	}
+
+	return &order.ListOrderStatusesResponse{
+		Base: utils.SuccessResponse("Order statuses retrieved successfully"),
+		Data: items,
+	}, nil
+}
+
+// pendingOrderNumber is a unique stand-in for the number of an order that is
+// still being created. It is never committed.
+func pendingOrderNumber() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return "PENDING-" + hex.EncodeToString(b), nil
+}
+
+func orderStatusHistoryToProto(history []*models.OrderStatusHistory) []*order.OrderStatusHistoryItem {
+	items := make([]*order.OrderStatusHistoryItem, 0)
+	for _, h := range history {
+		var actorUserID *uint64
+		if h.ActorUserID != nil {
+			id := uint64(*h.ActorUserID)
+			actorUserID = &id
+		}
+
+		items = append(items, &order.OrderStatusHistoryItem{
+			FromStatusCode: h.FromStatusCode,
+			ToStatusCode:   h.ToStatusCode,
+			ActorUserId:    actorUserID,
+			ActorType:      h.ActorType,
+			ActorName:      h.CreatedBy,
+			Reason:         h.Reason,
+			CreatedAt:      utils.ConvertTimeToTimestamp(h.CreatedAt),
+		})
+	}
+
+	return items
+}
+
+// unshippedQuantities returns how many units of each order item are not in
+// a shipment yet, keyed by order item id.
+func unshippedQuantities(o *models.Order) map[uint]int {
+	remaining := make(map[uint]int)
+	for _, oi := range o.Items {
+		remaining[oi.ID] = oi.Quantity
+	}
+
+	for _, sh := range o.Shipments {
+		for _, si := range sh.Items {
+			remaining[si.OrderItemID] -= si.Quantity
+		}
+	}
+
+	return remaining
+}
+
+// orderFilterFromProto builds the filters shared by the admin and customer
+// order lists.
+func orderFilterFromProto(statusCodes []string, createdFrom *timestamppb.Timestamp, createdTo *timestamppb.Timestamp) (*repositories.OrderFilter, string) {
+	filter := &repositories.OrderFilter{
+		StatusCodes: statusCodes,
+		CreatedFrom: protoToOptionalTime(createdFrom),
+		CreatedTo:   protoToOptionalTime(createdTo),
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedTo.After(*filter.CreatedFrom) {
+		return nil, "Created to must be after created from"
+	}
+
+	return filter, ""
+}
+
+// adminOrderFilter builds the filter of the admin order list.
+func adminOrderFilter(req *order.ListOrderAdminRequest) (*repositories.OrderFilter, string) {
+	filter, msg := orderFilterFromProto(req.StatusCodes, req.CreatedFrom, req.CreatedTo)
+	if msg != "" {
+		return nil, msg
+	}
+
+	msg = applyTotalRange(filter, req.MinTotal, req.MaxTotal)
+	if msg != "" {
+		return nil, msg
+	}
+
+	filter.Customer = strings.TrimSpace(req.Customer)
+	filter.NumberPrefix = strings.TrimSpace(req.NumberPrefix)
+	filter.PaymentMethod = strings.TrimSpace(req.PaymentMethod)
+
+	return filter, ""
+}
+
+// applyTotalRange limits the filter to totals between the amounts. Both
+// amounts must be in the same currency, since totals are only comparable
+// within one.
+func applyTotalRange(filter *repositories.OrderFilter, minTotal *common.Money, maxTotal *common.Money) string {
+	for _, m := range []*common.Money{minTotal, maxTotal} {
+		if m == nil {
+			continue
+		}
+
+		currencyCode, err := money.NormalizeCurrency(m.CurrencyCode)
+		if err != nil {
+			return fmt.Sprintf("Currency %s is not supported", m.CurrencyCode)
+		}
+		if filter.TotalCurrencyCode != "" && filter.TotalCurrencyCode != currencyCode {
+			return "Min total and max total must be in the same currency"
+		}
+		filter.TotalCurrencyCode = currencyCode
+	}
+
+	if minTotal != nil {
+		amount := money.FromMinor(minTotal.MinorUnits)
+		filter.MinTotal = &amount
+	}
+	if maxTotal != nil {
+		amount := money.FromMinor(maxTotal.MinorUnits)
+		filter.MaxTotal = &amount
+	}
+
+	if filter.MinTotal != nil && filter.MaxTotal != nil && *filter.MaxTotal < *filter.MinTotal {
+		return "Max total must not be less than min total"
+	}
+
+	return ""
+}
+
+// allocateDiscount spreads a discount over the eligible lines in proportion
+// to their subtotals. The last eligible line takes the rounding remainder so
+// the shares add up to the discount exactly.
+func allocateDiscount(subtotals []money.Amount, eligible []bool, discount money.Amount) []money.Amount {
+	shares := make([]money.Amount, len(subtotals))
+
+	var eligibleSubtotal money.Amount
+	last := -1
+	for i, subtotal := range subtotals {
+		if eligible[i] {
+			eligibleSubtotal += subtotal
+			last = i
+		}
+	}
+
+	if last < 0 || eligibleSubtotal == 0 {
+		return shares
+	}
+
+	var allocated money.Amount
+	for i, subtotal := range subtotals {
+		if !eligible[i] {
+			continue
+		}
+		if i == last {
+			shares[i] = discount - allocated
+			break
+		}
+		shares[i] = money.Amount(int64(discount) * int64(subtotal) / int64(eligibleSubtotal))
+		allocated += shares[i]
+	}
+
+	return shares
+}
+
+func NewOrderService(orderRepository repositories.IOrderRepository, productRepository repositories.IProductRepository, cartRepository repositories.ICartRepository, promotionRepository repositories.IPromotionRepository, promotionService IPromotionService, pricingService IPricingService, taxService ITaxService, shippingService IShippingService, orderStatusRepository repositories.IOrderStatusRepository, stateMachine IOrderStateMachine, cancellationService IOrderCancellationService, numberingRepository repositories.INumberingRepository, numberingService INumberingService, orderEvents *eventbus.Bus[OrderStatusEvent], outboxRepository repositories.IOutboxRepository) IOrderService {
 	return &orderService{
-		orderRepository:   orderRepository,
-		productRepository: productRepository,
-		cartRepository:    cartRepository,
+		orderRepository:       orderRepository,
+		productRepository:     productRepository,
+		cartRepository:        cartRepository,
+		promotionRepository:   promotionRepository,
+		promotionService:      promotionService,
+		pricingService:        pricingService,
+		taxService:            taxService,
+		shippingService:       shippingService,
+		orderStatusRepository: orderStatusRepository,
+		stateMachine:          stateMachine,
+		cancellationService:   cancellationService,
+		numberingRepository:   numberingRepository,
+		numberingService:      numberingService,
+		orderEvents:           orderEvents,
+		outboxRepository:      outboxRepository,
 	}
 }
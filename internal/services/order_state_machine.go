@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/entity"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/eventbus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderActor is whoever changes an order's status.
+type OrderActor struct {
+	Type   string
+	UserID *uint
+	Name   string
+}
+
+var (
+	SystemActor  = OrderActor{Type: models.OrderActorTypeSystem, Name: "System"}
+	WebhookActor = OrderActor{Type: models.OrderActorTypeWebhook, Name: "System"}
+)
+
+func ActorFromClaims(claims *entity.JwtClaims) OrderActor {
+	actorType := models.OrderActorTypeUser
+	if claims.RoleCode == "ADMIN" {
+		actorType = models.OrderActorTypeAdmin
+	}
+
+	userID := claims.UserID
+	return OrderActor{
+		Type:   actorType,
+		UserID: &userID,
+		Name:   claims.FullName,
+	}
+}
+
+// OrderStatusEvent is published on the order event bus after an order's
+// status changed.
+type OrderStatusEvent struct {
+	OrderID        uint
+	UserID         uint
+	OrderNumber    string
+	FromStatusCode string
+	ToStatusCode   string
+	ActorType      string
+	Reason         string
+	ChangedAt      time.Time
+}
+
+// changeOrderStatus moves the order to the new status and returns the
+// history entry to save with it.
+func changeOrderStatus(orderEntity *models.Order, newStatus string, actor OrderActor, reason string) *models.OrderStatusHistory {
+	now := time.Now()
+	history := &models.OrderStatusHistory{
+		OrderID:        orderEntity.ID,
+		FromStatusCode: orderEntity.OrderStatusCode,
+		ToStatusCode:   newStatus,
+		ActorUserID:    actor.UserID,
+		ActorType:      actor.Type,
+		Reason:         reason,
+		BaseModel: models.BaseModel{
+			CreatedAt: now,
+			CreatedBy: actor.Name,
+		},
+	}
+
+	orderEntity.OrderStatusCode = newStatus
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &actor.Name
+
+	return history
+}
+
+// IOrderStateMachine is the only place order statuses are changed. It checks
+// each change against the transitions stored in the database and saves the
+// outbox event for integrations with it. The event for watchers is returned
+// to the caller, who publishes it once the change is committed.
+type IOrderStateMachine interface {
+	CanTransition(ctx context.Context, fromStatusCode string, toStatusCode string, actor OrderActor) error
+	Transition(ctx context.Context, orderRepository repositories.IOrderRepository, orderEntity *models.Order, toStatusCode string, actor OrderActor, reason string) (*OrderStatusEvent, error)
+	Publish(event *OrderStatusEvent)
+}
+
+type orderStateMachine struct {
+	orderStatusRepository repositories.IOrderStatusRepository
+	orderEvents           *eventbus.Bus[OrderStatusEvent]
+}
+
+// CanTransition returns a status error when the transition does not exist
+// or the actor is not allowed to make it.
+func (osm *orderStateMachine) CanTransition(ctx context.Context, fromStatusCode string, toStatusCode string, actor OrderActor) error {
+	transition, err := osm.orderStatusRepository.GetTransition(ctx, fromStatusCode, toStatusCode)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to get order status transition")
+	}
+
+	if transition == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid status transition from %s to %s", fromStatusCode, toStatusCode)
+	}
+
+	for _, actorType := range transition.AllowedActorTypes {
+		if actorType == actor.Type {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "%s cannot change order status from %s to %s", actor.Type, fromStatusCode, toStatusCode)
+}
+
+// Transition moves the order to the new status and records it in the
+// status history. The change is only made when the order is still in the
+// status it was loaded with, otherwise an Aborted status error is returned.
+// Pass a repository bound to a transaction to make the change part of it.
+// The returned event is for Publish after the change is committed.
+func (osm *orderStateMachine) Transition(ctx context.Context, orderRepository repositories.IOrderRepository, orderEntity *models.Order, toStatusCode string, actor OrderActor, reason string) (*OrderStatusEvent, error) {
+	err := osm.CanTransition(ctx, orderEntity.OrderStatusCode, toStatusCode, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	history := changeOrderStatus(orderEntity, toStatusCode, actor, reason)
+
+	events := make([]*models.OutboxEvent, 0, 1)
+	if eventType := orderStatusEventType(toStatusCode); eventType != "" {
+		event, err := newOrderOutboxEvent(eventType, orderEntity, history)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to build order event")
+		}
+		events = append(events, event)
+	}
+
+	err = orderRepository.UpdateOrderStatus(ctx, orderEntity, history, events...)
+	if errors.Is(err, repositories.ErrOrderStatusChanged) {
+		return nil, status.Errorf(codes.Aborted, "order status was changed from %s by another request", history.FromStatusCode)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update order status")
+	}
+
+	return &OrderStatusEvent{
+		OrderID:        orderEntity.ID,
+		UserID:         orderEntity.UserID,
+		OrderNumber:    orderEntity.Number,
+		FromStatusCode: history.FromStatusCode,
+		ToStatusCode:   history.ToStatusCode,
+		ActorType:      history.ActorType,
+		Reason:         history.Reason,
+		ChangedAt:      history.CreatedAt,
+	}, nil
+}
+
+// Publish tells the order's watchers about a committed status change.
+func (osm *orderStateMachine) Publish(event *OrderStatusEvent) {
+	osm.orderEvents.Publish(*event)
+}
+
+func NewOrderStateMachine(orderStatusRepository repositories.IOrderStatusRepository, orderEvents *eventbus.Bus[OrderStatusEvent]) IOrderStateMachine {
+	return &orderStateMachine{
+		orderStatusRepository: orderStatusRepository,
+		orderEvents:           orderEvents,
+	}
+}
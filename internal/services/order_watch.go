@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orderWatchBuffer is how many status changes a watcher may fall behind by
+// before its stream is ended.
+const orderWatchBuffer = 16
+
+// WatchOrder streams the status changes of one order, starting with the
+// status it has now, until the client goes away.
+func (os *orderService) WatchOrder(req *order.WatchOrderRequest, stream order.OrderService_WatchOrderServer) error {
+	ctx := stream.Context()
+
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to get user info")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	// Subscribe before reading the order so no change falls in between.
+	events, unsubscribe := os.orderEvents.Subscribe(orderWatchBuffer, func(event OrderStatusEvent) bool {
+		return event.OrderID == uint(orderID)
+	})
+	defer unsubscribe()
+
+	orderEntity, err := os.orderRepository.GetOrderByID(ctx, uint(orderID))
+	if err != nil {
+		return status.Error(codes.NotFound, "order not found")
+	}
+
+	if claims.RoleCode != "ADMIN" && orderEntity.UserID != claims.UserID {
+		return status.Error(codes.PermissionDenied, "you can only view your own orders")
+	}
+
+	changedAt := orderEntity.CreatedAt
+	if len(orderEntity.StatusHistory) > 0 {
+		changedAt = orderEntity.StatusHistory[len(orderEntity.StatusHistory)-1].CreatedAt
+	}
+
+	err = stream.Send(&order.WatchOrderResponse{
+		OrderId:      fmt.Sprint(orderEntity.ID),
+		OrderNumber:  orderEntity.Number,
+		ToStatusCode: orderEntity.OrderStatusCode,
+		ChangedAt:    utils.ConvertTimeToTimestamp(changedAt),
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendOrderEvents(ctx, events, stream.Send)
+}
+
+// WatchOrders streams the status changes of all orders to an admin, limited
+// to the requested statuses.
+func (os *orderService) WatchOrders(req *order.WatchOrdersRequest, stream order.OrderService_WatchOrdersServer) error {
+	claims, err := utils.GetClaimsFromContext(stream.Context())
+	if err != nil {
+		return status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return status.Error(codes.PermissionDenied, "Only administrators can perform this action")
+	}
+
+	statusCodes := make(map[string]bool)
+	for _, statusCode := range req.StatusCodes {
+		statusCodes[statusCode] = true
+	}
+
+	events, unsubscribe := os.orderEvents.Subscribe(orderWatchBuffer, func(event OrderStatusEvent) bool {
+		return len(statusCodes) == 0 || statusCodes[event.ToStatusCode]
+	})
+	defer unsubscribe()
+
+	return sendOrderEvents(stream.Context(), events, stream.Send)
+}
+
+func sendOrderEvents(ctx context.Context, events <-chan OrderStatusEvent, send func(*order.WatchOrderResponse) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "order updates fell behind, watch again")
+			}
+
+			err := send(&order.WatchOrderResponse{
+				OrderId:        fmt.Sprint(event.OrderID),
+				OrderNumber:    event.OrderNumber,
+				FromStatusCode: event.FromStatusCode,
+				ToStatusCode:   event.ToStatusCode,
+				ActorType:      event.ActorType,
+				Reason:         event.Reason,
+				ChangedAt:      utils.ConvertTimeToTimestamp(event.ChangedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
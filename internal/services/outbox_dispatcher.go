@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/eventbus"
+)
+
+const outboxBatchSize = 100
+
+// IOutboxDispatcher moves committed outbox events on. Each one becomes a
+// delivery for every webhook subscription that asked for its type and, for
+// order events, a notification for the order's owner. It is then published
+// on the domain event bus.
+type IOutboxDispatcher interface {
+	DispatchEvents(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type outboxDispatcher struct {
+	outboxRepository              repositories.IOutboxRepository
+	webhookSubscriptionRepository repositories.IWebhookSubscriptionRepository
+	notificationRepository        repositories.INotificationRepository
+	domainEvents                  *eventbus.Bus[DomainEvent]
+}
+
+// DispatchEvents dispatches one batch of events and returns how many it
+// took. The events are locked while they are dispatched, so several
+// instances can run side by side.
+func (od *outboxDispatcher) DispatchEvents(ctx context.Context) (int, error) {
+	tx, err := od.outboxRepository.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	events, err := od.outboxRepository.WithTx(tx).GetUndispatchedEvents(ctx, outboxBatchSize)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if len(events) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	txSubscriptionRepo := od.webhookSubscriptionRepository.WithTx(tx)
+
+	subscriptions, err := txSubscriptionRepo.GetActiveSubscriptions(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	now := time.Now()
+	ids := make([]uint, 0, len(events))
+	deliveries := make([]*models.WebhookDelivery, 0)
+	notifications := make([]*models.Notification, 0)
+	for _, event := range events {
+		ids = append(ids, event.ID)
+
+		notification, err := newOrderNotification(event, now)
+		if err != nil {
+			log.Printf("failed to queue notification of outbox event %d: %v", event.ID, err)
+		} else if notification != nil {
+			notifications = append(notifications, notification)
+		}
+
+		for _, subscription := range subscriptions {
+			if !subscriptionWantsEvent(subscription, event) {
+				continue
+			}
+
+			deliveries = append(deliveries, &models.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				OutboxEventID:  event.ID,
+				EventType:      event.EventType,
+				Status:         models.WebhookDeliveryStatusPending,
+				NextAttemptAt:  now,
+				CreatedAt:      now,
+			})
+		}
+	}
+
+	err = txSubscriptionRepo.CreateDeliveries(ctx, deliveries)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = od.notificationRepository.WithTx(tx).CreateNotifications(ctx, notifications)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = od.outboxRepository.WithTx(tx).MarkEventsDispatched(ctx, ids, now)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		od.domainEvents.Publish(DomainEvent{
+			ID:            event.ID,
+			Type:          event.EventType,
+			AggregateType: event.AggregateType,
+			AggregateID:   event.AggregateID,
+			OccurredAt:    event.OccurredAt,
+			Payload:       event.Payload,
+		})
+	}
+
+	return len(events), nil
+}
+
+// Run dispatches events every interval until ctx is done, draining the
+// outbox each time.
+func (od *outboxDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				dispatched, err := od.DispatchEvents(ctx)
+				if err != nil {
+					log.Printf("failed to dispatch outbox events: %v", err)
+				}
+				if err != nil || dispatched < outboxBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// subscriptionWantsEvent reports whether the subscription gets the event.
+// Events addressed to one subscription, such as pings, only go there.
+func subscriptionWantsEvent(subscription *models.WebhookSubscription, event *models.OutboxEvent) bool {
+	if event.SubscriptionID != nil {
+		return *event.SubscriptionID == subscription.ID
+	}
+
+	for _, eventType := range subscription.EventTypes {
+		if eventType == event.EventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewOutboxDispatcher(outboxRepository repositories.IOutboxRepository, webhookSubscriptionRepository repositories.IWebhookSubscriptionRepository, notificationRepository repositories.INotificationRepository, domainEvents *eventbus.Bus[DomainEvent]) IOutboxDispatcher {
+	return &outboxDispatcher{
+		outboxRepository:              outboxRepository,
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		notificationRepository:        notificationRepository,
+		domainEvents:                  domainEvents,
+	}
+}
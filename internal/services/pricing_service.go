@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/pricing"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IPricingService interface {
+	SetProductPrice(ctx context.Context, req *pricing.SetProductPriceRequest) (*pricing.SetProductPriceResponse, error)
+	DeleteProductPrice(ctx context.Context, req *pricing.DeleteProductPriceRequest) (*pricing.DeleteProductPriceResponse, error)
+	ListProductPrices(ctx context.Context, req *pricing.ListProductPricesRequest) (*pricing.ListProductPricesResponse, error)
+	SetExchangeRate(ctx context.Context, req *pricing.SetExchangeRateRequest) (*pricing.SetExchangeRateResponse, error)
+	ListExchangeRates(ctx context.Context, req *pricing.ListExchangeRatesRequest) (*pricing.ListExchangeRatesResponse, error)
+	DeriveProductPrices(ctx context.Context, req *pricing.DeriveProductPricesRequest) (*pricing.DeriveProductPricesResponse, error)
+	ResolvePrices(ctx context.Context, currencyCode string, products []*models.Product) (map[uint]money.Amount, error)
+	ConvertAmount(ctx context.Context, amount money.Amount, currencyCode string) (money.Amount, bool, error)
+}
+
+type pricingService struct {
+	pricingRepository repositories.IPricingRepository
+	productRepository repositories.IProductRepository
+}
+
+func (ps *pricingService) SetProductPrice(ctx context.Context, req *pricing.SetProductPriceRequest) (*pricing.SetProductPriceResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update product prices")
+	}
+
+	currencyCode, err := resolveForeignCurrency(req.Price.CurrencyCode)
+	if err != nil {
+		return &pricing.SetProductPriceResponse{
+			Base: utils.BadRequestResponse(err.Error()),
+		}, nil
+	}
+
+	if req.Price.MinorUnits <= 0 {
+		return &pricing.SetProductPriceResponse{
+			Base: utils.BadRequestResponse("Price must be greater than 0"),
+		}, nil
+	}
+
+	_, err = ps.productRepository.GetProductByID(ctx, uint(req.ProductId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	err = ps.pricingRepository.UpsertProductPrice(ctx, &models.ProductPrice{
+		ProductID:    uint(req.ProductId),
+		CurrencyCode: currencyCode,
+		Price:        money.FromMinor(req.Price.MinorUnits),
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to set product price: %v", err))
+	}
+
+	return &pricing.SetProductPriceResponse{
+		Base: utils.SuccessResponse("Product price updated successfully"),
+	}, nil
+}
+
+func (ps *pricingService) DeleteProductPrice(ctx context.Context, req *pricing.DeleteProductPriceRequest) (*pricing.DeleteProductPriceResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can delete product prices")
+	}
+
+	currencyCode, err := resolveForeignCurrency(req.CurrencyCode)
+	if err != nil {
+		return &pricing.DeleteProductPriceResponse{
+			Base: utils.BadRequestResponse(err.Error()),
+		}, nil
+	}
+
+	err = ps.pricingRepository.DeleteProductPrice(ctx, uint(req.ProductId), currencyCode, claims.FullName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete product price: %v", err))
+	}
+
+	return &pricing.DeleteProductPriceResponse{
+		Base: utils.SuccessResponse("Product price deleted successfully"),
+	}, nil
+}
+
+func (ps *pricingService) ListProductPrices(ctx context.Context, req *pricing.ListProductPricesRequest) (*pricing.ListProductPricesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	product, err := ps.productRepository.GetProductByID(ctx, uint(req.ProductId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	prices, err := ps.pricingRepository.GetPricesByProductID(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*pricing.ListProductPricesResponseItem{
+		{Price: utils.ConvertMoneyToProto(product.Price, money.DefaultCurrency)},
+	}
+	for _, p := range prices {
+		items = append(items, &pricing.ListProductPricesResponseItem{
+			Price:     utils.ConvertMoneyToProto(p.Price, p.CurrencyCode),
+			IsDerived: p.IsDerived,
+		})
+	}
+
+	return &pricing.ListProductPricesResponse{
+		Base:   utils.SuccessResponse("Product prices retrieved successfully"),
+		Prices: items,
+	}, nil
+}
+
+func (ps *pricingService) SetExchangeRate(ctx context.Context, req *pricing.SetExchangeRateRequest) (*pricing.SetExchangeRateResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update exchange rates")
+	}
+
+	currencyCode, err := resolveForeignCurrency(req.CurrencyCode)
+	if err != nil {
+		return &pricing.SetExchangeRateResponse{
+			Base: utils.BadRequestResponse(err.Error()),
+		}, nil
+	}
+
+	err = ps.pricingRepository.UpsertExchangeRate(ctx, &models.ExchangeRate{
+		CurrencyCode: currencyCode,
+		Rate:         req.Rate,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to set exchange rate: %v", err))
+	}
+
+	_, err = ps.pricingRepository.RefreshDerivedPrices(ctx, 0, currencyCode, claims.FullName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to refresh derived prices: %v", err))
+	}
+
+	return &pricing.SetExchangeRateResponse{
+		Base: utils.SuccessResponse("Exchange rate updated successfully"),
+	}, nil
+}
+
+func (ps *pricingService) ListExchangeRates(ctx context.Context, req *pricing.ListExchangeRatesRequest) (*pricing.ListExchangeRatesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	rates, err := ps.pricingRepository.GetExchangeRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pricing.ListExchangeRatesResponseItem, 0)
+	for _, r := range rates {
+		updatedAt := r.CreatedAt
+		if r.UpdatedAt != nil {
+			updatedAt = *r.UpdatedAt
+		}
+
+		items = append(items, &pricing.ListExchangeRatesResponseItem{
+			CurrencyCode: r.CurrencyCode,
+			Rate:         r.Rate,
+			UpdatedAt:    utils.ConvertTimeToTimestamp(updatedAt),
+		})
+	}
+
+	return &pricing.ListExchangeRatesResponse{
+		Base:             utils.SuccessResponse("Exchange rates retrieved successfully"),
+		BaseCurrencyCode: money.DefaultCurrency,
+		Rates:            items,
+	}, nil
+}
+
+func (ps *pricingService) DeriveProductPrices(ctx context.Context, req *pricing.DeriveProductPricesRequest) (*pricing.DeriveProductPricesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update product prices")
+	}
+
+	currencyCode, err := resolveForeignCurrency(req.CurrencyCode)
+	if err != nil {
+		return &pricing.DeriveProductPricesResponse{
+			Base: utils.BadRequestResponse(err.Error()),
+		}, nil
+	}
+
+	rate, err := ps.pricingRepository.GetExchangeRate(ctx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if rate == nil {
+		return &pricing.DeriveProductPricesResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("No exchange rate configured for %s", currencyCode)),
+		}, nil
+	}
+
+	count, err := ps.pricingRepository.DeriveProductPrices(ctx, currencyCode, rate.Rate, req.Overwrite, claims.FullName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to derive product prices: %v", err))
+	}
+
+	return &pricing.DeriveProductPricesResponse{
+		Base:         utils.SuccessResponse("Product prices derived successfully"),
+		UpdatedCount: count,
+	}, nil
+}
+
+// ResolvePrices returns the price of each product in the currency. A product
+// without its own price in that currency falls back to the exchange rate, and
+// is left out of the map when there is no rate either.
+func (ps *pricingService) ResolvePrices(ctx context.Context, currencyCode string, products []*models.Product) (map[uint]money.Amount, error) {
+	prices := make(map[uint]money.Amount, len(products))
+
+	if currencyCode == money.DefaultCurrency {
+		for _, p := range products {
+			prices[p.ID] = p.Price
+		}
+		return prices, nil
+	}
+
+	if len(products) == 0 {
+		return prices, nil
+	}
+
+	productIds := make([]uint, 0, len(products))
+	for _, p := range products {
+		productIds = append(productIds, p.ID)
+	}
+
+	productPrices, err := ps.pricingRepository.GetProductPrices(ctx, productIds, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pp := range productPrices {
+		prices[pp.ProductID] = pp.Price
+	}
+
+	if len(prices) == len(products) {
+		return prices, nil
+	}
+
+	rate, err := ps.pricingRepository.GetExchangeRate(ctx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if rate != nil {
+		for _, p := range products {
+			if _, ok := prices[p.ID]; !ok {
+				prices[p.ID] = p.Price.Convert(rate.Rate)
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// ConvertAmount converts a store currency amount, such as a promotion's
+// minimum spend, into the currency. It reports false when no exchange rate
+// is configured.
+func (ps *pricingService) ConvertAmount(ctx context.Context, amount money.Amount, currencyCode string) (money.Amount, bool, error) {
+	if currencyCode == money.DefaultCurrency {
+		return amount, true, nil
+	}
+
+	rate, err := ps.pricingRepository.GetExchangeRate(ctx, currencyCode)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if rate == nil {
+		return 0, false, nil
+	}
+
+	return amount.Convert(rate.Rate), true, nil
+}
+
+// resolveCurrency validates the currency a shopper asked for, defaulting to
+// the store currency.
+func resolveCurrency(code string) (string, error) {
+	currencyCode, err := money.NormalizeCurrency(code)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "currency %s is not supported", code)
+	}
+	return currencyCode, nil
+}
+
+func resolveForeignCurrency(code string) (string, error) {
+	currencyCode, err := money.NormalizeCurrency(code)
+	if err != nil || code == "" {
+		return "", fmt.Errorf("Currency must be one of %v", money.SupportedCurrencies[1:])
+	}
+
+	if currencyCode == money.DefaultCurrency {
+		return "", fmt.Errorf("%s is the store currency, update the product price instead", money.DefaultCurrency)
+	}
+
+	return currencyCode, nil
+}
+
+func NewPricingService(pricingRepository repositories.IPricingRepository, productRepository repositories.IProductRepository) IPricingService {
+	return &pricingService{
+		pricingRepository: pricingRepository,
+		productRepository: productRepository,
+	}
+}
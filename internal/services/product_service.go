@@ -3,6 +3,7 @@ package services
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
@@ -10,6 +11,7 @@ import (
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/product"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -27,6 +29,9 @@ type IProductService interface {
 type productService struct {
 	productRepository repositories.IProductRepository
 	cloudinaryUtils   utils.ICloudinaryUtils
+	pricingService    IPricingService
+	pricingRepository repositories.IPricingRepository
+	outboxRepository  repositories.IOutboxRepository
 }
 
 func (ps *productService) CreateProduct(ctx context.Context, req *product.CreateProductRequest) (*product.CreateProductResponse, error) {
@@ -43,7 +48,12 @@ func (ps *productService) CreateProduct(ctx context.Context, req *product.Create
 		return nil, status.Error(codes.InvalidArgument, "product name is required")
 	}
 
-	if req.Price <= 0 {
+	price, err := utils.ConvertProtoToMoney(req.Price)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if price <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "product price must be greater than 0")
 	}
 
@@ -71,17 +81,45 @@ func (ps *productService) CreateProduct(ctx context.Context, req *product.Create
 	newProduct := &models.Product{
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       price,
 		ImageURL:    imageURL,
+		Category:    req.Category,
+		WeightGrams: int(req.WeightGrams),
+	}
+
+	if req.Stock != nil {
+		stock := int(req.GetStock())
+		newProduct.Stock = &stock
+	}
+
+	if req.MaxPerOrder != nil {
+		maxPerOrder := int(req.GetMaxPerOrder())
+		newProduct.MaxPerOrder = &maxPerOrder
 	}
 
 	newProduct.CreatedBy = claims.FullName
 
-	err = ps.productRepository.CreateProduct(ctx, newProduct)
+	tx, err := ps.outboxRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	err = ps.productRepository.WithTx(tx).CreateProduct(ctx, newProduct)
 	if err != nil {
+		tx.Rollback()
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create product: %v", err))
 	}
 
+	err = saveProductEvent(ctx, ps.outboxRepository.WithTx(tx), models.EventTypeProductCreated, newProduct)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
 	return &product.CreateProductResponse{
 		Base: utils.SuccessResponse("Product created successfully"),
 		Id:   fmt.Sprintf("%d", newProduct.ID),
@@ -89,18 +127,32 @@ func (ps *productService) CreateProduct(ctx context.Context, req *product.Create
 }
 
 func (ps *productService) DetailProduct(ctx context.Context, req *product.DetailProductRequest) (*product.DetailProductResponse, error) {
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := ps.productRepository.GetProductByID(ctx, uint(req.Id))
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "product not found")
 	}
 
+	prices, err := ps.pricingService.ResolvePrices(ctx, currencyCode, []*models.Product{res})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get product price: %v", err))
+	}
+
 	return &product.DetailProductResponse{
 		Base:        utils.SuccessResponse("Product retrieved successfully"),
 		Id:          uint64(res.ID),
 		Name:        res.Name,
 		Description: res.Description,
-		Price:       res.Price,
+		Price:       optionalPriceToProto(prices, res.ID, currencyCode),
 		ImageUrl:    res.ImageURL,
+		Stock:       optionalIntToProto(res.Stock),
+		MaxPerOrder: optionalIntToProto(res.MaxPerOrder),
+		Category:    res.Category,
+		WeightGrams: int32(res.WeightGrams),
 	}, nil
 }
 
@@ -134,8 +186,38 @@ func (ps *productService) UpdateProduct(ctx context.Context, req *product.Update
 		existingProduct.Description = req.Description
 	}
 
-	if req.Price > 0 {
-		existingProduct.Price = req.Price
+	if req.Price != nil {
+		price, err := utils.ConvertProtoToMoney(req.Price)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if price > 0 {
+			existingProduct.Price = price
+		}
+	}
+
+	if req.Stock != nil {
+		stock := int(req.GetStock())
+		existingProduct.Stock = &stock
+	}
+
+	if req.Category != "" {
+		existingProduct.Category = req.Category
+	}
+
+	if req.WeightGrams != nil {
+		existingProduct.WeightGrams = int(req.GetWeightGrams())
+	}
+
+	clearMaxPerOrder := false
+	if req.MaxPerOrder != nil {
+		if req.GetMaxPerOrder() == 0 {
+			existingProduct.MaxPerOrder = nil
+			clearMaxPerOrder = true
+		} else {
+			maxPerOrder := int(req.GetMaxPerOrder())
+			existingProduct.MaxPerOrder = &maxPerOrder
+		}
 	}
 
 	imageURL := req.ImageUrl
@@ -176,18 +258,63 @@ func (ps *productService) UpdateProduct(ctx context.Context, req *product.Update
 
 	existingProduct.UpdatedBy = &claims.FullName
 
-	err = ps.productRepository.UpdateProduct(ctx, existingProduct)
+	tx, err := ps.outboxRepository.BeginTransaction(ctx)
 	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	txProductRepo := ps.productRepository.WithTx(tx)
+
+	err = txProductRepo.UpdateProduct(ctx, existingProduct)
+	if err != nil {
+		tx.Rollback()
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update product: %v", err))
 	}
 
+	if clearMaxPerOrder {
+		err = txProductRepo.ClearMaxPerOrder(ctx, existingProduct.ID)
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update product: %v", err))
+		}
+	}
+
+	if req.WeightGrams != nil && req.GetWeightGrams() == 0 {
+		err = txProductRepo.ClearWeight(ctx, existingProduct.ID)
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update product: %v", err))
+		}
+	}
+
+	// Derived prices in other currencies follow the new store price.
+	_, err = ps.pricingRepository.WithTx(tx).RefreshDerivedPrices(ctx, existingProduct.ID, "", claims.FullName)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update derived prices: %v", err))
+	}
+
+	err = saveProductEvent(ctx, ps.outboxRepository.WithTx(tx), models.EventTypeProductUpdated, existingProduct)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
 	return &product.UpdateProductResponse{
 		Base:        utils.SuccessResponse("Product updated successfully"),
 		Id:          uint64(existingProduct.ID),
 		Name:        existingProduct.Name,
 		Description: existingProduct.Description,
-		Price:       existingProduct.Price,
+		Price:       utils.ConvertMoneyToProto(existingProduct.Price, money.DefaultCurrency),
 		ImageUrl:    existingProduct.ImageURL,
+		Stock:       optionalIntToProto(existingProduct.Stock),
+		MaxPerOrder: optionalIntToProto(existingProduct.MaxPerOrder),
+		Category:    existingProduct.Category,
+		WeightGrams: int32(existingProduct.WeightGrams),
 	}, nil
 }
 
@@ -210,11 +337,27 @@ func (ps *productService) DeleteProduct(ctx context.Context, req *product.Delete
 	existingProduct.DeletedBy = &claims.FullName
 	existingProduct.UpdatedBy = &claims.FullName
 
-	err = ps.productRepository.DeleteProduct(ctx, existingProduct)
+	tx, err := ps.outboxRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	err = ps.productRepository.WithTx(tx).DeleteProduct(ctx, existingProduct)
 	if err != nil {
+		tx.Rollback()
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete product: %v", err))
 	}
 
+	err = saveProductEvent(ctx, ps.outboxRepository.WithTx(tx), models.EventTypeProductDeleted, existingProduct)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
 	if existingProduct.ImageURL != "" {
 		publicID := ps.cloudinaryUtils.ExtractPublicIDFromURL(existingProduct.ImageURL)
 		if publicID != "" {
@@ -237,6 +380,11 @@ func (ps *productService) DeleteProduct(ctx context.Context, req *product.Delete
 }
 
 func (ps *productService) ListProduct(ctx context.Context, req *product.ListProductRequest) (*product.ListProductResponse, error) {
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Pagination == nil {
 		req.Pagination = &common.PaginationRequest{
 			CurrentPage: 1,
@@ -245,17 +393,27 @@ func (ps *productService) ListProduct(ctx context.Context, req *product.ListProd
 	}
 
 	products, pagination, err := ps.productRepository.GetProductsPagination(ctx, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &product.ListProductResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get products: %v", err))
 	}
 
+	prices, err := ps.pricingService.ResolvePrices(ctx, currencyCode, productPointers(products))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get product prices: %v", err))
+	}
+
 	var productItems []*product.ListProductResponseItem
 	for _, p := range products {
 		productItems = append(productItems, &product.ListProductResponseItem{
 			Id:          uint64(p.ID),
 			Name:        p.Name,
 			Description: p.Description,
-			Price:       p.Price,
+			Price:       optionalPriceToProto(prices, p.ID, currencyCode),
 			ImageUrl:    p.ImageURL,
 		})
 	}
@@ -285,6 +443,11 @@ func (ps *productService) ListProductAdmin(ctx context.Context, req *product.Lis
 	}
 
 	products, pagination, err := ps.productRepository.GetProductsPaginationAdmin(ctx, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &product.ListProductAdminResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get products: %v", err))
 	}
@@ -295,8 +458,11 @@ func (ps *productService) ListProductAdmin(ctx context.Context, req *product.Lis
 			Id:          uint64(p.ID),
 			Name:        p.Name,
 			Description: p.Description,
-			Price:       p.Price,
+			Price:       utils.ConvertMoneyToProto(p.Price, money.DefaultCurrency),
 			ImageUrl:    p.ImageURL,
+			Stock:       optionalIntToProto(p.Stock),
+			MaxPerOrder: optionalIntToProto(p.MaxPerOrder),
+			Category:    p.Category,
 		}
 
 		productItems = append(productItems, item)
@@ -310,18 +476,28 @@ func (ps *productService) ListProductAdmin(ctx context.Context, req *product.Lis
 }
 
 func (ps *productService) HighlightProducts(ctx context.Context, req *product.HighlightProductsRequest) (*product.HighlightProductsResponse, error) {
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
 	products, err := ps.productRepository.GetHighlightedProducts(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get highlighted products: %v", err))
 	}
 
+	prices, err := ps.pricingService.ResolvePrices(ctx, currencyCode, productPointers(products))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get product prices: %v", err))
+	}
+
 	var productItems []*product.HighlightProductsResponseItem
 	for _, p := range products {
 		productItems = append(productItems, &product.HighlightProductsResponseItem{
 			Id:          uint64(p.ID),
 			Name:        p.Name,
 			Description: p.Description,
-			Price:       p.Price,
+			Price:       optionalPriceToProto(prices, p.ID, currencyCode),
 			ImageUrl:    p.ImageURL,
 		})
 	}
@@ -332,12 +508,56 @@ func (ps *productService) HighlightProducts(ctx context.Context, req *product.Hi
 	}, nil
 }
 
+func optionalIntToProto(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	v := int32(*value)
+	return &v
+}
+
+func productPointers(products []models.Product) []*models.Product {
+	result := make([]*models.Product, 0, len(products))
+	for i := range products {
+		result = append(result, &products[i])
+	}
+	return result
+}
+
+func optionalPriceToProto(prices map[uint]money.Amount, productID uint, currencyCode string) *common.Money {
+	price, ok := prices[productID]
+	if !ok {
+		return nil
+	}
+	return utils.ConvertMoneyToProto(price, currencyCode)
+}
+
+func saveProductEvent(ctx context.Context, outboxRepository repositories.IOutboxRepository, eventType string, p *models.Product) error {
+	event, err := newProductOutboxEvent(eventType, p)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to build product event")
+	}
+
+	err = outboxRepository.CreateOutboxEvent(ctx, event)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to save product event")
+	}
+
+	return nil
+}
+
 func NewProductService(
 	productRepository repositories.IProductRepository,
 	cloudinaryUtils utils.ICloudinaryUtils,
+	pricingService IPricingService,
+	pricingRepository repositories.IPricingRepository,
+	outboxRepository repositories.IOutboxRepository,
 ) IProductService {
 	return &productService{
 		productRepository: productRepository,
 		cloudinaryUtils:   cloudinaryUtils,
+		pricingService:    pricingService,
+		pricingRepository: pricingRepository,
+		outboxRepository:  outboxRepository,
 	}
 }
@@ -0,0 +1,603 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/promotion"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PromotionLine is a single product line a coupon is evaluated against.
+type PromotionLine struct {
+	ProductID uint
+	Category  string
+	Price     money.Amount
+	Quantity  int
+}
+
+// PromotionResult is the outcome of evaluating a coupon. Reason is set when
+// the coupon cannot be applied.
+type PromotionResult struct {
+	Promotion          *models.Promotion
+	Reason             string
+	Subtotal           money.Amount
+	Discount           money.Amount
+	EligibleProductIDs []uint
+}
+
+func (r *PromotionResult) IsValid() bool {
+	return r.Reason == ""
+}
+
+type IPromotionService interface {
+	CreatePromotion(ctx context.Context, req *promotion.CreatePromotionRequest) (*promotion.CreatePromotionResponse, error)
+	UpdatePromotion(ctx context.Context, req *promotion.UpdatePromotionRequest) (*promotion.UpdatePromotionResponse, error)
+	DeletePromotion(ctx context.Context, req *promotion.DeletePromotionRequest) (*promotion.DeletePromotionResponse, error)
+	DetailPromotion(ctx context.Context, req *promotion.DetailPromotionRequest) (*promotion.DetailPromotionResponse, error)
+	ListPromotion(ctx context.Context, req *promotion.ListPromotionRequest) (*promotion.ListPromotionResponse, error)
+	ValidateCoupon(ctx context.Context, req *promotion.ValidateCouponRequest) (*promotion.ValidateCouponResponse, error)
+	EvaluateCoupon(ctx context.Context, code string, userID uint, currencyCode string, lines []PromotionLine) (*PromotionResult, error)
+}
+
+type promotionService struct {
+	promotionRepository repositories.IPromotionRepository
+	cartRepository      repositories.ICartRepository
+	pricingService      IPricingService
+}
+
+func (ps *promotionService) CreatePromotion(ctx context.Context, req *promotion.CreatePromotionRequest) (*promotion.CreatePromotionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create promotion")
+	}
+
+	code := normalizeCouponCode(req.Code)
+
+	existingPromotion, err := ps.promotionRepository.GetPromotionByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingPromotion != nil {
+		return &promotion.CreatePromotionResponse{
+			Base: utils.BadRequestResponse("Promotion code already exists"),
+		}, nil
+	}
+
+	newPromotion := &models.Promotion{
+		Code:          code,
+		Name:          req.Name,
+		Description:   req.Description,
+		DiscountType:  req.DiscountType,
+		DiscountValue: req.DiscountValue,
+		StartsAt:      protoToOptionalTime(req.StartsAt),
+		EndsAt:        protoToOptionalTime(req.EndsAt),
+		IsActive:      req.IsActive,
+		ProductIDs:    uint64sToUints(req.ProductIds),
+		Categories:    req.Categories,
+	}
+
+	if msg := applyPromotionAmounts(newPromotion, req.DiscountAmount, req.MaxDiscount, req.MinSpend); msg != "" {
+		return &promotion.CreatePromotionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	if msg := applyPromotionLimits(newPromotion, req.UsageLimit, req.PerUserLimit); msg != "" {
+		return &promotion.CreatePromotionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	newPromotion.CreatedAt = time.Now()
+	newPromotion.CreatedBy = claims.FullName
+
+	err = ps.promotionRepository.CreatePromotion(ctx, newPromotion)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create promotion: %v", err))
+	}
+
+	return &promotion.CreatePromotionResponse{
+		Base: utils.SuccessResponse("Promotion created successfully"),
+		Id:   uint64(newPromotion.ID),
+	}, nil
+}
+
+func (ps *promotionService) UpdatePromotion(ctx context.Context, req *promotion.UpdatePromotionRequest) (*promotion.UpdatePromotionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update promotion")
+	}
+
+	existingPromotion, err := ps.promotionRepository.GetPromotionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingPromotion == nil {
+		return &promotion.UpdatePromotionResponse{
+			Base: utils.NotFoundResponse("Promotion not found"),
+		}, nil
+	}
+
+	existingPromotion.Name = req.Name
+	existingPromotion.Description = req.Description
+	existingPromotion.DiscountType = req.DiscountType
+	existingPromotion.DiscountValue = req.DiscountValue
+	existingPromotion.StartsAt = protoToOptionalTime(req.StartsAt)
+	existingPromotion.EndsAt = protoToOptionalTime(req.EndsAt)
+	existingPromotion.IsActive = req.IsActive
+	existingPromotion.ProductIDs = uint64sToUints(req.ProductIds)
+	existingPromotion.Categories = req.Categories
+
+	if msg := applyPromotionAmounts(existingPromotion, req.DiscountAmount, req.MaxDiscount, req.MinSpend); msg != "" {
+		return &promotion.UpdatePromotionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	if msg := applyPromotionLimits(existingPromotion, req.UsageLimit, req.PerUserLimit); msg != "" {
+		return &promotion.UpdatePromotionResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	now := time.Now()
+	existingPromotion.UpdatedAt = &now
+	existingPromotion.UpdatedBy = &claims.FullName
+
+	err = ps.promotionRepository.UpdatePromotion(ctx, existingPromotion)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update promotion: %v", err))
+	}
+
+	return &promotion.UpdatePromotionResponse{
+		Base: utils.SuccessResponse("Promotion updated successfully"),
+	}, nil
+}
+
+func (ps *promotionService) DeletePromotion(ctx context.Context, req *promotion.DeletePromotionRequest) (*promotion.DeletePromotionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can delete promotion")
+	}
+
+	existingPromotion, err := ps.promotionRepository.GetPromotionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingPromotion == nil {
+		return &promotion.DeletePromotionResponse{
+			Base: utils.NotFoundResponse("Promotion not found"),
+		}, nil
+	}
+
+	existingPromotion.DeletedBy = &claims.FullName
+
+	err = ps.promotionRepository.DeletePromotion(ctx, existingPromotion)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete promotion: %v", err))
+	}
+
+	return &promotion.DeletePromotionResponse{
+		Base: utils.SuccessResponse("Promotion deleted successfully"),
+	}, nil
+}
+
+func (ps *promotionService) DetailPromotion(ctx context.Context, req *promotion.DetailPromotionRequest) (*promotion.DetailPromotionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	p, err := ps.promotionRepository.GetPromotionByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return &promotion.DetailPromotionResponse{
+			Base: utils.NotFoundResponse("Promotion not found"),
+		}, nil
+	}
+
+	productIds := make([]uint64, 0, len(p.ProductIDs))
+	for _, id := range p.ProductIDs {
+		productIds = append(productIds, uint64(id))
+	}
+
+	return &promotion.DetailPromotionResponse{
+		Base:           utils.SuccessResponse("Promotion retrieved successfully"),
+		Id:             uint64(p.ID),
+		Code:           p.Code,
+		Name:           p.Name,
+		Description:    p.Description,
+		DiscountType:   p.DiscountType,
+		DiscountValue:  p.DiscountValue,
+		DiscountAmount: utils.ConvertMoneyToProto(p.DiscountAmount, money.DefaultCurrency),
+		MaxDiscount:    optionalMoneyToProto(p.MaxDiscount),
+		MinSpend:       utils.ConvertMoneyToProto(p.MinSpend, money.DefaultCurrency),
+		UsageLimit:     optionalIntToProto(p.UsageLimit),
+		PerUserLimit:   optionalIntToProto(p.PerUserLimit),
+		UsedCount:      int32(p.UsedCount),
+		StartsAt:       optionalTimeToProto(p.StartsAt),
+		EndsAt:         optionalTimeToProto(p.EndsAt),
+		IsActive:       p.IsActive,
+		ProductIds:     productIds,
+		Categories:     p.Categories,
+	}, nil
+}
+
+func (ps *promotionService) ListPromotion(ctx context.Context, req *promotion.ListPromotionRequest) (*promotion.ListPromotionResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	if req.Pagination == nil {
+		req.Pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	promotions, pagination, err := ps.promotionRepository.GetPromotionsPagination(ctx, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &promotion.ListPromotionResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get promotions: %v", err))
+	}
+
+	items := make([]*promotion.ListPromotionResponseItem, 0)
+	for _, p := range promotions {
+		items = append(items, &promotion.ListPromotionResponseItem{
+			Id:             uint64(p.ID),
+			Code:           p.Code,
+			Name:           p.Name,
+			DiscountType:   p.DiscountType,
+			DiscountValue:  p.DiscountValue,
+			DiscountAmount: utils.ConvertMoneyToProto(p.DiscountAmount, money.DefaultCurrency),
+			UsedCount:      int32(p.UsedCount),
+			UsageLimit:     optionalIntToProto(p.UsageLimit),
+			StartsAt:       optionalTimeToProto(p.StartsAt),
+			EndsAt:         optionalTimeToProto(p.EndsAt),
+			IsActive:       p.IsActive,
+		})
+	}
+
+	return &promotion.ListPromotionResponse{
+		Base:       utils.SuccessResponse("Promotions retrieved successfully"),
+		Pagination: pagination,
+		Data:       items,
+	}, nil
+}
+
+func (ps *promotionService) ValidateCoupon(ctx context.Context, req *promotion.ValidateCouponRequest) (*promotion.ValidateCouponResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	carts, err := ps.cartRepository.GetListCart(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*models.Product, 0, len(carts))
+	for _, c := range carts {
+		if c.Product != nil {
+			products = append(products, c.Product)
+		}
+	}
+
+	prices, err := ps.pricingService.ResolvePrices(ctx, currencyCode, products)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]PromotionLine, 0, len(carts))
+	for _, c := range carts {
+		p := c.Product
+		if p == nil || p.IsDeleted || p.DeletedAt.Valid {
+			continue
+		}
+
+		price, priced := prices[p.ID]
+		if !priced {
+			continue
+		}
+
+		lines = append(lines, PromotionLine{
+			ProductID: p.ID,
+			Category:  p.Category,
+			Price:     price,
+			Quantity:  c.Quantity,
+		})
+	}
+
+	result, err := ps.EvaluateCoupon(ctx, req.Code, claims.UserID, currencyCode, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleProductIds := make([]uint64, 0, len(result.EligibleProductIDs))
+	for _, id := range result.EligibleProductIDs {
+		eligibleProductIds = append(eligibleProductIds, uint64(id))
+	}
+
+	return &promotion.ValidateCouponResponse{
+		Base:               utils.SuccessResponse("Coupon validated successfully"),
+		IsValid:            result.IsValid(),
+		Reason:             result.Reason,
+		Code:               normalizeCouponCode(req.Code),
+		Subtotal:           utils.ConvertMoneyToProto(result.Subtotal, currencyCode),
+		Discount:           utils.ConvertMoneyToProto(result.Discount, currencyCode),
+		Total:              utils.ConvertMoneyToProto(result.Subtotal-result.Discount, currencyCode),
+		EligibleProductIds: eligibleProductIds,
+	}, nil
+}
+
+// EvaluateCoupon checks whether the coupon can be used by the user for the
+// given lines, priced in currencyCode, and computes the discount it would
+// give. Promotion amounts are converted from the store currency.
+func (ps *promotionService) EvaluateCoupon(ctx context.Context, code string, userID uint, currencyCode string, lines []PromotionLine) (*PromotionResult, error) {
+	result := &PromotionResult{}
+
+	for _, line := range lines {
+		result.Subtotal += line.Price.Mul(line.Quantity)
+	}
+
+	p, err := ps.promotionRepository.GetPromotionByCode(ctx, normalizeCouponCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		result.Reason = "Coupon not found"
+		return result, nil
+	}
+	result.Promotion = p
+
+	now := time.Now()
+	switch {
+	case !p.IsActive:
+		result.Reason = "Coupon is not active"
+		return result, nil
+	case p.StartsAt != nil && now.Before(*p.StartsAt):
+		result.Reason = "Coupon is not yet valid"
+		return result, nil
+	case p.EndsAt != nil && now.After(*p.EndsAt):
+		result.Reason = "Coupon has expired"
+		return result, nil
+	case p.UsageLimit != nil && p.UsedCount >= *p.UsageLimit:
+		result.Reason = "Coupon usage limit has been reached"
+		return result, nil
+	}
+
+	if p.PerUserLimit != nil {
+		used, err := ps.promotionRepository.CountUsageByUser(ctx, p.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if used >= int64(*p.PerUserLimit) {
+			result.Reason = "You have already used this coupon the maximum number of times"
+			return result, nil
+		}
+	}
+
+	var eligibleSubtotal money.Amount
+	for _, line := range lines {
+		if !promotionAppliesTo(p, line) {
+			continue
+		}
+		eligibleSubtotal += line.Price.Mul(line.Quantity)
+		result.EligibleProductIDs = append(result.EligibleProductIDs, line.ProductID)
+	}
+
+	if len(result.EligibleProductIDs) == 0 {
+		result.Reason = "Coupon does not apply to any product in the cart"
+		return result, nil
+	}
+
+	minSpend, ok, err := ps.pricingService.ConvertAmount(ctx, p.MinSpend, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		result.Reason = fmt.Sprintf("Coupon cannot be used with %s", currencyCode)
+		return result, nil
+	}
+
+	if eligibleSubtotal < minSpend {
+		result.Reason = fmt.Sprintf("Minimum spend of %s %s is required for this coupon", currencyCode, minSpend)
+		return result, nil
+	}
+
+	var discount money.Amount
+	switch p.DiscountType {
+	case models.PromotionDiscountTypePercentage:
+		discount = eligibleSubtotal.Percent(p.DiscountValue)
+		if p.MaxDiscount != nil {
+			maxDiscount, _, err := ps.pricingService.ConvertAmount(ctx, *p.MaxDiscount, currencyCode)
+			if err != nil {
+				return nil, err
+			}
+			discount = money.Min(discount, maxDiscount)
+		}
+	case models.PromotionDiscountTypeFixed:
+		discount, _, err = ps.pricingService.ConvertAmount(ctx, p.DiscountAmount, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Discount = money.Min(discount, eligibleSubtotal)
+
+	return result, nil
+}
+
+// promotionAppliesTo reports whether the line falls within the promotion's
+// product or category scope. An unscoped promotion applies to every line.
+func promotionAppliesTo(p *models.Promotion, line PromotionLine) bool {
+	if len(p.ProductIDs) == 0 && len(p.Categories) == 0 {
+		return true
+	}
+
+	for _, id := range p.ProductIDs {
+		if id == line.ProductID {
+			return true
+		}
+	}
+
+	for _, category := range p.Categories {
+		if line.Category != "" && strings.EqualFold(category, line.Category) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func applyPromotionAmounts(p *models.Promotion, discountAmount *common.Money, maxDiscount *common.Money, minSpend *common.Money) string {
+	var err error
+
+	p.DiscountAmount, err = utils.ConvertProtoToMoney(discountAmount)
+	if err != nil {
+		return err.Error()
+	}
+
+	p.MinSpend, err = utils.ConvertProtoToMoney(minSpend)
+	if err != nil {
+		return err.Error()
+	}
+
+	p.MaxDiscount = nil
+	if maxDiscount != nil {
+		amount, err := utils.ConvertProtoToMoney(maxDiscount)
+		if err != nil {
+			return err.Error()
+		}
+		if amount <= 0 {
+			return "Maximum discount must be greater than 0"
+		}
+		p.MaxDiscount = &amount
+	}
+
+	switch p.DiscountType {
+	case models.PromotionDiscountTypePercentage:
+		if p.DiscountValue <= 0 {
+			return "Percentage promotions need a discount value greater than 0"
+		}
+		p.DiscountAmount = 0
+	case models.PromotionDiscountTypeFixed:
+		if p.DiscountAmount <= 0 {
+			return "Fixed promotions need a discount amount greater than 0"
+		}
+		p.DiscountValue = 0
+		p.MaxDiscount = nil
+	}
+
+	return ""
+}
+
+func applyPromotionLimits(p *models.Promotion, usageLimit *int32, perUserLimit *int32) string {
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return "Promotion end time must be after its start time"
+	}
+
+	p.UsageLimit = protoToOptionalInt(usageLimit)
+	p.PerUserLimit = protoToOptionalInt(perUserLimit)
+
+	return ""
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func uint64sToUints(values []uint64) []uint {
+	result := make([]uint, 0, len(values))
+	for _, v := range values {
+		result = append(result, uint(v))
+	}
+	return result
+}
+
+func protoToOptionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(*value)
+	return &v
+}
+
+func protoToOptionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func optionalMoneyToProto(amount *money.Amount) *common.Money {
+	if amount == nil {
+		return nil
+	}
+	return utils.ConvertMoneyToProto(*amount, money.DefaultCurrency)
+}
+
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return utils.ConvertTimeToTimestamp(*t)
+}
+
+func NewPromotionService(promotionRepository repositories.IPromotionRepository, cartRepository repositories.ICartRepository, pricingService IPricingService) IPromotionService {
+	return &promotionService{
+		promotionRepository: promotionRepository,
+		cartRepository:      cartRepository,
+		pricingService:      pricingService,
+	}
+}
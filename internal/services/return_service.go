@@ -0,0 +1,752 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/returns"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/payment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IReturnService interface {
+	RequestReturn(ctx context.Context, req *returns.RequestReturnRequest) (*returns.RequestReturnResponse, error)
+	CancelReturn(ctx context.Context, req *returns.CancelReturnRequest) (*returns.CancelReturnResponse, error)
+	ApproveReturn(ctx context.Context, req *returns.ApproveReturnRequest) (*returns.ApproveReturnResponse, error)
+	RejectReturn(ctx context.Context, req *returns.RejectReturnRequest) (*returns.RejectReturnResponse, error)
+	ReceiveReturn(ctx context.Context, req *returns.ReceiveReturnRequest) (*returns.ReceiveReturnResponse, error)
+	ListReturns(ctx context.Context, req *returns.ListReturnsRequest) (*returns.ListReturnsResponse, error)
+	DetailReturn(ctx context.Context, req *returns.DetailReturnRequest) (*returns.DetailReturnResponse, error)
+	RefundReport(ctx context.Context, req *returns.RefundReportRequest) (*returns.RefundReportResponse, error)
+	ApplyRefundStatus(ctx context.Context, returnRequest *models.ReturnRequest, gatewayStatus string) error
+}
+
+type returnService struct {
+	returnRepository  repositories.IReturnRepository
+	orderRepository   repositories.IOrderRepository
+	productRepository repositories.IProductRepository
+	refundGateway     payment.RefundGateway
+	// numberingRepository and numberingService number new returns.
+	numberingRepository repositories.INumberingRepository
+	numberingService    INumberingService
+}
+
+func (rs *returnService) RequestReturn(ctx context.Context, req *returns.RequestReturnRequest) (*returns.RequestReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	orderID, err := strconv.ParseUint(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	orderEntity, err := rs.orderRepository.GetOrderByID(ctx, uint(orderID))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	if orderEntity.UserID != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "you can only return items of your own orders")
+	}
+
+	if orderEntity.OrderStatusCode != models.OrderStatusCodeCompleted {
+		return &returns.RequestReturnResponse{
+			Base: utils.BadRequestResponse("Only completed orders can be returned"),
+		}, nil
+	}
+
+	tx, err := rs.returnRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	// The order stays locked until the return is created, so two requests
+	// for the same order cannot both claim the same units.
+	orderStatusCode, err := rs.orderRepository.WithTx(tx).LockOrderStatus(ctx, orderEntity.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to lock order")
+	}
+
+	if orderStatusCode != models.OrderStatusCodeCompleted {
+		tx.Rollback()
+		return &returns.RequestReturnResponse{
+			Base: utils.BadRequestResponse("Only completed orders can be returned"),
+		}, nil
+	}
+
+	txReturnRepo := rs.returnRepository.WithTx(tx)
+	orderEntity.Returns, err = txReturnRepo.GetReturnRequestsByOrderID(ctx, orderEntity.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get returns of order")
+	}
+
+	returnable := returnableQuantities(orderEntity)
+	requested := make(map[uint]int)
+	orderItemIds := make([]uint, 0)
+	for _, ri := range req.Items {
+		orderItemId := uint(ri.OrderItemId)
+		if _, exists := returnable[orderItemId]; !exists {
+			tx.Rollback()
+			return &returns.RequestReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Order item %d is not part of this order", orderItemId)),
+			}, nil
+		}
+		if _, exists := requested[orderItemId]; !exists {
+			orderItemIds = append(orderItemIds, orderItemId)
+		}
+		requested[orderItemId] += int(ri.Quantity)
+	}
+
+	items := make([]*models.ReturnItem, 0)
+	for _, orderItemId := range orderItemIds {
+		if requested[orderItemId] > returnable[orderItemId] {
+			tx.Rollback()
+			return &returns.RequestReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Only %d of order item %d can be returned", returnable[orderItemId], orderItemId)),
+			}, nil
+		}
+
+		items = append(items, &models.ReturnItem{
+			OrderItemID: orderItemId,
+			Quantity:    requested[orderItemId],
+			BaseModel: models.BaseModel{
+				CreatedAt: time.Now(),
+				CreatedBy: claims.FullName,
+			},
+		})
+	}
+
+	newReturn := &models.ReturnRequest{
+		OrderID:      orderEntity.ID,
+		UserID:       claims.UserID,
+		Status:       models.ReturnStatusRequested,
+		Reason:       req.Reason,
+		CurrencyCode: orderEntity.CurrencyCode,
+		Items:        items,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	newReturn.Number, err = rs.numberingService.Next(ctx, rs.numberingRepository.WithTx(tx), models.NumberingModuleReturn)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, "failed to get return number")
+	}
+
+	err = txReturnRepo.CreateReturnRequest(ctx, newReturn)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create return: %v", err))
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
+	return &returns.RequestReturnResponse{
+		Base:   utils.SuccessResponse("Return requested successfully"),
+		Id:     uint64(newReturn.ID),
+		Number: newReturn.Number,
+	}, nil
+}
+
+func (rs *returnService) CancelReturn(ctx context.Context, req *returns.CancelReturnRequest) (*returns.CancelReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	returnRequest, err := rs.returnRepository.GetReturnRequestByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if returnRequest == nil {
+		return &returns.CancelReturnResponse{
+			Base: utils.NotFoundResponse("Return not found"),
+		}, nil
+	}
+
+	if returnRequest.UserID != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "you can only cancel your own returns")
+	}
+
+	err = rs.changeReturnStatus(ctx, returnRequest, models.ReturnStatusCanceled, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &returns.CancelReturnResponse{
+		Base: utils.SuccessResponse("Return canceled successfully"),
+	}, nil
+}
+
+func (rs *returnService) ApproveReturn(ctx context.Context, req *returns.ApproveReturnRequest) (*returns.ApproveReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can approve return")
+	}
+
+	returnRequest, err := rs.returnRepository.GetReturnRequestByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if returnRequest == nil {
+		return &returns.ApproveReturnResponse{
+			Base: utils.NotFoundResponse("Return not found"),
+		}, nil
+	}
+
+	returnRequest.AdminNote = req.Note
+	err = rs.changeReturnStatus(ctx, returnRequest, models.ReturnStatusApproved, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &returns.ApproveReturnResponse{
+		Base: utils.SuccessResponse("Return approved successfully"),
+	}, nil
+}
+
+func (rs *returnService) RejectReturn(ctx context.Context, req *returns.RejectReturnRequest) (*returns.RejectReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can reject return")
+	}
+
+	returnRequest, err := rs.returnRepository.GetReturnRequestByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if returnRequest == nil {
+		return &returns.RejectReturnResponse{
+			Base: utils.NotFoundResponse("Return not found"),
+		}, nil
+	}
+
+	// Goods that already came back are rejected through inspection instead.
+	if returnRequest.Status != models.ReturnStatusRequested {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("cannot reject a return that is %s", returnRequest.Status))
+	}
+
+	returnRequest.AdminNote = req.Note
+	err = rs.changeReturnStatus(ctx, returnRequest, models.ReturnStatusRejected, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &returns.RejectReturnResponse{
+		Base: utils.SuccessResponse("Return rejected successfully"),
+	}, nil
+}
+
+// ReceiveReturn records the inspection of the returned goods. Accepted units
+// go back into stock and are refunded. A return with nothing accepted is
+// rejected.
+func (rs *returnService) ReceiveReturn(ctx context.Context, req *returns.ReceiveReturnRequest) (*returns.ReceiveReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can receive return")
+	}
+
+	returnRequest, err := rs.returnRepository.GetReturnRequestByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if returnRequest == nil {
+		return &returns.ReceiveReturnResponse{
+			Base: utils.NotFoundResponse("Return not found"),
+		}, nil
+	}
+
+	if !canTransitionReturn(returnRequest.Status, models.ReturnStatusReceived) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("cannot receive a return that is %s", returnRequest.Status))
+	}
+
+	inspected := make(map[uint]*returns.ReceiveReturnRequestItem)
+	for _, ri := range req.Items {
+		if _, exists := inspected[uint(ri.ReturnItemId)]; exists {
+			return &returns.ReceiveReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Return item %d is listed more than once", ri.ReturnItemId)),
+			}, nil
+		}
+		inspected[uint(ri.ReturnItemId)] = ri
+	}
+
+	if len(inspected) != len(returnRequest.Items) {
+		return &returns.ReceiveReturnResponse{
+			Base: utils.BadRequestResponse("Every item of the return must be inspected"),
+		}, nil
+	}
+
+	now := time.Now()
+	refundAmount := money.Amount(0)
+	restock := make(map[uint]int)
+	productIds := make([]uint, 0)
+	for _, item := range returnRequest.Items {
+		ri, exists := inspected[item.ID]
+		if !exists {
+			return &returns.ReceiveReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Return item %d is not part of this return", item.ID)),
+			}, nil
+		}
+
+		accepted := int(ri.AcceptedQuantity)
+		if accepted > item.Quantity {
+			return &returns.ReceiveReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Only %d of return item %d were returned", item.Quantity, item.ID)),
+			}, nil
+		}
+
+		item.AcceptedQuantity = &accepted
+		item.InspectionNote = ri.Note
+		item.UpdatedAt = &now
+		item.UpdatedBy = &claims.FullName
+
+		if accepted == 0 {
+			continue
+		}
+
+		refundAmount += returnItemRefund(returnRequest.Order, item.OrderItem, accepted)
+		if _, exists := restock[item.OrderItem.ProductID]; !exists {
+			productIds = append(productIds, item.OrderItem.ProductID)
+		}
+		restock[item.OrderItem.ProductID] += accepted
+	}
+
+	fromStatus := returnRequest.Status
+	returnRequest.Status = models.ReturnStatusReceived
+	if refundAmount == 0 {
+		returnRequest.Status = models.ReturnStatusRejected
+	} else {
+		returnRequest.RefundAmount = refundAmount
+		returnRequest.RefundStatus = models.OrderRefundStatusPending
+	}
+	returnRequest.UpdatedAt = &now
+	returnRequest.UpdatedBy = &claims.FullName
+
+	tx, err := rs.returnRepository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin transaction")
+	}
+
+	// The status goes first so a second inspection of the same return stops
+	// here instead of restocking the goods again.
+	txReturnRepo := rs.returnRepository.WithTx(tx)
+	err = txReturnRepo.UpdateReturnStatus(ctx, returnRequest, fromStatus)
+	if err != nil {
+		tx.Rollback()
+		return nil, returnStatusError(err, fromStatus)
+	}
+
+	for _, item := range returnRequest.Items {
+		err = txReturnRepo.UpdateReturnItem(ctx, item)
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, "failed to update return items")
+		}
+	}
+
+	// Same lock order as checkout so the two cannot deadlock.
+	sort.Slice(productIds, func(i, j int) bool { return productIds[i] < productIds[j] })
+
+	txProductRepo := rs.productRepository.WithTx(tx)
+	for _, productId := range productIds {
+		err = txProductRepo.IncrementStock(ctx, productId, restock[productId])
+		if err != nil {
+			tx.Rollback()
+			return nil, status.Error(codes.Internal, "failed to restock returned items")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to commit transaction")
+	}
+
+	if refundAmount > 0 {
+		err = rs.requestRefund(ctx, returnRequest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &returns.ReceiveReturnResponse{
+		Base:         utils.SuccessResponse("Return received successfully"),
+		RefundAmount: utils.ConvertMoneyToProto(returnRequest.RefundAmount, returnRequest.CurrencyCode),
+		RefundStatus: returnRequest.RefundStatus,
+	}, nil
+}
+
+func (rs *returnService) ListReturns(ctx context.Context, req *returns.ListReturnsRequest) (*returns.ListReturnsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &common.PaginationRequest{
+			CurrentPage: 1,
+			PerPage:     10,
+		}
+	}
+
+	returnRequests, paginationResponse, err := rs.returnRepository.GetReturnRequestsPagination(ctx, req.Status, pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &returns.ListReturnsResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get returns")
+	}
+
+	items := make([]*returns.ListReturnsResponseItem, 0)
+	for _, r := range returnRequests {
+		orderNumber := ""
+		if r.Order != nil {
+			orderNumber = r.Order.Number
+		}
+
+		items = append(items, &returns.ListReturnsResponseItem{
+			Id:           uint64(r.ID),
+			Number:       r.Number,
+			OrderId:      fmt.Sprint(r.OrderID),
+			OrderNumber:  orderNumber,
+			Status:       r.Status,
+			Reason:       r.Reason,
+			RefundAmount: utils.ConvertMoneyToProto(r.RefundAmount, r.CurrencyCode),
+			RefundStatus: r.RefundStatus,
+			CreatedAt:    utils.ConvertTimeToTimestamp(r.CreatedAt),
+		})
+	}
+
+	return &returns.ListReturnsResponse{
+		Base:       utils.SuccessResponse("Returns retrieved successfully"),
+		Pagination: paginationResponse,
+		Data:       items,
+	}, nil
+}
+
+func (rs *returnService) DetailReturn(ctx context.Context, req *returns.DetailReturnRequest) (*returns.DetailReturnResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	returnRequest, err := rs.returnRepository.GetReturnRequestByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if returnRequest == nil {
+		return &returns.DetailReturnResponse{
+			Base: utils.NotFoundResponse("Return not found"),
+		}, nil
+	}
+
+	if claims.RoleCode != "ADMIN" && returnRequest.UserID != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "you can only view your own returns")
+	}
+
+	items := make([]*returns.ReturnItem, 0)
+	for _, item := range returnRequest.Items {
+		name := ""
+		if item.OrderItem != nil {
+			name = item.OrderItem.ProductName
+		}
+
+		var accepted *int64
+		if item.AcceptedQuantity != nil {
+			value := int64(*item.AcceptedQuantity)
+			accepted = &value
+		}
+
+		items = append(items, &returns.ReturnItem{
+			Id:               uint64(item.ID),
+			OrderItemId:      uint64(item.OrderItemID),
+			Name:             name,
+			Quantity:         int64(item.Quantity),
+			AcceptedQuantity: accepted,
+			InspectionNote:   item.InspectionNote,
+		})
+	}
+
+	return &returns.DetailReturnResponse{
+		Base:         utils.SuccessResponse("Detail return success"),
+		Id:           uint64(returnRequest.ID),
+		Number:       returnRequest.Number,
+		OrderId:      fmt.Sprint(returnRequest.OrderID),
+		OrderNumber:  returnRequest.Order.Number,
+		Status:       returnRequest.Status,
+		Reason:       returnRequest.Reason,
+		AdminNote:    returnRequest.AdminNote,
+		Items:        items,
+		RefundAmount: utils.ConvertMoneyToProto(returnRequest.RefundAmount, returnRequest.CurrencyCode),
+		RefundStatus: returnRequest.RefundStatus,
+		RefundedAt:   optionalTimeToProto(returnRequest.RefundedAt),
+		CreatedAt:    utils.ConvertTimeToTimestamp(returnRequest.CreatedAt),
+	}, nil
+}
+
+// RefundReport sums the succeeded refunds of canceled orders and of returns
+// per currency.
+func (rs *returnService) RefundReport(ctx context.Context, req *returns.RefundReportRequest) (*returns.RefundReportResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	startDate := req.StartDate.AsTime()
+	endDate := req.EndDate.AsTime()
+	if !endDate.After(startDate) {
+		return &returns.RefundReportResponse{
+			Base: utils.BadRequestResponse("End date must be after start date"),
+		}, nil
+	}
+
+	orderTotals, err := rs.returnRepository.GetOrderRefundTotals(ctx, startDate, endDate)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get order refunds")
+	}
+
+	returnTotals, err := rs.returnRepository.GetReturnRefundTotals(ctx, startDate, endDate)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get return refunds")
+	}
+
+	type refundRow struct {
+		orderTotal  money.Amount
+		orderCount  int64
+		returnTotal money.Amount
+		returnCount int64
+	}
+
+	rows := make(map[string]*refundRow)
+	currencies := make([]string, 0)
+	rowFor := func(currencyCode string) *refundRow {
+		row, exists := rows[currencyCode]
+		if !exists {
+			row = &refundRow{}
+			rows[currencyCode] = row
+			currencies = append(currencies, currencyCode)
+		}
+		return row
+	}
+
+	for _, t := range orderTotals {
+		row := rowFor(t.CurrencyCode)
+		row.orderTotal = t.Total
+		row.orderCount = t.Count
+	}
+	for _, t := range returnTotals {
+		row := rowFor(t.CurrencyCode)
+		row.returnTotal = t.Total
+		row.returnCount = t.Count
+	}
+	sort.Strings(currencies)
+
+	items := make([]*returns.RefundReportResponseItem, 0)
+	for _, currencyCode := range currencies {
+		row := rows[currencyCode]
+		items = append(items, &returns.RefundReportResponseItem{
+			CurrencyCode:      currencyCode,
+			OrderRefundTotal:  utils.ConvertMoneyToProto(row.orderTotal, currencyCode),
+			OrderRefundCount:  row.orderCount,
+			ReturnRefundTotal: utils.ConvertMoneyToProto(row.returnTotal, currencyCode),
+			ReturnRefundCount: row.returnCount,
+			Total:             utils.ConvertMoneyToProto(row.orderTotal+row.returnTotal, currencyCode),
+		})
+	}
+
+	return &returns.RefundReportResponse{
+		Base: utils.SuccessResponse("Refund report retrieved successfully"),
+		Data: items,
+	}, nil
+}
+
+// ApplyRefundStatus records a refund status reported by the gateway. The
+// return is refunded once the gateway confirms it.
+func (rs *returnService) ApplyRefundStatus(ctx context.Context, returnRequest *models.ReturnRequest, gatewayStatus string) error {
+	refundStatus := refundStatusFromGateway(gatewayStatus)
+	if refundStatus == returnRequest.RefundStatus {
+		return nil
+	}
+
+	returnRequest.RefundStatus = refundStatus
+	if refundStatus == models.OrderRefundStatusSucceeded {
+		now := time.Now()
+		returnRequest.RefundedAt = &now
+
+		err := changeReturnStatusTo(returnRequest, models.ReturnStatusRefunded)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rs.returnRepository.UpdateReturnRequest(ctx, returnRequest)
+}
+
+// requestRefund asks the gateway for the refund after the inspection is
+// saved. A failed request is kept on the return for an admin to follow up.
+func (rs *returnService) requestRefund(ctx context.Context, returnRequest *models.ReturnRequest) error {
+	refund, err := rs.refundGateway.Refund(ctx, payment.RefundRequest{
+		InvoiceID:    returnRequest.Order.XenditInvoiceID,
+		ReferenceID:  fmt.Sprintf("return-%d-refund", returnRequest.ID),
+		Amount:       returnRequest.RefundAmount,
+		CurrencyCode: returnRequest.CurrencyCode,
+		Reason:       payment.RefundReasonRequestedByCustomer,
+	})
+	if err != nil {
+		log.Printf("failed to refund return %d: %v", returnRequest.ID, err)
+		return rs.ApplyRefundStatus(ctx, returnRequest, payment.RefundStatusFailed)
+	}
+
+	returnRequest.RefundID = refund.ID
+
+	// Pending is already stored, so write the refund id either way.
+	if refundStatusFromGateway(refund.Status) == models.OrderRefundStatusPending {
+		return rs.returnRepository.UpdateReturnRequest(ctx, returnRequest)
+	}
+
+	return rs.ApplyRefundStatus(ctx, returnRequest, refund.Status)
+}
+
+func (rs *returnService) changeReturnStatus(ctx context.Context, returnRequest *models.ReturnRequest, newStatus string, updatedBy string) error {
+	fromStatus := returnRequest.Status
+	err := changeReturnStatusTo(returnRequest, newStatus)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	returnRequest.UpdatedAt = &now
+	returnRequest.UpdatedBy = &updatedBy
+
+	err = rs.returnRepository.UpdateReturnStatus(ctx, returnRequest, fromStatus)
+	if err != nil {
+		return returnStatusError(err, fromStatus)
+	}
+
+	return nil
+}
+
+func returnStatusError(err error, fromStatus string) error {
+	if errors.Is(err, repositories.ErrReturnStatusChanged) {
+		return status.Errorf(codes.Aborted, "return status was changed from %s by another request", fromStatus)
+	}
+
+	return status.Error(codes.Internal, "failed to update return")
+}
+
+func changeReturnStatusTo(returnRequest *models.ReturnRequest, newStatus string) error {
+	if !canTransitionReturn(returnRequest.Status, newStatus) {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("cannot change return from %s to %s", returnRequest.Status, newStatus))
+	}
+
+	returnRequest.Status = newStatus
+	return nil
+}
+
+func canTransitionReturn(from string, to string) bool {
+	for _, allowed := range models.ReturnStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returnableQuantities returns how many units of each order item can still
+// be returned. Units in an open return or accepted by an earlier one are
+// taken off. The order needs its items and returns loaded.
+func returnableQuantities(orderEntity *models.Order) map[uint]int {
+	returnable := make(map[uint]int)
+	for _, oi := range orderEntity.Items {
+		returnable[oi.ID] = oi.Quantity
+	}
+
+	for _, r := range orderEntity.Returns {
+		if r.Status == models.ReturnStatusRejected || r.Status == models.ReturnStatusCanceled {
+			continue
+		}
+
+		for _, item := range r.Items {
+			taken := item.Quantity
+			if item.AcceptedQuantity != nil {
+				taken = *item.AcceptedQuantity
+			}
+			returnable[item.OrderItemID] -= taken
+		}
+	}
+
+	return returnable
+}
+
+// returnItemRefund is what the shopper paid for quantity units of the order
+// item, after discounts and including exclusive tax. Shipping is not
+// refunded.
+func returnItemRefund(orderEntity *models.Order, orderItem *models.OrderItem, quantity int) money.Amount {
+	paid := orderItem.Subtotal - orderItem.DiscountAmount
+	if !orderEntity.TaxInclusive {
+		paid += orderItem.TaxAmount
+	}
+
+	if quantity == orderItem.Quantity {
+		return paid
+	}
+
+	return money.FromMinor(paid.Minor() * int64(quantity) / int64(orderItem.Quantity))
+}
+
+func NewReturnService(returnRepository repositories.IReturnRepository, orderRepository repositories.IOrderRepository, productRepository repositories.IProductRepository, refundGateway payment.RefundGateway, numberingRepository repositories.INumberingRepository, numberingService INumberingService) IReturnService {
+	return &returnService{
+		returnRepository:    returnRepository,
+		orderRepository:     orderRepository,
+		productRepository:   productRepository,
+		refundGateway:       refundGateway,
+		numberingRepository: numberingRepository,
+		numberingService:    numberingService,
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/carrier"
+)
+
+type IShipmentTrackingService interface {
+	SyncShipments(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type shipmentTrackingService struct {
+	orderRepository repositories.IOrderRepository
+	tracker         carrier.Tracker
+	stateMachine    IOrderStateMachine
+}
+
+// SyncShipments asks the carrier about every shipment still in transit and
+// completes the orders whose items have all been delivered.
+func (sts *shipmentTrackingService) SyncShipments(ctx context.Context) error {
+	shipments, err := sts.orderRepository.GetShipmentsInTransit(ctx)
+	if err != nil {
+		return err
+	}
+
+	updatedBy := "System"
+	deliveredOrderIds := make(map[uint]bool)
+	for _, shipment := range shipments {
+		trackingStatus, err := sts.tracker.Track(ctx, carrier.TrackingRequest{
+			Carrier:        shipment.Carrier,
+			TrackingNumber: shipment.TrackingNumber,
+			ShippedAt:      shipment.ShippedAt,
+		})
+		if err != nil {
+			log.Printf("failed to track shipment %d: %v", shipment.ID, err)
+			continue
+		}
+
+		if !trackingStatus.Delivered {
+			continue
+		}
+
+		now := time.Now()
+		deliveredAt := now
+		if trackingStatus.DeliveredAt != nil {
+			deliveredAt = *trackingStatus.DeliveredAt
+		}
+
+		shipment.Status = models.ShipmentStatusDelivered
+		shipment.DeliveredAt = &deliveredAt
+		shipment.UpdatedAt = &now
+		shipment.UpdatedBy = &updatedBy
+
+		err = sts.orderRepository.UpdateShipment(ctx, shipment)
+		if err != nil {
+			return err
+		}
+
+		deliveredOrderIds[shipment.OrderID] = true
+	}
+
+	for orderID := range deliveredOrderIds {
+		err := sts.completeDeliveredOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sts *shipmentTrackingService) completeDeliveredOrder(ctx context.Context, orderID uint) error {
+	orderEntity, err := sts.orderRepository.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if orderEntity.OrderStatusCode != models.OrderStatusCodeShipped {
+		return nil
+	}
+
+	for _, quantity := range unshippedQuantities(orderEntity) {
+		if quantity > 0 {
+			return nil
+		}
+	}
+
+	for _, shipment := range orderEntity.Shipments {
+		if shipment.Status != models.ShipmentStatusDelivered {
+			return nil
+		}
+	}
+
+	event, err := sts.stateMachine.Transition(ctx, sts.orderRepository, orderEntity, models.OrderStatusCodeCompleted, SystemActor, "All shipments delivered")
+	if err != nil {
+		return err
+	}
+
+	sts.stateMachine.Publish(event)
+	return nil
+}
+
+// Run syncs shipments every interval until the context is done.
+func (sts *shipmentTrackingService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sts.SyncShipments(ctx); err != nil {
+				log.Printf("failed to sync shipments: %v", err)
+			}
+		}
+	}
+}
+
+func NewShipmentTrackingService(orderRepository repositories.IOrderRepository, tracker carrier.Tracker, stateMachine IOrderStateMachine) IShipmentTrackingService {
+	return &shipmentTrackingService{
+		orderRepository: orderRepository,
+		tracker:         tracker,
+		stateMachine:    stateMachine,
+	}
+}
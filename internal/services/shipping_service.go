@@ -0,0 +1,466 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/shipping"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShippingQuote is the cost of sending an order with one shipping method.
+// Reason explains why the method cannot be used.
+type ShippingQuote struct {
+	Method *models.ShippingMethod
+	Reason string
+	Cost   money.Amount
+}
+
+func (q *ShippingQuote) IsValid() bool {
+	return q.Reason == ""
+}
+
+type IShippingService interface {
+	CreateShippingMethod(ctx context.Context, req *shipping.CreateShippingMethodRequest) (*shipping.CreateShippingMethodResponse, error)
+	UpdateShippingMethod(ctx context.Context, req *shipping.UpdateShippingMethodRequest) (*shipping.UpdateShippingMethodResponse, error)
+	DeleteShippingMethod(ctx context.Context, req *shipping.DeleteShippingMethodRequest) (*shipping.DeleteShippingMethodResponse, error)
+	ListShippingMethods(ctx context.Context, req *shipping.ListShippingMethodsRequest) (*shipping.ListShippingMethodsResponse, error)
+	SetShippingRates(ctx context.Context, req *shipping.SetShippingRatesRequest) (*shipping.SetShippingRatesResponse, error)
+	QuoteShipping(ctx context.Context, req *shipping.QuoteShippingRequest) (*shipping.QuoteShippingResponse, error)
+	QuoteMethod(ctx context.Context, code string, region string, currencyCode string, weightGrams int) (*ShippingQuote, error)
+}
+
+type shippingService struct {
+	shippingRepository repositories.IShippingRepository
+	cartRepository     repositories.ICartRepository
+	pricingService     IPricingService
+}
+
+func (ss *shippingService) CreateShippingMethod(ctx context.Context, req *shipping.CreateShippingMethodRequest) (*shipping.CreateShippingMethodResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create shipping method")
+	}
+
+	code := normalizeShippingCode(req.Code)
+
+	existingMethod, err := ss.shippingRepository.GetShippingMethodByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingMethod != nil {
+		return &shipping.CreateShippingMethodResponse{
+			Base: utils.BadRequestResponse("Shipping method code already exists"),
+		}, nil
+	}
+
+	newMethod := &models.ShippingMethod{
+		Code:        code,
+		Name:        req.Name,
+		Description: req.Description,
+		RateType:    req.RateType,
+		IsActive:    req.IsActive,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	err = ss.shippingRepository.CreateShippingMethod(ctx, newMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create shipping method: %v", err))
+	}
+
+	return &shipping.CreateShippingMethodResponse{
+		Base: utils.SuccessResponse("Shipping method created successfully"),
+		Id:   uint64(newMethod.ID),
+	}, nil
+}
+
+func (ss *shippingService) UpdateShippingMethod(ctx context.Context, req *shipping.UpdateShippingMethodRequest) (*shipping.UpdateShippingMethodResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update shipping method")
+	}
+
+	existingMethod, err := ss.shippingRepository.GetShippingMethodByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingMethod == nil {
+		return &shipping.UpdateShippingMethodResponse{
+			Base: utils.NotFoundResponse("Shipping method not found"),
+		}, nil
+	}
+
+	// The rate table was written for the old type, so it has to fit the new one.
+	if req.RateType != existingMethod.RateType {
+		if msg := validateShippingRates(req.RateType, existingMethod.Rates); msg != "" {
+			return &shipping.UpdateShippingMethodResponse{
+				Base: utils.BadRequestResponse(msg),
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	existingMethod.Name = req.Name
+	existingMethod.Description = req.Description
+	existingMethod.RateType = req.RateType
+	existingMethod.IsActive = req.IsActive
+	existingMethod.UpdatedAt = &now
+	existingMethod.UpdatedBy = &claims.FullName
+
+	err = ss.shippingRepository.UpdateShippingMethod(ctx, existingMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update shipping method: %v", err))
+	}
+
+	return &shipping.UpdateShippingMethodResponse{
+		Base: utils.SuccessResponse("Shipping method updated successfully"),
+	}, nil
+}
+
+func (ss *shippingService) DeleteShippingMethod(ctx context.Context, req *shipping.DeleteShippingMethodRequest) (*shipping.DeleteShippingMethodResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can delete shipping method")
+	}
+
+	existingMethod, err := ss.shippingRepository.GetShippingMethodByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingMethod == nil {
+		return &shipping.DeleteShippingMethodResponse{
+			Base: utils.NotFoundResponse("Shipping method not found"),
+		}, nil
+	}
+
+	existingMethod.DeletedBy = &claims.FullName
+
+	err = ss.shippingRepository.DeleteShippingMethod(ctx, existingMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete shipping method: %v", err))
+	}
+
+	return &shipping.DeleteShippingMethodResponse{
+		Base: utils.SuccessResponse("Shipping method deleted successfully"),
+	}, nil
+}
+
+func (ss *shippingService) ListShippingMethods(ctx context.Context, req *shipping.ListShippingMethodsRequest) (*shipping.ListShippingMethodsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	methods, err := ss.shippingRepository.GetShippingMethods(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*shipping.ListShippingMethodsResponseItem, 0)
+	for _, m := range methods {
+		rates := make([]*shipping.ShippingRate, 0)
+		for _, r := range m.Rates {
+			rates = append(rates, &shipping.ShippingRate{
+				Region:         r.Region,
+				MinWeightGrams: int32(r.MinWeightGrams),
+				MaxWeightGrams: optionalIntToProto(r.MaxWeightGrams),
+				Price:          utils.ConvertMoneyToProto(r.Price, money.DefaultCurrency),
+			})
+		}
+
+		items = append(items, &shipping.ListShippingMethodsResponseItem{
+			Id:          uint64(m.ID),
+			Code:        m.Code,
+			Name:        m.Name,
+			Description: m.Description,
+			RateType:    m.RateType,
+			IsActive:    m.IsActive,
+			Rates:       rates,
+		})
+	}
+
+	return &shipping.ListShippingMethodsResponse{
+		Base: utils.SuccessResponse("Shipping methods retrieved successfully"),
+		Data: items,
+	}, nil
+}
+
+func (ss *shippingService) SetShippingRates(ctx context.Context, req *shipping.SetShippingRatesRequest) (*shipping.SetShippingRatesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update shipping rates")
+	}
+
+	existingMethod, err := ss.shippingRepository.GetShippingMethodByID(ctx, uint(req.ShippingMethodId))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingMethod == nil {
+		return &shipping.SetShippingRatesResponse{
+			Base: utils.NotFoundResponse("Shipping method not found"),
+		}, nil
+	}
+
+	now := time.Now()
+	rates := make([]*models.ShippingRate, 0, len(req.Rates))
+	for _, r := range req.Rates {
+		price, err := utils.ConvertProtoToMoney(r.Price)
+		if err != nil {
+			return &shipping.SetShippingRatesResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Shipping rates must be in %s", money.DefaultCurrency)),
+			}, nil
+		}
+
+		var maxWeight *int
+		if r.MaxWeightGrams != nil {
+			value := int(r.GetMaxWeightGrams())
+			maxWeight = &value
+		}
+
+		rates = append(rates, &models.ShippingRate{
+			Region:         strings.TrimSpace(r.Region),
+			MinWeightGrams: int(r.MinWeightGrams),
+			MaxWeightGrams: maxWeight,
+			Price:          price,
+			BaseModel: models.BaseModel{
+				CreatedAt: now,
+				CreatedBy: claims.FullName,
+			},
+		})
+	}
+
+	if msg := validateShippingRates(existingMethod.RateType, rates); msg != "" {
+		return &shipping.SetShippingRatesResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	err = ss.shippingRepository.ReplaceShippingRates(ctx, existingMethod.ID, rates, claims.FullName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update shipping rates: %v", err))
+	}
+
+	return &shipping.SetShippingRatesResponse{
+		Base: utils.SuccessResponse("Shipping rates updated successfully"),
+	}, nil
+}
+
+func (ss *shippingService) QuoteShipping(ctx context.Context, req *shipping.QuoteShippingRequest) (*shipping.QuoteShippingResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	carts, err := ss.cartRepository.GetListCart(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	weightGrams := 0
+	for _, c := range carts {
+		if c.Product == nil || c.Product.IsDeleted {
+			continue
+		}
+		weightGrams += c.Product.WeightGrams * c.Quantity
+	}
+
+	methods, err := ss.shippingRepository.GetShippingMethods(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*shipping.QuoteShippingOption, 0)
+	for _, m := range methods {
+		quote, err := ss.quote(ctx, m, req.Region, currencyCode, weightGrams)
+		if err != nil {
+			return nil, err
+		}
+		if !quote.IsValid() {
+			continue
+		}
+
+		options = append(options, &shipping.QuoteShippingOption{
+			Code:        m.Code,
+			Name:        m.Name,
+			Description: m.Description,
+			Cost:        utils.ConvertMoneyToProto(quote.Cost, currencyCode),
+		})
+	}
+
+	return &shipping.QuoteShippingResponse{
+		Base:             utils.SuccessResponse("Shipping quote success"),
+		TotalWeightGrams: int32(weightGrams),
+		Options:          options,
+	}, nil
+}
+
+// QuoteMethod prices a shipping method for an order. An empty code is only
+// allowed while no shipping method is active.
+func (ss *shippingService) QuoteMethod(ctx context.Context, code string, region string, currencyCode string, weightGrams int) (*ShippingQuote, error) {
+	if code == "" {
+		methods, err := ss.shippingRepository.GetShippingMethods(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+
+		quote := &ShippingQuote{}
+		if len(methods) > 0 {
+			quote.Reason = "Shipping method is required"
+		}
+		return quote, nil
+	}
+
+	method, err := ss.shippingRepository.GetShippingMethodByCode(ctx, normalizeShippingCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	if method == nil || !method.IsActive {
+		return &ShippingQuote{Reason: "Shipping method not found"}, nil
+	}
+
+	return ss.quote(ctx, method, region, currencyCode, weightGrams)
+}
+
+func (ss *shippingService) quote(ctx context.Context, method *models.ShippingMethod, region string, currencyCode string, weightGrams int) (*ShippingQuote, error) {
+	result := &ShippingQuote{Method: method}
+
+	rate := matchShippingRate(method, region, weightGrams)
+	if rate == nil {
+		result.Reason = fmt.Sprintf("%s does not ship this order to %s", method.Name, region)
+		return result, nil
+	}
+
+	cost, ok, err := ss.pricingService.ConvertAmount(ctx, rate.Price, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		result.Reason = fmt.Sprintf("%s cannot be used with %s", method.Name, currencyCode)
+		return result, nil
+	}
+
+	result.Cost = cost
+	return result, nil
+}
+
+// matchShippingRate picks the rate of the method's table that applies to an
+// order. It returns nil when the method cannot ship the order.
+func matchShippingRate(method *models.ShippingMethod, region string, weightGrams int) *models.ShippingRate {
+	switch method.RateType {
+	case models.ShippingRateTypeFlat:
+		if len(method.Rates) > 0 {
+			return method.Rates[0]
+		}
+	case models.ShippingRateTypeWeight:
+		for _, r := range method.Rates {
+			if weightGrams >= r.MinWeightGrams && (r.MaxWeightGrams == nil || weightGrams < *r.MaxWeightGrams) {
+				return r
+			}
+		}
+	case models.ShippingRateTypeRegion:
+		var fallback *models.ShippingRate
+		for _, r := range method.Rates {
+			if r.Region == "" {
+				fallback = r
+				continue
+			}
+			if strings.EqualFold(r.Region, strings.TrimSpace(region)) {
+				return r
+			}
+		}
+		return fallback
+	}
+
+	return nil
+}
+
+func validateShippingRates(rateType string, rates []*models.ShippingRate) string {
+	switch rateType {
+	case models.ShippingRateTypeFlat:
+		if len(rates) > 1 {
+			return "Flat shipping method takes a single rate"
+		}
+	case models.ShippingRateTypeWeight:
+		for _, r := range rates {
+			if r.MaxWeightGrams != nil && *r.MaxWeightGrams <= r.MinWeightGrams {
+				return "Maximum weight must be greater than minimum weight"
+			}
+		}
+		for i := range rates {
+			for j := i + 1; j < len(rates); j++ {
+				if weightRangesOverlap(rates[i], rates[j]) {
+					return "Weight ranges must not overlap"
+				}
+			}
+		}
+	case models.ShippingRateTypeRegion:
+		regions := make(map[string]bool)
+		for _, r := range rates {
+			key := strings.ToLower(r.Region)
+			if regions[key] {
+				return fmt.Sprintf("Region %s has more than one rate", r.Region)
+			}
+			regions[key] = true
+		}
+	}
+
+	return ""
+}
+
+func weightRangesOverlap(a, b *models.ShippingRate) bool {
+	aBelowB := a.MaxWeightGrams != nil && *a.MaxWeightGrams <= b.MinWeightGrams
+	bBelowA := b.MaxWeightGrams != nil && *b.MaxWeightGrams <= a.MinWeightGrams
+	return !aBelowB && !bBelowA
+}
+
+func normalizeShippingCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func NewShippingService(shippingRepository repositories.IShippingRepository, cartRepository repositories.ICartRepository, pricingService IPricingService) IShippingService {
+	return &shippingService{
+		shippingRepository: shippingRepository,
+		cartRepository:     cartRepository,
+		pricingService:     pricingService,
+	}
+}
@@ -0,0 +1,322 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/tax"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TaxLine is an order line to tax. Amount is the line total after its share
+// of discounts.
+type TaxLine struct {
+	Category string
+	Amount   money.Amount
+}
+
+// TaxResult holds the tax of each line, in the same order as the lines.
+type TaxResult struct {
+	Rule      *models.TaxRule
+	Inclusive bool
+	Rates     []float64
+	Amounts   []money.Amount
+	Total     money.Amount
+}
+
+type ITaxService interface {
+	CreateTaxRule(ctx context.Context, req *tax.CreateTaxRuleRequest) (*tax.CreateTaxRuleResponse, error)
+	UpdateTaxRule(ctx context.Context, req *tax.UpdateTaxRuleRequest) (*tax.UpdateTaxRuleResponse, error)
+	DeleteTaxRule(ctx context.Context, req *tax.DeleteTaxRuleRequest) (*tax.DeleteTaxRuleResponse, error)
+	ListTaxRules(ctx context.Context, req *tax.ListTaxRulesRequest) (*tax.ListTaxRulesResponse, error)
+	CalculateTax(ctx context.Context, currencyCode string, lines []TaxLine) (*TaxResult, error)
+}
+
+type taxService struct {
+	taxRepository repositories.ITaxRepository
+}
+
+func (ts *taxService) CreateTaxRule(ctx context.Context, req *tax.CreateTaxRuleRequest) (*tax.CreateTaxRuleResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can create tax rule")
+	}
+
+	currencyCode, msg := normalizeTaxCurrency(req.CurrencyCode)
+	if msg != "" {
+		return &tax.CreateTaxRuleResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	newRule := &models.TaxRule{
+		Name:             req.Name,
+		Rate:             req.Rate,
+		IsInclusive:      req.IsInclusive,
+		CurrencyCode:     currencyCode,
+		ExemptCategories: req.ExemptCategories,
+		IsActive:         req.IsActive,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	if msg, err := ts.checkSingleActiveRule(ctx, newRule); err != nil || msg != "" {
+		if err != nil {
+			return nil, err
+		}
+		return &tax.CreateTaxRuleResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	err = ts.taxRepository.CreateTaxRule(ctx, newRule)
+	if errors.Is(err, repositories.ErrActiveTaxRuleExists) {
+		return &tax.CreateTaxRuleResponse{
+			Base: utils.BadRequestResponse("Another tax rule is already active for this currency"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create tax rule: %v", err))
+	}
+
+	return &tax.CreateTaxRuleResponse{
+		Base: utils.SuccessResponse("Tax rule created successfully"),
+		Id:   uint64(newRule.ID),
+	}, nil
+}
+
+func (ts *taxService) UpdateTaxRule(ctx context.Context, req *tax.UpdateTaxRuleRequest) (*tax.UpdateTaxRuleResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can update tax rule")
+	}
+
+	existingRule, err := ts.taxRepository.GetTaxRuleByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingRule == nil {
+		return &tax.UpdateTaxRuleResponse{
+			Base: utils.NotFoundResponse("Tax rule not found"),
+		}, nil
+	}
+
+	currencyCode, msg := normalizeTaxCurrency(req.CurrencyCode)
+	if msg != "" {
+		return &tax.UpdateTaxRuleResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	existingRule.Name = req.Name
+	existingRule.Rate = req.Rate
+	existingRule.IsInclusive = req.IsInclusive
+	existingRule.CurrencyCode = currencyCode
+	existingRule.ExemptCategories = req.ExemptCategories
+	existingRule.IsActive = req.IsActive
+
+	if msg, err := ts.checkSingleActiveRule(ctx, existingRule); err != nil || msg != "" {
+		if err != nil {
+			return nil, err
+		}
+		return &tax.UpdateTaxRuleResponse{
+			Base: utils.BadRequestResponse(msg),
+		}, nil
+	}
+
+	now := time.Now()
+	existingRule.UpdatedAt = &now
+	existingRule.UpdatedBy = &claims.FullName
+
+	err = ts.taxRepository.UpdateTaxRule(ctx, existingRule)
+	if errors.Is(err, repositories.ErrActiveTaxRuleExists) {
+		return &tax.UpdateTaxRuleResponse{
+			Base: utils.BadRequestResponse("Another tax rule is already active for this currency"),
+		}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update tax rule: %v", err))
+	}
+
+	return &tax.UpdateTaxRuleResponse{
+		Base: utils.SuccessResponse("Tax rule updated successfully"),
+	}, nil
+}
+
+func (ts *taxService) DeleteTaxRule(ctx context.Context, req *tax.DeleteTaxRuleRequest) (*tax.DeleteTaxRuleResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can delete tax rule")
+	}
+
+	existingRule, err := ts.taxRepository.GetTaxRuleByID(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingRule == nil {
+		return &tax.DeleteTaxRuleResponse{
+			Base: utils.NotFoundResponse("Tax rule not found"),
+		}, nil
+	}
+
+	existingRule.DeletedBy = &claims.FullName
+
+	err = ts.taxRepository.DeleteTaxRule(ctx, existingRule)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete tax rule: %v", err))
+	}
+
+	return &tax.DeleteTaxRuleResponse{
+		Base: utils.SuccessResponse("Tax rule deleted successfully"),
+	}, nil
+}
+
+func (ts *taxService) ListTaxRules(ctx context.Context, req *tax.ListTaxRulesRequest) (*tax.ListTaxRulesResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	rules, err := ts.taxRepository.GetTaxRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*tax.ListTaxRulesResponseItem, 0)
+	for _, r := range rules {
+		items = append(items, &tax.ListTaxRulesResponseItem{
+			Id:               uint64(r.ID),
+			Name:             r.Name,
+			Rate:             r.Rate,
+			IsInclusive:      r.IsInclusive,
+			CurrencyCode:     r.CurrencyCode,
+			ExemptCategories: r.ExemptCategories,
+			IsActive:         r.IsActive,
+		})
+	}
+
+	return &tax.ListTaxRulesResponse{
+		Base: utils.SuccessResponse("Tax rules retrieved successfully"),
+		Data: items,
+	}, nil
+}
+
+// CalculateTax applies the active tax rule for the currency to each line.
+// Lines in an exempt category are not taxed. Without an active rule the
+// result is zero.
+func (ts *taxService) CalculateTax(ctx context.Context, currencyCode string, lines []TaxLine) (*TaxResult, error) {
+	result := &TaxResult{
+		Rates:   make([]float64, len(lines)),
+		Amounts: make([]money.Amount, len(lines)),
+	}
+
+	rule, err := ts.taxRepository.GetActiveTaxRule(ctx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == nil {
+		return result, nil
+	}
+
+	result.Rule = rule
+	result.Inclusive = rule.IsInclusive
+
+	for i, line := range lines {
+		if taxExempt(rule, line.Category) {
+			continue
+		}
+
+		result.Rates[i] = rule.Rate
+		if rule.IsInclusive {
+			result.Amounts[i] = line.Amount.PercentIncluded(rule.Rate)
+		} else {
+			result.Amounts[i] = line.Amount.Percent(rule.Rate)
+		}
+		result.Total += result.Amounts[i]
+	}
+
+	return result, nil
+}
+
+// checkSingleActiveRule makes sure at most one active rule exists per
+// currency, so the rule an order gets is never ambiguous. It only gives a
+// friendlier message; the partial unique index on tax_rule catches writes
+// that race past it.
+func (ts *taxService) checkSingleActiveRule(ctx context.Context, rule *models.TaxRule) (string, error) {
+	if !rule.IsActive {
+		return "", nil
+	}
+
+	activeRule, err := ts.taxRepository.GetActiveTaxRule(ctx, rule.CurrencyCode)
+	if err != nil {
+		return "", err
+	}
+
+	if activeRule != nil && activeRule.ID != rule.ID && activeRule.CurrencyCode == rule.CurrencyCode {
+		return fmt.Sprintf("Tax rule %s is already active for this currency", activeRule.Name), nil
+	}
+
+	return "", nil
+}
+
+func taxExempt(rule *models.TaxRule, category string) bool {
+	if category == "" {
+		return false
+	}
+
+	for _, exempt := range rule.ExemptCategories {
+		if strings.EqualFold(exempt, category) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeTaxCurrency(code string) (string, string) {
+	if code == "" {
+		return "", ""
+	}
+
+	currencyCode, err := money.NormalizeCurrency(code)
+	if err != nil {
+		return "", fmt.Sprintf("Currency %s is not supported", code)
+	}
+
+	return currencyCode, ""
+}
+
+func NewTaxService(taxRepository repositories.ITaxRepository) ITaxService {
+	return &taxService{
+		taxRepository: taxRepository,
+	}
+}
@@ -3,7 +3,10 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fahrillrizal/ecommerce-grpc/internal/dto"
@@ -11,14 +14,34 @@ import (
 	"github.com/fahrillrizal/ecommerce-grpc/models"
 )
 
+// Invoice statuses in Xendit callbacks that mean the invoice was paid.
+const (
+	xenditInvoiceStatusPaid    = "PAID"
+	xenditInvoiceStatusSettled = "SETTLED"
+)
+
 type IWebhookService interface {
 	ReceiveInvoice(ctx context.Context, req *dto.XenditInvoiceRequest) error
+	ReceiveRefund(ctx context.Context, req *dto.XenditRefundRequest) error
 }
 type webhookService struct {
-	orderRepository repositories.IOrderRepository
+	orderRepository     repositories.IOrderRepository
+	returnRepository    repositories.IReturnRepository
+	stateMachine        IOrderStateMachine
+	cancellationService IOrderCancellationService
+	returnService       IReturnService
 }
 
+// ReceiveInvoice marks the order paid when Xendit reports its invoice paid.
+// Other invoice statuses need nothing, unpaid orders are canceled by the
+// expiry job.
 func (ws *webhookService) ReceiveInvoice(ctx context.Context, req *dto.XenditInvoiceRequest) error {
+	switch strings.ToUpper(req.Status) {
+	case xenditInvoiceStatusPaid, xenditInvoiceStatusSettled:
+	default:
+		return nil
+	}
+
 	orderID, err := strconv.ParseUint(req.ExternalID, 10, 64)
 	if err != nil {
 		return errors.New("invalid external ID format")
@@ -33,24 +56,72 @@ func (ws *webhookService) ReceiveInvoice(ctx context.Context, req *dto.XenditInv
 	}
 
 	now := time.Now()
-	updatedBy := "System"
-	orderEntity.OrderStatusCode = models.OrderStatusCodePaid
-	orderEntity.UpdatedAt = &now
-	orderEntity.UpdatedBy = &updatedBy
-	orderEntity.XenditPaidAt = &now
-	orderEntity.XenditPaymentChannel = req.PaymentChannel
-	orderEntity.XenditPaymentMethod = req.PaymentMethod
-
-	err = ws.orderRepository.UpdateOrder(ctx, orderEntity)
+	if !req.PaidAt.IsZero() {
+		now = req.PaidAt
+	}
+
+	switch orderEntity.OrderStatusCode {
+	case models.OrderStatusCodeUnpaid:
+		orderEntity.XenditPaidAt = &now
+		orderEntity.XenditPaymentChannel = req.PaymentChannel
+		orderEntity.XenditPaymentMethod = req.PaymentMethod
+
+		// When the order is canceled meanwhile the transition fails, and the
+		// payment is refunded when Xendit retries the callback.
+		reason := fmt.Sprintf("Xendit invoice paid via %s", req.PaymentChannel)
+		event, err := ws.stateMachine.Transition(ctx, ws.orderRepository, orderEntity, models.OrderStatusCodePaid, WebhookActor, reason)
+		if err != nil {
+			return err
+		}
+		ws.stateMachine.Publish(event)
+		return nil
+	case models.OrderStatusCodeCanceled:
+		// The invoice was paid while the order was being canceled, so the
+		// money goes back.
+		if orderEntity.XenditPaidAt == nil {
+			orderEntity.XenditPaidAt = &now
+			orderEntity.XenditPaymentChannel = req.PaymentChannel
+			orderEntity.XenditPaymentMethod = req.PaymentMethod
+		}
+
+		return ws.cancellationService.RefundLatePayment(ctx, orderEntity, WebhookActor)
+	default:
+		// Xendit retries deliveries, so a paid order is already handled.
+		return nil
+	}
+}
+
+func (ws *webhookService) ReceiveRefund(ctx context.Context, req *dto.XenditRefundRequest) error {
+	orderEntity, err := ws.orderRepository.GetOrderByRefundID(ctx, req.Data.ID)
 	if err != nil {
 		return err
 	}
+	if orderEntity == nil {
+		// Not an order cancellation, so it has to be a return.
+		returnRequest, err := ws.returnRepository.GetReturnRequestByRefundID(ctx, req.Data.ID)
+		if err != nil {
+			return err
+		}
+		if returnRequest == nil {
+			return errors.New("refund not found")
+		}
+
+		return ws.returnService.ApplyRefundStatus(ctx, returnRequest, req.Data.Status)
+	}
+
+	if req.Data.FailureCode != "" {
+		log.Printf("refund %s of order %d failed: %s", req.Data.ID, orderEntity.ID, req.Data.FailureCode)
+	}
 
-	return nil
+	return ws.cancellationService.ApplyRefundStatus(ctx, orderEntity, req.Data.Status, WebhookActor)
 }
 
-func NewWebhookService(orderRepository repositories.IOrderRepository) IWebhookService {
+func NewWebhookService(orderRepository repositories.IOrderRepository, returnRepository repositories.IReturnRepository, stateMachine IOrderStateMachine, cancellationService IOrderCancellationService, returnService IReturnService) IWebhookService {
 	return &webhookService{
-		orderRepository: orderRepository,
+		orderRepository:     orderRepository,
+		returnRepository:    returnRepository,
+		stateMachine:        stateMachine,
+		cancellationService: cancellationService,
+		returnService:       returnService,
 	}
 }
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/cart"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/wishlist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IWishlistService interface {
+	AddToWishlist(ctx context.Context, req *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, req *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error)
+	ListWishlist(ctx context.Context, req *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error)
+	MoveWishlistToCart(ctx context.Context, req *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error)
+	MostWishlistedProducts(ctx context.Context, req *wishlist.MostWishlistedProductsRequest) (*wishlist.MostWishlistedProductsResponse, error)
+}
+
+type wishlistService struct {
+	wishlistRepository repositories.IWishlistRepository
+	productRepository  repositories.IProductRepository
+	cartService        ICartService
+	pricingService     IPricingService
+}
+
+func (ws *wishlistService) AddToWishlist(ctx context.Context, req *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	_, err = ws.productRepository.GetProductByID(ctx, uint(req.ProductId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	existingWishlist, err := ws.wishlistRepository.GetWishlistByProductUserID(ctx, uint(req.ProductId), claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingWishlist != nil {
+		return &wishlist.AddToWishlistResponse{
+			Base: utils.SuccessResponse("Product is already in wishlist"),
+			Id:   uint64(existingWishlist.ID),
+		}, nil
+	}
+
+	newWishlist := &models.Wishlist{
+		ProductID: uint(req.ProductId),
+		UserID:    claims.UserID,
+		BaseModel: models.BaseModel{
+			CreatedAt: time.Now(),
+			CreatedBy: claims.FullName,
+		},
+	}
+
+	err = ws.wishlistRepository.CreateWishlist(ctx, newWishlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.AddToWishlistResponse{
+		Base: utils.SuccessResponse("Added to wishlist successfully"),
+		Id:   uint64(newWishlist.ID),
+	}, nil
+}
+
+func (ws *wishlistService) RemoveFromWishlist(ctx context.Context, req *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	existingWishlist, err := ws.wishlistRepository.GetWishlistByProductUserID(ctx, uint(req.ProductId), claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingWishlist == nil {
+		return &wishlist.RemoveFromWishlistResponse{
+			Base: utils.NotFoundResponse("Product is not in wishlist"),
+		}, nil
+	}
+
+	err = ws.wishlistRepository.DeleteWishlist(ctx, existingWishlist.ID, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.RemoveFromWishlistResponse{
+		Base: utils.SuccessResponse("Removed from wishlist successfully"),
+	}, nil
+}
+
+func (ws *wishlistService) ListWishlist(ctx context.Context, req *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	currencyCode, err := resolveCurrency(req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlists, metadata, err := ws.wishlistRepository.GetListWishlist(ctx, claims.UserID, req.Pagination)
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return &wishlist.ListWishlistResponse{
+			Base: utils.BadRequestResponse("Invalid pagination cursor"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*models.Product, 0, len(wishlists))
+	for _, w := range wishlists {
+		if w.Product != nil {
+			products = append(products, w.Product)
+		}
+	}
+
+	prices, err := ws.pricingService.ResolvePrices(ctx, currencyCode, products)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*wishlist.ListWishlistResponseItem, 0)
+	for _, w := range wishlists {
+		item := &wishlist.ListWishlistResponseItem{
+			Id:        uint64(w.ID),
+			ProductId: uint64(w.ProductID),
+			AddedAt:   utils.ConvertTimeToTimestamp(w.CreatedAt),
+		}
+
+		p := w.Product
+		if p != nil {
+			item.ProductName = p.Name
+			item.ProductImageUrl = p.ImageURL
+			item.ProductPrice = optionalPriceToProto(prices, p.ID, currencyCode)
+			item.IsAvailable = !p.IsDeleted && !p.DeletedAt.Valid
+			item.InStock = item.IsAvailable && (p.Stock == nil || *p.Stock > 0)
+		}
+
+		items = append(items, item)
+	}
+
+	return &wishlist.ListWishlistResponse{
+		Base:       utils.SuccessResponse("List wishlist fetched successfully"),
+		Pagination: metadata,
+		Items:      items,
+	}, nil
+}
+
+// MoveWishlistToCart adds the product to the cart with the same rules as
+// AddToCart and removes it from the wishlist once the cart accepted it.
+func (ws *wishlistService) MoveWishlistToCart(ctx context.Context, req *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	existingWishlist, err := ws.wishlistRepository.GetWishlistByProductUserID(ctx, uint(req.ProductId), claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingWishlist == nil {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.NotFoundResponse("Product is not in wishlist"),
+		}, nil
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	cartRes, err := ws.cartService.AddToCart(ctx, &cart.AddToCartRequest{
+		ProductId: req.ProductId,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cartRes.BaseResponse.IsError {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: cartRes.BaseResponse,
+		}, nil
+	}
+
+	err = ws.wishlistRepository.DeleteWishlist(ctx, existingWishlist.ID, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.MoveWishlistToCartResponse{
+		Base:   utils.SuccessResponse("Moved to cart successfully"),
+		CartId: cartRes.Id,
+	}, nil
+}
+
+func (ws *wishlistService) MostWishlistedProducts(ctx context.Context, req *wishlist.MostWishlistedProductsRequest) (*wishlist.MostWishlistedProductsResponse, error) {
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	if claims.RoleCode != "ADMIN" {
+		return nil, status.Error(codes.PermissionDenied, "only admin can access this resource")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	counts, err := ws.wishlistRepository.GetMostWishlistedProducts(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*wishlist.MostWishlistedProductsResponseItem, 0)
+	for _, c := range counts {
+		items = append(items, &wishlist.MostWishlistedProductsResponseItem{
+			ProductId:     uint64(c.ProductID),
+			ProductName:   c.ProductName,
+			WishlistCount: c.WishlistCount,
+		})
+	}
+
+	return &wishlist.MostWishlistedProductsResponse{
+		Base:  utils.SuccessResponse("Most wishlisted products fetched successfully"),
+		Items: items,
+	}, nil
+}
+
+func NewWishlistService(wishlistRepository repositories.IWishlistRepository, productRepository repositories.IProductRepository, cartService ICartService, pricingService IPricingService) IWishlistService {
+	return &wishlistService{
+		wishlistRepository: wishlistRepository,
+		productRepository:  productRepository,
+		cartService:        cartService,
+		pricingService:     pricingService,
+	}
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// CartTokenHeader is the metadata key carrying a guest cart token.
+const CartTokenHeader = "x-cart-token"
+
+func GenerateCartToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func ExtractCartTokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing metadata in context")
+	}
+
+	tokens := md.Get(CartTokenHeader)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return "", errors.New("missing cart token")
+	}
+
+	return tokens[0], nil
+}
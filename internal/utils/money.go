@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+// ConvertMoneyToProto converts an amount in the given currency to common.Money
+func ConvertMoneyToProto(amount money.Amount, currencyCode string) *common.Money {
+	return &common.Money{
+		CurrencyCode: currencyCode,
+		MinorUnits:   amount.Minor(),
+	}
+}
+
+// ConvertProtoToMoney converts common.Money in the store currency to an
+// amount. A nil message is a zero amount.
+func ConvertProtoToMoney(m *common.Money) (money.Amount, error) {
+	if m == nil {
+		return 0, nil
+	}
+
+	if m.CurrencyCode != "" && m.CurrencyCode != money.DefaultCurrency {
+		return 0, fmt.Errorf("unsupported currency %s", m.CurrencyCode)
+	}
+
+	return money.FromMinor(m.MinorUnits), nil
+}
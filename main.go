@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"net/http"
@@ -13,11 +14,24 @@ import (
 	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/auth"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/cart"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/integration"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/newsletter"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/notification"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/order"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/pricing"
 	"github.com/fahrillrizal/ecommerce-grpc/pb/product"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/promotion"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/returns"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/shipping"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/tax"
+	"github.com/fahrillrizal/ecommerce-grpc/pb/wishlist"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/carrier"
 	"github.com/fahrillrizal/ecommerce-grpc/pkg/database"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/eventbus"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/mail"
 	"github.com/fahrillrizal/ecommerce-grpc/pkg/middleware"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/payment"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/webhook"
 	"github.com/gofiber/fiber/v2"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/joho/godotenv"
@@ -32,6 +46,10 @@ func main() {
 	godotenv.Load()
 
 	xendit.Opt.SecretKey = os.Getenv("XENDIT_SECRET_KEY")
+	xenditCallbackToken := os.Getenv("XENDIT_CALLBACK_TOKEN")
+	if xenditCallbackToken == "" {
+		log.Fatal("XENDIT_CALLBACK_TOKEN must be set to verify Xendit callbacks")
+	}
 
 	db, err := database.InitDB()
 	if err != nil {
@@ -40,36 +58,125 @@ func main() {
 
 	cacheService := gocache.New(time.Hour*24, time.Hour)
 
-	authMiddleware := middleware.NewAuthMiddleware(cacheService)
-	authRepository := repositories.NewAuthRepository(db)
-	authService := services.NewAuthService(authRepository, cacheService)
-	authHandler := handler.NewAuthHandler(authService)
-
 	cloudinaryUtils, err := utils.NewCloudinaryUtils()
 	if err != nil {
 		log.Fatalf("Failed to initialize Cloudinary: %v", err)
 	}
 
 	productRepository := repositories.NewProductRepository(db)
-	productService := services.NewProductService(productRepository, cloudinaryUtils)
+	pricingRepository := repositories.NewPricingRepository(db)
+	pricingService := services.NewPricingService(pricingRepository, productRepository)
+	pricingHandler := handler.NewPricingHandler(pricingService)
+
+	taxRepository := repositories.NewTaxRepository(db)
+	taxService := services.NewTaxService(taxRepository)
+	taxHandler := handler.NewTaxHandler(taxService)
+
+	outboxRepository := repositories.NewOutboxRepository(db)
+
+	productService := services.NewProductService(productRepository, cloudinaryUtils, pricingService, pricingRepository, outboxRepository)
 	productHandler := handler.NewProductHandler(productService)
 
 	cartRepository := repositories.NewCartRepository(db)
-	cartService := services.NewCartService(productRepository, cartRepository)
+	cartService := services.NewCartService(productRepository, cartRepository, pricingService)
 	cartHandler := handler.NewCartHandler(cartService)
 
+	shippingRepository := repositories.NewShippingRepository(db)
+	shippingService := services.NewShippingService(shippingRepository, cartRepository, pricingService)
+	shippingHandler := handler.NewShippingHandler(shippingService)
+
+	wishlistRepository := repositories.NewWishlistRepository(db)
+	wishlistService := services.NewWishlistService(wishlistRepository, productRepository, cartService, pricingService)
+	wishlistHandler := handler.NewWishlistHandler(wishlistService)
+
+	authMiddleware := middleware.NewAuthMiddleware(cacheService)
+	authRepository := repositories.NewAuthRepository(db)
+	authService := services.NewAuthService(authRepository, cartService, cacheService)
+	authHandler := handler.NewAuthHandler(authService)
+
+	promotionRepository := repositories.NewPromotionRepository(db)
+	promotionService := services.NewPromotionService(promotionRepository, cartRepository, pricingService)
+	promotionHandler := handler.NewPromotionHandler(promotionService)
+
+	numberingRepository := repositories.NewNumberingRepository(db)
+	numberingService := services.NewNumberingService()
+
 	orderRepository := repositories.NewOrderRepository(db)
-	orderService := services.NewOrderService(orderRepository, productRepository, cartRepository)
+	orderStatusRepository := repositories.NewOrderStatusRepository(db)
+	orderEvents := eventbus.New[services.OrderStatusEvent]()
+	orderStateMachine := services.NewOrderStateMachine(orderStatusRepository, orderEvents)
+	refundGateway := payment.NewXenditRefundGateway(os.Getenv("XENDIT_SECRET_KEY"))
+	orderCancellationService := services.NewOrderCancellationService(orderRepository, productRepository, promotionRepository, orderStateMachine, refundGateway)
+	orderService := services.NewOrderService(orderRepository, productRepository, cartRepository, promotionRepository, promotionService, pricingService, taxService, shippingService, orderStatusRepository, orderStateMachine, orderCancellationService, numberingRepository, numberingService, orderEvents, outboxRepository)
 	orderHandler := handler.NewOrderHandler(orderService)
+	orderExportHandler := handler.NewOrderExportHandler(orderService, authMiddleware)
+	orderReceiptHandler := handler.NewOrderReceiptHandler(orderService, authMiddleware)
+
+	returnRepository := repositories.NewReturnRepository(db)
+	returnService := services.NewReturnService(returnRepository, orderRepository, productRepository, refundGateway, numberingRepository, numberingService)
+	returnHandler := handler.NewReturnHandler(returnService)
+
+	tracker, err := carrier.NewTrackerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize carrier tracker: %v", err)
+	}
+	// Without a tracker shipped orders are completed by the customer or an admin.
+	if tracker != nil {
+		shipmentTrackingService := services.NewShipmentTrackingService(orderRepository, tracker, orderStateMachine)
+		go shipmentTrackingService.Run(context.Background(), 30*time.Minute)
+	}
+
+	orderExpiryService := services.NewOrderExpiryService(orderRepository, orderCancellationService)
+	go orderExpiryService.Run(context.Background(), 5*time.Minute)
+
+	mailTransport, err := mail.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize mail transport: %v", err)
+	}
 
+	newsletterSigningKey := os.Getenv("NEWSLETTER_SECRET_KEY")
+	if newsletterSigningKey == "" {
+		newsletterSigningKey = os.Getenv("JWT_SECRET_KEY")
+	}
+	if newsletterSigningKey == "" {
+		log.Fatal("NEWSLETTER_SECRET_KEY or JWT_SECRET_KEY must be set to sign unsubscribe links")
+	}
 	newsletterRepository := repositories.NewNewsletterRepository(db)
-	newsletterService := services.NewNewsletterService(newsletterRepository)
+	newsletterCampaignRepository := repositories.NewNewsletterCampaignRepository(db)
+	newsletterService := services.NewNewsletterService(newsletterRepository, newsletterCampaignRepository, mailTransport, []byte(newsletterSigningKey))
 	newsletterHandler := handler.NewNewsletterHandler(newsletterService)
+	newsletterExportHandler := handler.NewNewsletterExportHandler(newsletterService, authMiddleware)
+	newsletterUnsubscribeHandler := handler.NewNewsletterUnsubscribeHandler(newsletterService)
+
+	go newsletterService.Run(context.Background(), 30*time.Second)
+
+	webhookSubscriptionRepository := repositories.NewWebhookSubscriptionRepository(db)
+	notificationRepository := repositories.NewNotificationRepository(db)
+	domainEvents := eventbus.New[services.DomainEvent]()
+	outboxDispatcher := services.NewOutboxDispatcher(outboxRepository, webhookSubscriptionRepository, notificationRepository, domainEvents)
+	go outboxDispatcher.Run(context.Background(), 5*time.Second)
+
+	integrationService := services.NewIntegrationService(webhookSubscriptionRepository, outboxRepository, webhook.NewHTTPSender(10*time.Second))
+	go integrationService.Run(context.Background(), 15*time.Second)
+	integrationHandler := handler.NewIntegrationHandler(integrationService)
+
+	notificationService := services.NewNotificationService(notificationRepository, authRepository, orderRepository, mailTransport)
+	go notificationService.Run(context.Background(), 15*time.Second)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+
+	idempotencyRepository := repositories.NewIdempotencyRepository(db)
+	idempotencyMiddleware := middleware.NewIdempotencyMiddleware(idempotencyRepository, 24*time.Hour, 5*time.Minute)
+	go idempotencyMiddleware.Run(context.Background(), time.Hour)
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middleware.ErrorMiddleware,
 			authMiddleware.Middleware,
+			idempotencyMiddleware.Middleware,
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.ErrorStreamMiddleware,
+			authMiddleware.StreamMiddleware,
 		),
 	)
 
@@ -78,6 +185,14 @@ func main() {
 	cart.RegisterCartServiceServer(server, cartHandler)
 	order.RegisterOrderServiceServer(server, orderHandler)
 	newsletter.RegisterNewsletterServiceServer(server, newsletterHandler)
+	wishlist.RegisterWishlistServiceServer(server, wishlistHandler)
+	promotion.RegisterPromotionServiceServer(server, promotionHandler)
+	pricing.RegisterPricingServiceServer(server, pricingHandler)
+	tax.RegisterTaxServiceServer(server, taxHandler)
+	shipping.RegisterShippingServiceServer(server, shippingHandler)
+	returns.RegisterReturnServiceServer(server, returnHandler)
+	integration.RegisterIntegrationServiceServer(server, integrationHandler)
+	notification.RegisterNotificationServiceServer(server, notificationHandler)
 
 	if os.Getenv("ENVIRONMENT") == "dev" {
 		reflection.Register(server)
@@ -106,6 +221,8 @@ func main() {
 			"X-Grpc-Web",
 			"X-User-Agent",
 			"Authorization",
+			"X-Cart-Token",
+			"Idempotency-Key",
 		},
 		ExposedHeaders: []string{
 			"Grpc-Status",
@@ -122,10 +239,35 @@ func main() {
 			log.Printf("[gRPC-Web] %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
 
 			if wrappedGrpc.IsGrpcWebRequest(r) {
+				// These streams outlive the write timeout: the exports run as long
+				// as there are rows, the watches until the browser closes them.
+				if isLongRunningStream(r.URL.Path) {
+					http.NewResponseController(w).SetWriteDeadline(time.Time{})
+				}
 				wrappedGrpc.ServeHTTP(w, r)
 				return
 			}
 
+			if r.URL.Path == "/export/orders" {
+				orderExportHandler.ServeHTTP(w, r)
+				return
+			}
+
+			if r.URL.Path == "/export/newsletter-subscribers" {
+				newsletterExportHandler.ServeHTTP(w, r)
+				return
+			}
+
+			if r.URL.Path == "/newsletter/unsubscribe" {
+				newsletterUnsubscribeHandler.ServeHTTP(w, r)
+				return
+			}
+
+			if _, ok := handler.ReceiptOrderID(r.URL.Path); ok {
+				orderReceiptHandler.ServeHTTP(w, r)
+				return
+			}
+
 			if r.URL.Path == "/health" {
 				w.WriteHeader(http.StatusOK)
 				w.Write([]byte("OK"))
@@ -147,9 +289,10 @@ func main() {
 
 	// Setup Fiber untuk webhook
 	app := fiber.New()
-	webhookService := services.NewWebhookService(orderRepository)
-	webhookHandler := handler.NewWebhookHandler(webhookService)
-	app.Post("/webhook/xendit/invoice", webhookHandler.ReceiveInvoice)
+	webhookService := services.NewWebhookService(orderRepository, returnRepository, orderStateMachine, orderCancellationService, returnService)
+	webhookHandler := handler.NewWebhookHandler(webhookService, xenditCallbackToken)
+	app.Post("/webhook/xendit/invoice", webhookHandler.VerifyCallbackToken, webhookHandler.ReceiveInvoice)
+	app.Post("/webhook/xendit/refund", webhookHandler.VerifyCallbackToken, webhookHandler.ReceiveRefund)
 
 	go func() {
 		log.Println("Webhook server listening on :8081")
@@ -168,3 +311,13 @@ func main() {
 		log.Panicf("error serving gRPC server: %v", err)
 	}
 }
+
+func isLongRunningStream(path string) bool {
+	switch path {
+	case "/order.OrderService/ExportOrders", "/order.OrderService/WatchOrder", "/order.OrderService/WatchOrders",
+		"/newsletter.NewsletterService/ExportSubscribers":
+		return true
+	default:
+		return false
+	}
+}
@@ -1,15 +1,22 @@
 package models
 
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
 type Cart struct {
 	ID        uint `gorm:"primaryKey;autoIncrement" json:"id"`
 	ProductID uint `gorm:"not null" json:"product_id"`
-	UserID    uint `gorm:"not null" json:"user_id"`
-	Quantity  int  `gorm:"not null" json:"quantity"`
+	// Exactly one of UserID and GuestCartID is set.
+	UserID      *uint `gorm:"index:idx_cart_user" json:"user_id,omitempty"`
+	GuestCartID *uint `gorm:"index:idx_cart_guest_cart" json:"guest_cart_id,omitempty"`
+	Quantity    int   `gorm:"not null" json:"quantity"`
+	// Price is the product price at the time the line was added.
+	Price money.Amount `gorm:"type:decimal(15,2);not null;default:0" json:"price"`
 	BaseModel
-	Product *Product `gorm:"foreignKey:ProductID" json:"product,omitempty"`
-	User    *User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Product   *Product   `gorm:"foreignKey:ProductID" json:"product,omitempty"`
+	User      *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	GuestCart *GuestCart `gorm:"foreignKey:GuestCartID" json:"guest_cart,omitempty"`
 }
 
 func init() {
 	RegisterModel(&Cart{})
-}
\ No newline at end of file
+}
@@ -0,0 +1,17 @@
+package models
+
+const (
+	CartWarningCodePriceChanged       = "price_changed"
+	CartWarningCodeProductUnavailable = "product_unavailable"
+	CartWarningCodeInsufficientStock  = "insufficient_stock"
+	CartWarningCodeCurrencyNotPriced  = "currency_not_priced"
+)
+
+// Rules for resolving a product that is in both the guest cart and the
+// user's cart when the two are merged on login.
+const (
+	CartMergeStrategySum       = "sum"
+	CartMergeStrategyMax       = "max"
+	CartMergeStrategyKeepUser  = "keep_user"
+	CartMergeStrategyKeepGuest = "keep_guest"
+)
@@ -0,0 +1,36 @@
+package models
+
+// Domain event types. Integrations subscribe to them through outbound
+// webhooks.
+const (
+	EventTypeOrderCreated   = "order.created"
+	EventTypeOrderPaid      = "order.paid"
+	EventTypeOrderShipped   = "order.shipped"
+	EventTypeOrderCompleted = "order.completed"
+	EventTypeOrderCanceled  = "order.canceled"
+	EventTypeProductCreated = "product.created"
+	EventTypeProductUpdated = "product.updated"
+	EventTypeProductDeleted = "product.deleted"
+	// EventTypeWebhookPing is only sent to the subscription being tested.
+	EventTypeWebhookPing = "webhook.ping"
+)
+
+// EventTypes lists the event types a webhook subscription can ask for.
+var EventTypes = []string{
+	EventTypeOrderCreated,
+	EventTypeOrderPaid,
+	EventTypeOrderShipped,
+	EventTypeOrderCompleted,
+	EventTypeOrderCanceled,
+	EventTypeProductCreated,
+	EventTypeProductUpdated,
+	EventTypeProductDeleted,
+}
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	// WebhookDeliveryStatusDead marks a delivery that ran out of attempts.
+	// It stays in the dead-letter list until an admin retries it.
+	WebhookDeliveryStatusDead = "dead"
+)
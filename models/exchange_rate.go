@@ -0,0 +1,14 @@
+package models
+
+// ExchangeRate converts store currency amounts into CurrencyCode: one unit of
+// the store currency is worth Rate units of CurrencyCode.
+type ExchangeRate struct {
+	ID           uint    `gorm:"primaryKey;autoIncrement" json:"id"`
+	CurrencyCode string  `gorm:"type:varchar(3);uniqueIndex;not null" json:"currency_code"`
+	Rate         float64 `gorm:"type:decimal(20,10);not null" json:"rate"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ExchangeRate{})
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type GuestCart struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	Token     string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	ExpiresAt time.Time  `gorm:"type:timestamptz;not null;index:idx_guest_cart_expires" json:"expires_at"`
+	MergedAt  *time.Time `gorm:"type:timestamptz" json:"merged_at,omitempty"`
+	MergedTo  *uint      `gorm:"index" json:"merged_to,omitempty"`
+	BaseModel
+	Items []*Cart `gorm:"foreignKey:GuestCartID" json:"items,omitempty"`
+}
+
+func init() {
+	RegisterModel(&GuestCart{})
+}
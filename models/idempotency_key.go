@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// IdempotencyKey remembers a request sent with an idempotency-key header and
+// the response it got, so a retry with the same key gets that response again
+// instead of running the request twice.
+type IdempotencyKey struct {
+	ID     uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID uint   `gorm:"not null;uniqueIndex:idx_idempotency_key_user_key" json:"user_id"`
+	Key    string `gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_key_user_key" json:"key"`
+	Method string `gorm:"type:varchar(255);not null" json:"method"`
+	// RequestHash is the SHA-256 of the serialized request.
+	RequestHash string `gorm:"type:varchar(64);not null" json:"request_hash"`
+	// Response is the serialized response, set once the request completed.
+	Response    []byte     `gorm:"type:bytea" json:"-"`
+	CompletedAt *time.Time `gorm:"type:timestamptz" json:"completed_at,omitempty"`
+	// LockedUntil is how long the request holds the key while it runs. When
+	// it passes without a response, the request is taken to have died and
+	// another attempt may take the key over.
+	LockedUntil *time.Time `gorm:"type:timestamptz" json:"locked_until,omitempty"`
+	ExpiresAt   time.Time  `gorm:"type:timestamptz;not null;index:idx_idempotency_key_expires_at" json:"expires_at"`
+	CreatedAt   time.Time  `gorm:"type:timestamptz;not null" json:"created_at"`
+}
+
+func init() {
+	RegisterModel(&IdempotencyKey{})
+}
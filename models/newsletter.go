@@ -1,12 +1,29 @@
 package models
 
+import "time"
+
 type Newsletter struct {
 	ID       uint   `gorm:"primaryKey;autoIncrement" json:"id"`
 	FullName string `gorm:"type:varchar(255);not null" json:"full_name"`
-	Email    string `gorm:"type:varchar(255);not null;" json:"email"`
+	// Email is stored lower case, so the unique index ignores case.
+	Email string `gorm:"type:varchar(255);not null;uniqueIndex" json:"email"`
+	// Status is one of the NewsletterStatus constants. Rows from before double
+	// opt-in count as subscribed.
+	Status string `gorm:"type:varchar(20);not null;default:'subscribed';index:idx_newsletter_status" json:"status"`
+	// Topics lists the NewsletterTopic constants the subscriber wants. Empty
+	// means every topic.
+	Topics []string `gorm:"type:jsonb;serializer:json" json:"topics"`
+	Locale string   `gorm:"type:varchar(5);not null;default:'id'" json:"locale"`
+	// ConfirmationTokenHash is the SHA-256 of the token in the confirmation
+	// email, cleared once it is used.
+	ConfirmationTokenHash string     `gorm:"type:varchar(64);index:idx_newsletter_confirmation_token" json:"-"`
+	ConfirmationSentAt    *time.Time `gorm:"type:timestamptz" json:"confirmation_sent_at,omitempty"`
+	ConfirmationExpiresAt *time.Time `gorm:"type:timestamptz" json:"confirmation_expires_at,omitempty"`
+	ConfirmedAt           *time.Time `gorm:"type:timestamptz" json:"confirmed_at,omitempty"`
+	UnsubscribedAt        *time.Time `gorm:"type:timestamptz" json:"unsubscribed_at,omitempty"`
 	BaseModel
 }
 
 func init() {
 	RegisterModel(&Newsletter{})
-}
\ No newline at end of file
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// NewsletterCampaign is an email sent to every subscriber in its segment.
+// The bodies are templates rendered for each recipient.
+type NewsletterCampaign struct {
+	ID       uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name     string `gorm:"type:varchar(255);not null" json:"name"`
+	Subject  string `gorm:"type:varchar(255);not null" json:"subject"`
+	HTMLBody string `gorm:"type:text;not null" json:"html_body"`
+	TextBody string `gorm:"type:text;not null" json:"text_body"`
+	// Topic and Locale pick the subscribers. Empty matches everyone.
+	Topic  string `gorm:"type:varchar(50)" json:"topic"`
+	Locale string `gorm:"type:varchar(5)" json:"locale"`
+	// Status is one of the NewsletterCampaignStatus constants.
+	Status      string     `gorm:"type:varchar(20);not null;index:idx_newsletter_campaign_status" json:"status"`
+	ScheduledAt *time.Time `gorm:"type:timestamptz" json:"scheduled_at,omitempty"`
+	StartedAt   *time.Time `gorm:"type:timestamptz" json:"started_at,omitempty"`
+	FinishedAt  *time.Time `gorm:"type:timestamptz" json:"finished_at,omitempty"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&NewsletterCampaign{})
+}
@@ -0,0 +1,39 @@
+package models
+
+const (
+	// NewsletterStatusPending waits for the subscriber to confirm their
+	// address.
+	NewsletterStatusPending      = "pending"
+	NewsletterStatusSubscribed   = "subscribed"
+	NewsletterStatusUnsubscribed = "unsubscribed"
+)
+
+const (
+	NewsletterTopicPromotions  = "promotions"
+	NewsletterTopicNewArrivals = "new_arrivals"
+	NewsletterTopicNews        = "news"
+)
+
+// NewsletterTopics lists the topics a subscriber can choose from.
+var NewsletterTopics = []string{
+	NewsletterTopicPromotions,
+	NewsletterTopicNewArrivals,
+	NewsletterTopicNews,
+}
+
+const (
+	NewsletterCampaignStatusDraft     = "draft"
+	NewsletterCampaignStatusScheduled = "scheduled"
+	NewsletterCampaignStatusSending   = "sending"
+	NewsletterCampaignStatusSent      = "sent"
+	NewsletterCampaignStatusCanceled  = "canceled"
+)
+
+const (
+	NewsletterDeliveryStatusPending = "pending"
+	NewsletterDeliveryStatusSent    = "sent"
+	NewsletterDeliveryStatusFailed  = "failed"
+	// NewsletterDeliveryStatusUnsubscribed marks a recipient who unsubscribed
+	// after the campaign started and before their email went out.
+	NewsletterDeliveryStatusUnsubscribed = "unsubscribed"
+)
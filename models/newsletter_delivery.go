@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// NewsletterDelivery is one recipient of a campaign. The rows are created
+// when the campaign starts sending.
+type NewsletterDelivery struct {
+	ID           uint                `gorm:"primaryKey;autoIncrement" json:"id"`
+	CampaignID   uint                `gorm:"not null;uniqueIndex:idx_newsletter_delivery_recipient;index:idx_newsletter_delivery_status" json:"campaign_id"`
+	Campaign     *NewsletterCampaign `gorm:"foreignKey:CampaignID" json:"campaign,omitempty"`
+	NewsletterID uint                `gorm:"not null;uniqueIndex:idx_newsletter_delivery_recipient" json:"newsletter_id"`
+	Newsletter   *Newsletter         `gorm:"foreignKey:NewsletterID" json:"newsletter,omitempty"`
+	Email        string              `gorm:"type:varchar(255);not null" json:"email"`
+	// Status is one of the NewsletterDeliveryStatus constants.
+	Status string `gorm:"type:varchar(20);not null;index:idx_newsletter_delivery_status" json:"status"`
+	// LeaseUntil keeps other workers off a delivery while it is sent.
+	LeaseUntil *time.Time `gorm:"type:timestamptz" json:"lease_until,omitempty"`
+	Error      string     `gorm:"type:text" json:"error"`
+	SentAt     *time.Time `gorm:"type:timestamptz" json:"sent_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"type:timestamptz;not null" json:"created_at"`
+}
+
+func init() {
+	RegisterModel(&NewsletterDelivery{})
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Notification is one notification of one domain event. It is queued when
+// the event is dispatched and then logs whether it was sent or skipped.
+type Notification struct {
+	ID            uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID        uint         `gorm:"not null;index:idx_notification_user" json:"user_id"`
+	OutboxEventID uint         `gorm:"not null;uniqueIndex:idx_notification_event_channel" json:"outbox_event_id"`
+	OutboxEvent   *OutboxEvent `gorm:"foreignKey:OutboxEventID" json:"outbox_event,omitempty"`
+	EventType     string       `gorm:"type:varchar(100);not null" json:"event_type"`
+	Channel       string       `gorm:"type:varchar(20);not null;uniqueIndex:idx_notification_event_channel" json:"channel"`
+	// Recipient and Locale are filled in when the notification is sent.
+	Recipient string `gorm:"type:varchar(255);not null" json:"recipient"`
+	Subject   string `gorm:"type:varchar(255)" json:"subject"`
+	Locale    string `gorm:"type:varchar(5);not null" json:"locale"`
+	// Status is one of the NotificationStatus constants.
+	Status   string `gorm:"type:varchar(20);not null;index:idx_notification_status" json:"status"`
+	Error    string `gorm:"type:text" json:"error"`
+	Attempts int    `gorm:"not null;default:0" json:"attempts"`
+	// NextAttemptAt is when a pending or failed notification is tried next.
+	// It is empty once the notification was sent, skipped or given up on.
+	NextAttemptAt *time.Time `gorm:"type:timestamptz;index:idx_notification_due" json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time `gorm:"type:timestamptz" json:"sent_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"type:timestamptz;not null" json:"created_at"`
+}
+
+func init() {
+	RegisterModel(&Notification{})
+}
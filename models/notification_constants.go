@@ -0,0 +1,27 @@
+package models
+
+const (
+	NotificationChannelEmail = "email"
+)
+
+const (
+	NotificationStatusPending = "pending"
+	NotificationStatusSent    = "sent"
+	NotificationStatusFailed  = "failed"
+	// NotificationStatusSkipped records an event the user asked not to be
+	// told about.
+	NotificationStatusSkipped = "skipped"
+)
+
+const (
+	NotificationLocaleIndonesian = "id"
+	NotificationLocaleEnglish    = "en"
+)
+
+// NotificationEventTypes lists the domain events a user is emailed about.
+var NotificationEventTypes = []string{
+	EventTypeOrderCreated,
+	EventTypeOrderPaid,
+	EventTypeOrderShipped,
+	EventTypeOrderCanceled,
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// NotificationPreference holds what a user wants to be notified about. A
+// user without one gets every notification in Indonesian.
+type NotificationPreference struct {
+	UserID uint   `gorm:"primaryKey" json:"user_id"`
+	Locale string `gorm:"type:varchar(5);not null;default:'id'" json:"locale"`
+	// MutedEventTypes lists the event types the user gets no email for.
+	MutedEventTypes []string  `gorm:"type:jsonb;serializer:json" json:"muted_event_types"`
+	UpdatedAt       time.Time `gorm:"type:timestamptz;not null" json:"updated_at"`
+}
+
+func init() {
+	RegisterModel(&NotificationPreference{})
+}
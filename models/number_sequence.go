@@ -0,0 +1,13 @@
+package models
+
+// NumberSequence is the last number handed out for a module in one period,
+// such as a year. Period is empty for sequences that never start over.
+type NumberSequence struct {
+	Module string `gorm:"primaryKey;type:varchar(50)" json:"module"`
+	Period string `gorm:"primaryKey;type:varchar(20)" json:"period"`
+	Number int64  `gorm:"not null;default:0" json:"number"`
+}
+
+func init() {
+	RegisterModel(&NumberSequence{})
+}
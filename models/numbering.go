@@ -1,5 +1,8 @@
 package models
 
+// Numbering is the single counter per module that was used before
+// NumberSequence. Its rows are carried over to NumberSequence when the
+// database is seeded and then removed.
 type Numbering struct {
 	Module string `gorm:"primaryKey;type:varchar(255)" json:"module"`
 	Number int    `gorm:"type:int;not null" json:"number"`
@@ -7,4 +10,4 @@ type Numbering struct {
 
 func init() {
 	RegisterModel(&Numbering{})
-}
\ No newline at end of file
+}
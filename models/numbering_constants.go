@@ -0,0 +1,7 @@
+package models
+
+// Modules that hand out document numbers through NumberSequence.
+const (
+	NumberingModuleOrder  = "order"
+	NumberingModuleReturn = "return"
+)
@@ -1,26 +1,47 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
 
 type Order struct {
-	ID                   uint         `gorm:"primaryKey;autoIncrement" json:"id"`
-	Number               string       `gorm:"type:varchar(100);uniqueIndex;not null" json:"number"`
-	UserID               uint         `gorm:"not null;index:idx_order_user" json:"user_id"`
-	User                 *User        `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	OrderStatusCode      string       `gorm:"type:varchar(50);not null;index:idx_order_status" json:"order_status_code"`
-	OrderStatus          *OrderStatus `gorm:"foreignKey:OrderStatusCode;references:Code" json:"order_status,omitempty"`
-	UserFullName         string       `gorm:"type:varchar(255);not null" json:"user_full_name"`
-	Address              string       `gorm:"type:text;not null" json:"address"`
-	PhoneNumber          string       `gorm:"type:varchar(20);not null" json:"phone_number"`
-	Notes                string       `gorm:"type:text" json:"notes"`
-	Total                float64      `gorm:"type:decimal(15,2);not null" json:"total"`
-	ExpiredAt            *time.Time   `gorm:"type:timestamptz;index:idx_order_expired" json:"expired_at,omitempty"`
-	XenditInvoiceID      string       `gorm:"type:varchar(255);uniqueIndex" json:"xendit_invoice_id"`
-	XenditInvoiceUrl     string       `gorm:"type:varchar(255)" json:"xendit_invoice_url"`
-	XenditPaidAt         *time.Time   `gorm:"type:timestamptz" json:"xendit_paid_at,omitempty"`
-	XenditPaymentMethod  string       `gorm:"type:varchar(255)" json:"xendit_payment_method,omitempty"`
-	XenditPaymentChannel string       `gorm:"type:varchar(255)" json:"xendit_payment_channel,omitempty"`
-	Items                []*OrderItem `gorm:"foreignKey:OrderID" json:"items,omitempty"`
+	ID                   uint                  `gorm:"primaryKey;autoIncrement" json:"id"`
+	Number               string                `gorm:"type:varchar(100);uniqueIndex;not null" json:"number"`
+	UserID               uint                  `gorm:"not null;index:idx_order_user" json:"user_id"`
+	User                 *User                 `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	OrderStatusCode      string                `gorm:"type:varchar(50);not null;index:idx_order_status" json:"order_status_code"`
+	OrderStatus          *OrderStatus          `gorm:"foreignKey:OrderStatusCode;references:Code" json:"order_status,omitempty"`
+	UserFullName         string                `gorm:"type:varchar(255);not null" json:"user_full_name"`
+	Address              string                `gorm:"type:text;not null" json:"address"`
+	PhoneNumber          string                `gorm:"type:varchar(20);not null" json:"phone_number"`
+	Notes                string                `gorm:"type:text" json:"notes"`
+	CurrencyCode         string                `gorm:"type:varchar(3);not null;default:'IDR'" json:"currency_code"`
+	Subtotal             money.Amount          `gorm:"type:decimal(15,2);not null;default:0" json:"subtotal"`
+	DiscountTotal        money.Amount          `gorm:"type:decimal(15,2);not null;default:0" json:"discount_total"`
+	TaxTotal             money.Amount          `gorm:"type:decimal(15,2);not null;default:0" json:"tax_total"`
+	TaxInclusive         bool                  `gorm:"type:boolean;not null;default:false" json:"tax_inclusive"`
+	ShippingMethodCode   string                `gorm:"type:varchar(50)" json:"shipping_method_code"`
+	ShippingMethodName   string                `gorm:"type:varchar(100)" json:"shipping_method_name"`
+	ShippingRegion       string                `gorm:"type:varchar(100)" json:"shipping_region"`
+	ShippingTotal        money.Amount          `gorm:"type:decimal(15,2);not null;default:0" json:"shipping_total"`
+	Total                money.Amount          `gorm:"type:decimal(15,2);not null" json:"total"`
+	RefundID             string                `gorm:"type:varchar(255);index:idx_order_refund" json:"refund_id,omitempty"`
+	RefundStatus         string                `gorm:"type:varchar(20)" json:"refund_status,omitempty"`
+	RefundAmount         money.Amount          `gorm:"type:decimal(15,2);not null;default:0" json:"refund_amount"`
+	RefundedAt           *time.Time            `gorm:"type:timestamptz" json:"refunded_at,omitempty"`
+	ExpiredAt            *time.Time            `gorm:"type:timestamptz;index:idx_order_expired" json:"expired_at,omitempty"`
+	XenditInvoiceID      string                `gorm:"type:varchar(255);uniqueIndex" json:"xendit_invoice_id"`
+	XenditInvoiceUrl     string                `gorm:"type:varchar(255)" json:"xendit_invoice_url"`
+	XenditPaidAt         *time.Time            `gorm:"type:timestamptz" json:"xendit_paid_at,omitempty"`
+	XenditPaymentMethod  string                `gorm:"type:varchar(255)" json:"xendit_payment_method,omitempty"`
+	XenditPaymentChannel string                `gorm:"type:varchar(255)" json:"xendit_payment_channel,omitempty"`
+	Items                []*OrderItem          `gorm:"foreignKey:OrderID" json:"items,omitempty"`
+	Discounts            []*OrderDiscount      `gorm:"foreignKey:OrderID" json:"discounts,omitempty"`
+	Shipments            []*Shipment           `gorm:"foreignKey:OrderID" json:"shipments,omitempty"`
+	StatusHistory        []*OrderStatusHistory `gorm:"foreignKey:OrderID" json:"status_history,omitempty"`
+	Returns              []*ReturnRequest      `gorm:"foreignKey:OrderID" json:"returns,omitempty"`
 	BaseModel
 }
 
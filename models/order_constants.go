@@ -5,6 +5,23 @@ const (
 	OrderStatusCodePaid      = "paid"
 	OrderStatusCodeShipped   = "shipped"
 	OrderStatusCodeCompleted = "completed"
-	OrderStatusCodeDone      = "done"
 	OrderStatusCodeCanceled  = "canceled"
 )
+
+// OrderStatusCodeDone is a retired status that meant the same as completed.
+// Orders still in it are moved to completed when the database is seeded.
+const OrderStatusCodeDone = "done"
+
+const (
+	OrderRefundStatusPending   = "pending"
+	OrderRefundStatusSucceeded = "succeeded"
+	OrderRefundStatusFailed    = "failed"
+)
+
+// Who made an order status change.
+const (
+	OrderActorTypeUser    = "user"
+	OrderActorTypeAdmin   = "admin"
+	OrderActorTypeSystem  = "system"
+	OrderActorTypeWebhook = "webhook"
+)
@@ -0,0 +1,19 @@
+package models
+
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
+type OrderDiscount struct {
+	ID          uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	OrderID     uint         `gorm:"not null;index:idx_order_discount_order" json:"order_id"`
+	Order       *Order       `gorm:"foreignKey:OrderID" json:"order,omitempty"`
+	PromotionID uint         `gorm:"not null" json:"promotion_id"`
+	Promotion   *Promotion   `gorm:"foreignKey:PromotionID" json:"promotion,omitempty"`
+	Code        string       `gorm:"type:varchar(50);not null" json:"code"`
+	Description string       `gorm:"type:varchar(255)" json:"description"`
+	Amount      money.Amount `gorm:"type:decimal(15,2);not null" json:"amount"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&OrderDiscount{})
+}
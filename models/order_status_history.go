@@ -0,0 +1,19 @@
+package models
+
+type OrderStatusHistory struct {
+	ID      uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	OrderID uint   `gorm:"not null;index:idx_order_status_history_order" json:"order_id"`
+	Order   *Order `gorm:"foreignKey:OrderID" json:"order,omitempty"`
+	// FromStatusCode is empty for the entry written when the order is created.
+	FromStatusCode string `gorm:"type:varchar(50);not null;default:''" json:"from_status_code"`
+	ToStatusCode   string `gorm:"type:varchar(50);not null" json:"to_status_code"`
+	// ActorUserID is nil when the change was not made by a user.
+	ActorUserID *uint  `gorm:"index:idx_order_status_history_actor" json:"actor_user_id,omitempty"`
+	ActorType   string `gorm:"type:varchar(20);not null" json:"actor_type"`
+	Reason      string `gorm:"type:text" json:"reason"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&OrderStatusHistory{})
+}
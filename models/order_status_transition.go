@@ -0,0 +1,16 @@
+package models
+
+// OrderStatusTransition allows orders to move from one status to another.
+// AllowedActorTypes lists the OrderActorType constants that may make the
+// change.
+type OrderStatusTransition struct {
+	ID                uint     `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromStatusCode    string   `gorm:"type:varchar(50);not null;uniqueIndex:idx_order_status_transition" json:"from_status_code"`
+	ToStatusCode      string   `gorm:"type:varchar(50);not null;uniqueIndex:idx_order_status_transition" json:"to_status_code"`
+	AllowedActorTypes []string `gorm:"type:jsonb;serializer:json" json:"allowed_actor_types"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&OrderStatusTransition{})
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// OutboxEvent is a domain event saved in the same transaction as the change
+// it describes. The outbox dispatcher hands it to webhook subscriptions and
+// marks it dispatched, so an event is never sent for a change that was
+// rolled back nor lost for one that was committed.
+type OutboxEvent struct {
+	ID            uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	EventType     string `gorm:"type:varchar(100);not null" json:"event_type"`
+	AggregateType string `gorm:"type:varchar(50);not null" json:"aggregate_type"`
+	AggregateID   string `gorm:"type:varchar(100);not null" json:"aggregate_id"`
+	// Payload is the JSON body sent to subscribers.
+	Payload []byte `gorm:"type:jsonb;not null" json:"payload"`
+	// SubscriptionID limits the event to one subscription, as for pings.
+	SubscriptionID *uint      `gorm:"index:idx_outbox_event_subscription" json:"subscription_id,omitempty"`
+	OccurredAt     time.Time  `gorm:"type:timestamptz;not null" json:"occurred_at"`
+	DispatchedAt   *time.Time `gorm:"type:timestamptz;index:idx_outbox_event_dispatched_at" json:"dispatched_at,omitempty"`
+}
+
+func init() {
+	RegisterModel(&OutboxEvent{})
+}
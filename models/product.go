@@ -1,14 +1,23 @@
 package models
 
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
 type Product struct {
-	ID          uint    `gorm:"primaryKey;autoIncrement" json:"id"`
-	Name        string  `gorm:"type:varchar(255);not null" json:"name"`
-	Price       float64 `gorm:"type:decimal(15,2);not null;" json:"price"`
-	Description string  `gorm:"type:text" json:"description"`
-	ImageURL    string  `gorm:"type:varchar(255)" json:"image_url"`
+	ID          uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name        string       `gorm:"type:varchar(255);not null" json:"name"`
+	Price       money.Amount `gorm:"type:decimal(15,2);not null;" json:"price"`
+	Description string       `gorm:"type:text" json:"description"`
+	ImageURL    string       `gorm:"type:varchar(255)" json:"image_url"`
+	Category    string       `gorm:"type:varchar(100);index:idx_product_category" json:"category"`
+	// Stock is nil when the product's stock is not tracked.
+	Stock *int `gorm:"type:int" json:"stock,omitempty"`
+	// MaxPerOrder is nil when there is no per-order quantity limit.
+	MaxPerOrder *int `gorm:"type:int" json:"max_per_order,omitempty"`
+	// WeightGrams is the shipping weight of one unit.
+	WeightGrams int `gorm:"type:int;not null;default:0" json:"weight_grams"`
 	BaseModel
 }
 
 func init() {
 	RegisterModel(&Product{})
-}
\ No newline at end of file
+}
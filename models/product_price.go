@@ -0,0 +1,21 @@
+package models
+
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
+// ProductPrice is the price of a product in a currency other than the store
+// currency, which is kept on Product.Price.
+type ProductPrice struct {
+	ID           uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	ProductID    uint         `gorm:"not null;uniqueIndex:idx_product_price_product_currency" json:"product_id"`
+	Product      *Product     `gorm:"foreignKey:ProductID" json:"product,omitempty"`
+	CurrencyCode string       `gorm:"type:varchar(3);not null;uniqueIndex:idx_product_price_product_currency" json:"currency_code"`
+	Price        money.Amount `gorm:"type:decimal(15,2);not null" json:"price"`
+	// IsDerived is set when the price was computed from an exchange rate
+	// rather than entered by an admin.
+	IsDerived bool `gorm:"type:boolean;not null;default:false" json:"is_derived"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ProductPrice{})
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+type Promotion struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	Code         string `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
+	Name         string `gorm:"type:varchar(255);not null" json:"name"`
+	Description  string `gorm:"type:text" json:"description"`
+	DiscountType string `gorm:"type:varchar(20);not null" json:"discount_type"`
+	// DiscountValue is the percent off for percentage promotions and
+	// DiscountAmount the amount off for fixed ones.
+	DiscountValue  float64      `gorm:"type:decimal(15,2);not null;default:0" json:"discount_value"`
+	DiscountAmount money.Amount `gorm:"type:decimal(15,2);not null;default:0" json:"discount_amount"`
+	// MaxDiscount caps a percentage discount; nil means uncapped.
+	MaxDiscount *money.Amount `gorm:"type:decimal(15,2)" json:"max_discount,omitempty"`
+	MinSpend    money.Amount  `gorm:"type:decimal(15,2);not null;default:0" json:"min_spend"`
+	// UsageLimit and PerUserLimit are nil when unlimited.
+	UsageLimit   *int       `gorm:"type:int" json:"usage_limit,omitempty"`
+	PerUserLimit *int       `gorm:"type:int" json:"per_user_limit,omitempty"`
+	UsedCount    int        `gorm:"type:int;not null;default:0" json:"used_count"`
+	StartsAt     *time.Time `gorm:"type:timestamptz" json:"starts_at,omitempty"`
+	EndsAt       *time.Time `gorm:"type:timestamptz" json:"ends_at,omitempty"`
+	IsActive     bool       `gorm:"type:boolean;not null;default:true" json:"is_active"`
+	// The promotion applies to the whole cart when both scopes are empty.
+	ProductIDs []uint   `gorm:"type:jsonb;serializer:json" json:"product_ids"`
+	Categories []string `gorm:"type:jsonb;serializer:json" json:"categories"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&Promotion{})
+}
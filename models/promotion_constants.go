@@ -0,0 +1,6 @@
+package models
+
+const (
+	PromotionDiscountTypePercentage = "percentage"
+	PromotionDiscountTypeFixed      = "fixed"
+)
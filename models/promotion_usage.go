@@ -0,0 +1,17 @@
+package models
+
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
+type PromotionUsage struct {
+	ID             uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	PromotionID    uint         `gorm:"not null;index:idx_promotion_usage_promotion_user" json:"promotion_id"`
+	Promotion      *Promotion   `gorm:"foreignKey:PromotionID" json:"promotion,omitempty"`
+	UserID         uint         `gorm:"not null;index:idx_promotion_usage_promotion_user" json:"user_id"`
+	OrderID        uint         `gorm:"not null;index:idx_promotion_usage_order" json:"order_id"`
+	DiscountAmount money.Amount `gorm:"type:decimal(15,2);not null" json:"discount_amount"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&PromotionUsage{})
+}
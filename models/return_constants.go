@@ -0,0 +1,18 @@
+package models
+
+const (
+	ReturnStatusRequested = "requested"
+	ReturnStatusApproved  = "approved"
+	ReturnStatusRejected  = "rejected"
+	ReturnStatusCanceled  = "canceled"
+	ReturnStatusReceived  = "received"
+	ReturnStatusRefunded  = "refunded"
+)
+
+// ReturnStatusTransitions lists the statuses a return can move to from each
+// status. Rejected, canceled and refunded returns are closed.
+var ReturnStatusTransitions = map[string][]string{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected, ReturnStatusCanceled},
+	ReturnStatusApproved:  {ReturnStatusReceived},
+	ReturnStatusReceived:  {ReturnStatusRefunded, ReturnStatusRejected},
+}
@@ -0,0 +1,17 @@
+package models
+
+type ReturnItem struct {
+	ID              uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	ReturnRequestID uint       `gorm:"not null;index:idx_return_item_return_request" json:"return_request_id"`
+	OrderItemID     uint       `gorm:"not null;index:idx_return_item_order_item" json:"order_item_id"`
+	OrderItem       *OrderItem `gorm:"foreignKey:OrderItemID" json:"order_item,omitempty"`
+	Quantity        int        `gorm:"not null" json:"quantity"`
+	// AcceptedQuantity is set when the returned goods are inspected.
+	AcceptedQuantity *int   `gorm:"type:int" json:"accepted_quantity,omitempty"`
+	InspectionNote   string `gorm:"type:text" json:"inspection_note"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ReturnItem{})
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+type ReturnRequest struct {
+	ID uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	// Number is empty for returns requested before returns were numbered.
+	Number  string `gorm:"type:varchar(100);uniqueIndex" json:"number"`
+	OrderID uint   `gorm:"not null;index:idx_return_request_order" json:"order_id"`
+	Order   *Order `gorm:"foreignKey:OrderID" json:"order,omitempty"`
+	UserID  uint   `gorm:"not null;index:idx_return_request_user" json:"user_id"`
+	// Status is one of the ReturnStatus constants.
+	Status       string        `gorm:"type:varchar(20);not null;index:idx_return_request_status" json:"status"`
+	Reason       string        `gorm:"type:text;not null" json:"reason"`
+	AdminNote    string        `gorm:"type:text" json:"admin_note"`
+	CurrencyCode string        `gorm:"type:varchar(3);not null;default:'IDR'" json:"currency_code"`
+	RefundID     string        `gorm:"type:varchar(255);index:idx_return_request_refund" json:"refund_id,omitempty"`
+	RefundStatus string        `gorm:"type:varchar(20)" json:"refund_status,omitempty"`
+	RefundAmount money.Amount  `gorm:"type:decimal(15,2);not null;default:0" json:"refund_amount"`
+	RefundedAt   *time.Time    `gorm:"type:timestamptz" json:"refunded_at,omitempty"`
+	Items        []*ReturnItem `gorm:"foreignKey:ReturnRequestID" json:"items,omitempty"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ReturnRequest{})
+}
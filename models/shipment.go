@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+type Shipment struct {
+	ID             uint            `gorm:"primaryKey;autoIncrement" json:"id"`
+	OrderID        uint            `gorm:"not null;index:idx_shipment_order" json:"order_id"`
+	Order          *Order          `gorm:"foreignKey:OrderID" json:"order,omitempty"`
+	Carrier        string          `gorm:"type:varchar(50);not null" json:"carrier"`
+	TrackingNumber string          `gorm:"type:varchar(100);not null" json:"tracking_number"`
+	Status         string          `gorm:"type:varchar(30);not null;index:idx_shipment_status" json:"status"`
+	ShippedAt      time.Time       `gorm:"type:timestamptz;not null" json:"shipped_at"`
+	DeliveredAt    *time.Time      `gorm:"type:timestamptz" json:"delivered_at,omitempty"`
+	Items          []*ShipmentItem `gorm:"foreignKey:ShipmentID" json:"items,omitempty"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&Shipment{})
+}
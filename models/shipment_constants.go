@@ -0,0 +1,6 @@
+package models
+
+const (
+	ShipmentStatusInTransit = "in_transit"
+	ShipmentStatusDelivered = "delivered"
+)
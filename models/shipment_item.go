@@ -0,0 +1,14 @@
+package models
+
+type ShipmentItem struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	ShipmentID  uint       `gorm:"not null;index:idx_shipment_item_shipment" json:"shipment_id"`
+	OrderItemID uint       `gorm:"not null;index:idx_shipment_item_order_item" json:"order_item_id"`
+	OrderItem   *OrderItem `gorm:"foreignKey:OrderItemID" json:"order_item,omitempty"`
+	Quantity    int        `gorm:"not null" json:"quantity"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ShipmentItem{})
+}
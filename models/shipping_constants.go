@@ -0,0 +1,8 @@
+package models
+
+// How a shipping method's rate table is matched against an order.
+const (
+	ShippingRateTypeFlat   = "flat"
+	ShippingRateTypeWeight = "weight"
+	ShippingRateTypeRegion = "region"
+)
@@ -0,0 +1,17 @@
+package models
+
+type ShippingMethod struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	Code        string `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
+	Name        string `gorm:"type:varchar(100);not null" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	// RateType is one of the ShippingRateType constants.
+	RateType string          `gorm:"type:varchar(20);not null" json:"rate_type"`
+	IsActive bool            `gorm:"type:boolean;not null;default:true" json:"is_active"`
+	Rates    []*ShippingRate `gorm:"foreignKey:ShippingMethodID" json:"rates,omitempty"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ShippingMethod{})
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+
+// ShippingRate is one row of a shipping method's rate table, priced in the
+// store currency.
+type ShippingRate struct {
+	ID               uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	ShippingMethodID uint `gorm:"not null;index:idx_shipping_rate_method" json:"shipping_method_id"`
+	// Region is matched by region-based methods. An empty region is the
+	// fallback for regions without a rate of their own.
+	Region string `gorm:"type:varchar(100);not null;default:''" json:"region"`
+	// Weight-based methods match orders weighing at least MinWeightGrams and
+	// less than MaxWeightGrams. A nil MaxWeightGrams has no upper bound.
+	MinWeightGrams int          `gorm:"type:int;not null;default:0" json:"min_weight_grams"`
+	MaxWeightGrams *int         `gorm:"type:int" json:"max_weight_grams,omitempty"`
+	Price          money.Amount `gorm:"type:decimal(15,2);not null" json:"price"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&ShippingRate{})
+}
@@ -0,0 +1,21 @@
+package models
+
+type TaxRule struct {
+	ID   uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name string `gorm:"type:varchar(100);not null" json:"name"`
+	// Rate is a percentage, e.g. 11 for PPN.
+	Rate float64 `gorm:"type:decimal(5,2);not null" json:"rate"`
+	// IsInclusive is set when product prices already include the tax.
+	IsInclusive bool `gorm:"type:boolean;not null;default:false" json:"is_inclusive"`
+	// CurrencyCode limits the rule to orders in that currency. An empty code
+	// applies to every currency without a rule of its own. Only one active
+	// rule may exist per currency.
+	CurrencyCode     string   `gorm:"type:varchar(3);not null;default:'';uniqueIndex:idx_tax_rule_active_currency,where:is_active AND NOT is_deleted" json:"currency_code"`
+	ExemptCategories []string `gorm:"type:jsonb;serializer:json" json:"exempt_categories"`
+	IsActive         bool     `gorm:"type:boolean;not null;default:true" json:"is_active"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&TaxRule{})
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// WebhookDelivery is one event on its way to one subscription, together
+// with the outcome of the last attempt.
+type WebhookDelivery struct {
+	ID             uint                 `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubscriptionID uint                 `gorm:"not null;uniqueIndex:idx_webhook_delivery_event" json:"subscription_id"`
+	Subscription   *WebhookSubscription `gorm:"foreignKey:SubscriptionID" json:"subscription,omitempty"`
+	OutboxEventID  uint                 `gorm:"not null;uniqueIndex:idx_webhook_delivery_event" json:"outbox_event_id"`
+	OutboxEvent    *OutboxEvent         `gorm:"foreignKey:OutboxEventID" json:"outbox_event,omitempty"`
+	EventType      string               `gorm:"type:varchar(100);not null" json:"event_type"`
+	// Status is one of the WebhookDeliveryStatus constants.
+	Status         string     `gorm:"type:varchar(20);not null;index:idx_webhook_delivery_due" json:"status"`
+	Attempts       int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  time.Time  `gorm:"type:timestamptz;not null;index:idx_webhook_delivery_due" json:"next_attempt_at"`
+	LastAttemptAt  *time.Time `gorm:"type:timestamptz" json:"last_attempt_at,omitempty"`
+	ResponseStatus int        `gorm:"not null;default:0" json:"response_status"`
+	LastError      string     `gorm:"type:text" json:"last_error"`
+	DeliveredAt    *time.Time `gorm:"type:timestamptz" json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `gorm:"type:timestamptz;not null" json:"created_at"`
+}
+
+func init() {
+	RegisterModel(&WebhookDelivery{})
+}
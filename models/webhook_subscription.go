@@ -0,0 +1,18 @@
+package models
+
+// WebhookSubscription sends the domain events of the listed types to URL.
+type WebhookSubscription struct {
+	ID   uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name string `gorm:"type:varchar(100);not null" json:"name"`
+	URL  string `gorm:"type:varchar(2048);not null" json:"url"`
+	// Secret signs every delivery so the receiver can check where it came
+	// from.
+	Secret     string   `gorm:"type:varchar(255);not null" json:"-"`
+	EventTypes []string `gorm:"type:jsonb;serializer:json" json:"event_types"`
+	IsActive   bool     `gorm:"type:boolean;not null;default:true" json:"is_active"`
+	BaseModel
+}
+
+func init() {
+	RegisterModel(&WebhookSubscription{})
+}
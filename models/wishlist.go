@@ -0,0 +1,14 @@
+package models
+
+type Wishlist struct {
+	ID        uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	ProductID uint `gorm:"not null;index:idx_wishlist_product" json:"product_id"`
+	UserID    uint `gorm:"not null;index:idx_wishlist_user" json:"user_id"`
+	BaseModel
+	Product *Product `gorm:"foreignKey:ProductID" json:"product,omitempty"`
+	User    *User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func init() {
+	RegisterModel(&Wishlist{})
+}
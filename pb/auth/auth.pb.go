@@ -30,8 +30,10 @@ type RegisterRequest struct {
 	Email                string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password             string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	PasswordConfirmation string                 `protobuf:"bytes,4,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Guest cart whose lines are merged into the new account.
+	GuestCartToken string `protobuf:"bytes,5,opt,name=guest_cart_token,json=guestCartToken,proto3" json:"guest_cart_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RegisterRequest) Reset() {
@@ -92,6 +94,13 @@ func (x *RegisterRequest) GetPasswordConfirmation() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetGuestCartToken() string {
+	if x != nil {
+		return x.GuestCartToken
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -137,11 +146,13 @@ func (x *RegisterResponse) GetBase() *common.BaseResponse {
 }
 
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Guest cart whose lines are merged into the user's cart.
+	GuestCartToken string `protobuf:"bytes,3,opt,name=guest_cart_token,json=guestCartToken,proto3" json:"guest_cart_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
@@ -188,6 +199,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetGuestCartToken() string {
+	if x != nil {
+		return x.GuestCartToken
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -548,18 +566,20 @@ var File_auth_auth_proto protoreflect.FileDescriptor
 
 const file_auth_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x0fauth/auth.proto\x12\x04auth\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x01\n" +
+	"\x0fauth/auth.proto\x12\x04auth\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
 	"\x0fRegisterRequest\x12'\n" +
 	"\tfull_name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\x12\"\n" +
 	"\x05email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x05\x18\xff\x01`\x01R\x05email\x12%\n" +
 	"\bpassword\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\fR\bpassword\x12>\n" +
-	"\x15password_confirmation\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\fR\x14passwordConfirmation\"<\n" +
+	"\x15password_confirmation\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\fR\x14passwordConfirmation\x12(\n" +
+	"\x10guest_cart_token\x18\x05 \x01(\tR\x0eguestCartToken\"<\n" +
 	"\x10RegisterResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"Y\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x83\x01\n" +
 	"\fLoginRequest\x12\"\n" +
 	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x05\x18\xff\x01`\x01R\x05email\x12%\n" +
-	"\bpassword\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\fR\bpassword\"O\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\fR\bpassword\x12(\n" +
+	"\x10guest_cart_token\x18\x03 \x01(\tR\x0eguestCartToken\"O\n" +
 	"\rLoginResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"\x0f\n" +
@@ -11,6 +11,7 @@ import (
 	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,11 +25,16 @@ const (
 )
 
 type AddToCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// A product already in the cart keeps the price it was first added at as
+	// its reference, unless this is set to take the current price instead.
+	// The reference only drives the price change warning, the cart and the
+	// order are always charged the current price.
+	AcceptCurrentPrice bool `protobuf:"varint,3,opt,name=accept_current_price,json=acceptCurrentPrice,proto3" json:"accept_current_price,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *AddToCartRequest) Reset() {
@@ -75,10 +81,22 @@ func (x *AddToCartRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *AddToCartRequest) GetAcceptCurrentPrice() bool {
+	if x != nil {
+		return x.AcceptCurrentPrice
+	}
+	return false
+}
+
 type AddToCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BaseResponse  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
-	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	BaseResponse *common.BaseResponse   `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	Id           uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The price the line was added at.
+	PriceAtAdd *common.Money `protobuf:"bytes,3,opt,name=price_at_add,json=priceAtAdd,proto3" json:"price_at_add,omitempty"`
+	// Set when price_at_add is not the current price. The line is still
+	// charged the current price.
+	PriceChanged  bool `protobuf:"varint,4,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,8 +145,24 @@ func (x *AddToCartResponse) GetId() uint64 {
 	return 0
 }
 
+func (x *AddToCartResponse) GetPriceAtAdd() *common.Money {
+	if x != nil {
+		return x.PriceAtAdd
+	}
+	return nil
+}
+
+func (x *AddToCartResponse) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
 type ListCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -163,21 +197,87 @@ func (*ListCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_cart_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *ListCartRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ListCartResponseItemWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartResponseItemWarning) Reset() {
+	*x = ListCartResponseItemWarning{}
+	mi := &file_cart_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartResponseItemWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponseItemWarning) ProtoMessage() {}
+
+func (x *ListCartResponseItemWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponseItemWarning.ProtoReflect.Descriptor instead.
+func (*ListCartResponseItemWarning) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCartResponseItemWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCartResponseItemWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type ListCartResponseItem struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CartId          uint64                 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
 	ProductId       uint64                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	ProductName     string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
 	ProductImageUrl string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
-	ProductPrice    float64                `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity        int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Unset when the product has no price in the requested currency.
+	ProductPrice *common.Money `protobuf:"bytes,11,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	Quantity     int32         `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// The price the line was added at, always in the store currency. It is
+	// only compared with the current price to warn about changes.
+	PriceAtAdd    *common.Money                  `protobuf:"bytes,12,opt,name=price_at_add,json=priceAtAdd,proto3" json:"price_at_add,omitempty"`
+	Subtotal      *common.Money                  `protobuf:"bytes,13,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	IsAvailable   bool                           `protobuf:"varint,9,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Warnings      []*ListCartResponseItemWarning `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCartResponseItem) Reset() {
 	*x = ListCartResponseItem{}
-	mi := &file_cart_cart_proto_msgTypes[3]
+	mi := &file_cart_cart_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +289,7 @@ func (x *ListCartResponseItem) String() string {
 func (*ListCartResponseItem) ProtoMessage() {}
 
 func (x *ListCartResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[3]
+	mi := &file_cart_cart_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +302,7 @@ func (x *ListCartResponseItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartResponseItem.ProtoReflect.Descriptor instead.
 func (*ListCartResponseItem) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{3}
+	return file_cart_cart_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListCartResponseItem) GetCartId() uint64 {
@@ -233,11 +333,11 @@ func (x *ListCartResponseItem) GetProductImageUrl() string {
 	return ""
 }
 
-func (x *ListCartResponseItem) GetProductPrice() float64 {
+func (x *ListCartResponseItem) GetProductPrice() *common.Money {
 	if x != nil {
 		return x.ProductPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *ListCartResponseItem) GetQuantity() int32 {
@@ -247,17 +347,48 @@ func (x *ListCartResponseItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *ListCartResponseItem) GetPriceAtAdd() *common.Money {
+	if x != nil {
+		return x.PriceAtAdd
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetSubtotal() *common.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *ListCartResponseItem) GetWarnings() []*ListCartResponseItemWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type ListCartResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	BaseResponse  *common.BaseResponse    `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	Items         []*ListCartResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Total         *common.Money           `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	TotalQuantity int32                   `protobuf:"varint,4,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	HasWarnings   bool                    `protobuf:"varint,5,opt,name=has_warnings,json=hasWarnings,proto3" json:"has_warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCartResponse) Reset() {
 	*x = ListCartResponse{}
-	mi := &file_cart_cart_proto_msgTypes[4]
+	mi := &file_cart_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +400,7 @@ func (x *ListCartResponse) String() string {
 func (*ListCartResponse) ProtoMessage() {}
 
 func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[4]
+	mi := &file_cart_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +413,7 @@ func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
 func (*ListCartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{4}
+	return file_cart_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCartResponse) GetBaseResponse() *common.BaseResponse {
@@ -299,6 +430,27 @@ func (x *ListCartResponse) GetItems() []*ListCartResponseItem {
 	return nil
 }
 
+func (x *ListCartResponse) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *ListCartResponse) GetHasWarnings() bool {
+	if x != nil {
+		return x.HasWarnings
+	}
+	return false
+}
+
 type DeleteCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CartId        uint64                 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
@@ -308,7 +460,7 @@ type DeleteCartRequest struct {
 
 func (x *DeleteCartRequest) Reset() {
 	*x = DeleteCartRequest{}
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +472,7 @@ func (x *DeleteCartRequest) String() string {
 func (*DeleteCartRequest) ProtoMessage() {}
 
 func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +485,7 @@ func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteCartRequest) GetCartId() uint64 {
@@ -352,7 +504,7 @@ type DeleteCartResponse struct {
 
 func (x *DeleteCartResponse) Reset() {
 	*x = DeleteCartResponse{}
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +516,7 @@ func (x *DeleteCartResponse) String() string {
 func (*DeleteCartResponse) ProtoMessage() {}
 
 func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +529,7 @@ func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteCartResponse) GetBaseResponse() *common.BaseResponse {
@@ -388,16 +540,17 @@ func (x *DeleteCartResponse) GetBaseResponse() *common.BaseResponse {
 }
 
 type UpdateCartQtyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        uint64                 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	NewQuantity   int32                  `protobuf:"varint,2,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CartId uint64                 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	// 0 removes the line from the cart.
+	NewQuantity   int32 `protobuf:"varint,2,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCartQtyRequest) Reset() {
 	*x = UpdateCartQtyRequest{}
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +562,7 @@ func (x *UpdateCartQtyRequest) String() string {
 func (*UpdateCartQtyRequest) ProtoMessage() {}
 
 func (x *UpdateCartQtyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +575,7 @@ func (x *UpdateCartQtyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartQtyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCartQtyRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{7}
+	return file_cart_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateCartQtyRequest) GetCartId() uint64 {
@@ -448,7 +601,7 @@ type UpdateCartQtyResponse struct {
 
 func (x *UpdateCartQtyResponse) Reset() {
 	*x = UpdateCartQtyResponse{}
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +613,7 @@ func (x *UpdateCartQtyResponse) String() string {
 func (*UpdateCartQtyResponse) ProtoMessage() {}
 
 func (x *UpdateCartQtyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +626,7 @@ func (x *UpdateCartQtyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartQtyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCartQtyResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{8}
+	return file_cart_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCartQtyResponse) GetBaseResponse() *common.BaseResponse {
@@ -483,30 +636,374 @@ func (x *UpdateCartQtyResponse) GetBaseResponse() *common.BaseResponse {
 	return nil
 }
 
+type CreateGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartRequest) Reset() {
+	*x = CreateGuestCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartRequest) ProtoMessage() {}
+
+func (x *CreateGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+type CreateGuestCartResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	BaseResponse *common.BaseResponse   `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	// Send as the x-cart-token metadata header on cart calls made without logging in.
+	CartToken     string                 `protobuf:"bytes,2,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateGuestCartResponse) GetBaseResponse() *common.BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+func (x *CreateGuestCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *CreateGuestCartResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BulkUpdateCartRequestItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The new quantity of the line; 0 removes it from the cart.
+	Quantity int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Same as on AddToCartRequest.
+	AcceptCurrentPrice bool `protobuf:"varint,3,opt,name=accept_current_price,json=acceptCurrentPrice,proto3" json:"accept_current_price,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartRequestItem) Reset() {
+	*x = BulkUpdateCartRequestItem{}
+	mi := &file_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartRequestItem) ProtoMessage() {}
+
+func (x *BulkUpdateCartRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartRequestItem.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartRequestItem) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkUpdateCartRequestItem) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *BulkUpdateCartRequestItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BulkUpdateCartRequestItem) GetAcceptCurrentPrice() bool {
+	if x != nil {
+		return x.AcceptCurrentPrice
+	}
+	return false
+}
+
+type BulkUpdateCartRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Items         []*BulkUpdateCartRequestItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartRequest) Reset() {
+	*x = BulkUpdateCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartRequest) ProtoMessage() {}
+
+func (x *BulkUpdateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BulkUpdateCartRequest) GetItems() []*BulkUpdateCartRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BulkUpdateCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseResponse  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartResponse) Reset() {
+	*x = BulkUpdateCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartResponse) ProtoMessage() {}
+
+func (x *BulkUpdateCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BulkUpdateCartResponse) GetBaseResponse() *common.BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{15}
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseResponse  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearCartResponse) GetBaseResponse() *common.BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
 var File_cart_cart_proto protoreflect.FileDescriptor
 
 const file_cart_cart_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"_\n" +
+	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x01\n" +
 	"\x10AddToCartRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x12#\n" +
-	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"^\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x120\n" +
+	"\x14accept_current_price\x18\x03 \x01(\bR\x12acceptCurrentPrice\"\xb4\x01\n" +
 	"\x11AddToCartResponse\x129\n" +
 	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\x04R\x02id\"\x11\n" +
-	"\x0fListCartRequest\"\xde\x01\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12/\n" +
+	"\fprice_at_add\x18\x03 \x01(\v2\r.common.MoneyR\n" +
+	"priceAtAdd\x12#\n" +
+	"\rprice_changed\x18\x04 \x01(\bR\fpriceChanged\"O\n" +
+	"\x0fListCartRequest\x12<\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"K\n" +
+	"\x1bListCartResponseItemWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xbd\x03\n" +
 	"\x14ListCartResponseItem\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\x04R\x06cartId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\x04R\tproductId\x12!\n" +
 	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12*\n" +
-	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x12#\n" +
-	"\rproduct_price\x18\x05 \x01(\x01R\fproductPrice\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\"\x7f\n" +
+	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x122\n" +
+	"\rproduct_price\x18\v \x01(\v2\r.common.MoneyR\fproductPrice\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12/\n" +
+	"\fprice_at_add\x18\f \x01(\v2\r.common.MoneyR\n" +
+	"priceAtAdd\x12)\n" +
+	"\bsubtotal\x18\r \x01(\v2\r.common.MoneyR\bsubtotal\x12!\n" +
+	"\fis_available\x18\t \x01(\bR\visAvailable\x12=\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\v2!.cart.ListCartResponseItemWarningR\bwarningsJ\x04\b\x05\x10\x06J\x04\b\a\x10\bJ\x04\b\b\x10\t\"\xf4\x01\n" +
 	"\x10ListCartResponse\x129\n" +
 	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse\x120\n" +
-	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\"5\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\x12#\n" +
+	"\x05total\x18\x06 \x01(\v2\r.common.MoneyR\x05total\x12%\n" +
+	"\x0etotal_quantity\x18\x04 \x01(\x05R\rtotalQuantity\x12!\n" +
+	"\fhas_warnings\x18\x05 \x01(\bR\vhasWarningsJ\x04\b\x03\x10\x04\"5\n" +
 	"\x11DeleteCartRequest\x12 \n" +
 	"\acart_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06cartId\"O\n" +
 	"\x12DeleteCartResponse\x129\n" +
@@ -515,13 +1012,36 @@ const file_cart_cart_proto_rawDesc = "" +
 	"\acart_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06cartId\x12*\n" +
 	"\fnew_quantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\vnewQuantity\"R\n" +
 	"\x15UpdateCartQtyResponse\x129\n" +
-	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse2\x91\x02\n" +
+	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse\"\x18\n" +
+	"\x16CreateGuestCartRequest\"\xae\x01\n" +
+	"\x17CreateGuestCartResponse\x129\n" +
+	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x02 \x01(\tR\tcartToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9a\x01\n" +
+	"\x19BulkUpdateCartRequestItem\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\x120\n" +
+	"\x14accept_current_price\x18\x03 \x01(\bR\x12acceptCurrentPrice\"Z\n" +
+	"\x15BulkUpdateCartRequest\x12A\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.cart.BulkUpdateCartRequestItemB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x05items\"S\n" +
+	"\x16BulkUpdateCartResponse\x129\n" +
+	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse\"\x12\n" +
+	"\x10ClearCartRequest\"N\n" +
+	"\x11ClearCartResponse\x129\n" +
+	"\rbase_response\x18\x01 \x01(\v2\x14.common.BaseResponseR\fbaseResponse2\xec\x03\n" +
 	"\vCartService\x12<\n" +
 	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x17.cart.AddToCartResponse\x129\n" +
 	"\bListCart\x12\x15.cart.ListCartRequest\x1a\x16.cart.ListCartResponse\x12?\n" +
 	"\n" +
 	"DeleteCart\x12\x17.cart.DeleteCartRequest\x1a\x18.cart.DeleteCartResponse\x12H\n" +
-	"\rUpdateCartQty\x12\x1a.cart.UpdateCartQtyRequest\x1a\x1b.cart.UpdateCartQtyResponseBu\n" +
+	"\rUpdateCartQty\x12\x1a.cart.UpdateCartQtyRequest\x1a\x1b.cart.UpdateCartQtyResponse\x12N\n" +
+	"\x0fCreateGuestCart\x12\x1c.cart.CreateGuestCartRequest\x1a\x1d.cart.CreateGuestCartResponse\x12K\n" +
+	"\x0eBulkUpdateCart\x12\x1b.cart.BulkUpdateCartRequest\x1a\x1c.cart.BulkUpdateCartResponse\x12<\n" +
+	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponseBu\n" +
 	"\bcom.cartB\tCartProtoP\x01Z.github.com/fahrillrizal/ecommerce-grpc/pb/cart\xa2\x02\x03CXX\xaa\x02\x04Cart\xca\x02\x04Cart\xe2\x02\x10Cart\\GPBMetadata\xea\x02\x04Cartb\x06proto3"
 
 var (
@@ -536,38 +1056,65 @@ func file_cart_cart_proto_rawDescGZIP() []byte {
 	return file_cart_cart_proto_rawDescData
 }
 
-var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_cart_cart_proto_goTypes = []any{
-	(*AddToCartRequest)(nil),      // 0: cart.AddToCartRequest
-	(*AddToCartResponse)(nil),     // 1: cart.AddToCartResponse
-	(*ListCartRequest)(nil),       // 2: cart.ListCartRequest
-	(*ListCartResponseItem)(nil),  // 3: cart.ListCartResponseItem
-	(*ListCartResponse)(nil),      // 4: cart.ListCartResponse
-	(*DeleteCartRequest)(nil),     // 5: cart.DeleteCartRequest
-	(*DeleteCartResponse)(nil),    // 6: cart.DeleteCartResponse
-	(*UpdateCartQtyRequest)(nil),  // 7: cart.UpdateCartQtyRequest
-	(*UpdateCartQtyResponse)(nil), // 8: cart.UpdateCartQtyResponse
-	(*common.BaseResponse)(nil),   // 9: common.BaseResponse
+	(*AddToCartRequest)(nil),            // 0: cart.AddToCartRequest
+	(*AddToCartResponse)(nil),           // 1: cart.AddToCartResponse
+	(*ListCartRequest)(nil),             // 2: cart.ListCartRequest
+	(*ListCartResponseItemWarning)(nil), // 3: cart.ListCartResponseItemWarning
+	(*ListCartResponseItem)(nil),        // 4: cart.ListCartResponseItem
+	(*ListCartResponse)(nil),            // 5: cart.ListCartResponse
+	(*DeleteCartRequest)(nil),           // 6: cart.DeleteCartRequest
+	(*DeleteCartResponse)(nil),          // 7: cart.DeleteCartResponse
+	(*UpdateCartQtyRequest)(nil),        // 8: cart.UpdateCartQtyRequest
+	(*UpdateCartQtyResponse)(nil),       // 9: cart.UpdateCartQtyResponse
+	(*CreateGuestCartRequest)(nil),      // 10: cart.CreateGuestCartRequest
+	(*CreateGuestCartResponse)(nil),     // 11: cart.CreateGuestCartResponse
+	(*BulkUpdateCartRequestItem)(nil),   // 12: cart.BulkUpdateCartRequestItem
+	(*BulkUpdateCartRequest)(nil),       // 13: cart.BulkUpdateCartRequest
+	(*BulkUpdateCartResponse)(nil),      // 14: cart.BulkUpdateCartResponse
+	(*ClearCartRequest)(nil),            // 15: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),           // 16: cart.ClearCartResponse
+	(*common.BaseResponse)(nil),         // 17: common.BaseResponse
+	(*common.Money)(nil),                // 18: common.Money
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
 }
 var file_cart_cart_proto_depIdxs = []int32{
-	9, // 0: cart.AddToCartResponse.base_response:type_name -> common.BaseResponse
-	9, // 1: cart.ListCartResponse.base_response:type_name -> common.BaseResponse
-	3, // 2: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
-	9, // 3: cart.DeleteCartResponse.base_response:type_name -> common.BaseResponse
-	9, // 4: cart.UpdateCartQtyResponse.base_response:type_name -> common.BaseResponse
-	0, // 5: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
-	2, // 6: cart.CartService.ListCart:input_type -> cart.ListCartRequest
-	5, // 7: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
-	7, // 8: cart.CartService.UpdateCartQty:input_type -> cart.UpdateCartQtyRequest
-	1, // 9: cart.CartService.AddToCart:output_type -> cart.AddToCartResponse
-	4, // 10: cart.CartService.ListCart:output_type -> cart.ListCartResponse
-	6, // 11: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
-	8, // 12: cart.CartService.UpdateCartQty:output_type -> cart.UpdateCartQtyResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	17, // 0: cart.AddToCartResponse.base_response:type_name -> common.BaseResponse
+	18, // 1: cart.AddToCartResponse.price_at_add:type_name -> common.Money
+	18, // 2: cart.ListCartResponseItem.product_price:type_name -> common.Money
+	18, // 3: cart.ListCartResponseItem.price_at_add:type_name -> common.Money
+	18, // 4: cart.ListCartResponseItem.subtotal:type_name -> common.Money
+	3,  // 5: cart.ListCartResponseItem.warnings:type_name -> cart.ListCartResponseItemWarning
+	17, // 6: cart.ListCartResponse.base_response:type_name -> common.BaseResponse
+	4,  // 7: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
+	18, // 8: cart.ListCartResponse.total:type_name -> common.Money
+	17, // 9: cart.DeleteCartResponse.base_response:type_name -> common.BaseResponse
+	17, // 10: cart.UpdateCartQtyResponse.base_response:type_name -> common.BaseResponse
+	17, // 11: cart.CreateGuestCartResponse.base_response:type_name -> common.BaseResponse
+	19, // 12: cart.CreateGuestCartResponse.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 13: cart.BulkUpdateCartRequest.items:type_name -> cart.BulkUpdateCartRequestItem
+	17, // 14: cart.BulkUpdateCartResponse.base_response:type_name -> common.BaseResponse
+	17, // 15: cart.ClearCartResponse.base_response:type_name -> common.BaseResponse
+	0,  // 16: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
+	2,  // 17: cart.CartService.ListCart:input_type -> cart.ListCartRequest
+	6,  // 18: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
+	8,  // 19: cart.CartService.UpdateCartQty:input_type -> cart.UpdateCartQtyRequest
+	10, // 20: cart.CartService.CreateGuestCart:input_type -> cart.CreateGuestCartRequest
+	13, // 21: cart.CartService.BulkUpdateCart:input_type -> cart.BulkUpdateCartRequest
+	15, // 22: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	1,  // 23: cart.CartService.AddToCart:output_type -> cart.AddToCartResponse
+	5,  // 24: cart.CartService.ListCart:output_type -> cart.ListCartResponse
+	7,  // 25: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
+	9,  // 26: cart.CartService.UpdateCartQty:output_type -> cart.UpdateCartQtyResponse
+	11, // 27: cart.CartService.CreateGuestCart:output_type -> cart.CreateGuestCartResponse
+	14, // 28: cart.CartService.BulkUpdateCart:output_type -> cart.BulkUpdateCartResponse
+	16, // 29: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
+	23, // [23:30] is the sub-list for method output_type
+	16, // [16:23] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_cart_cart_proto_init() }
@@ -581,7 +1128,7 @@ func file_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_cart_proto_rawDesc), len(file_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_AddToCart_FullMethodName     = "/cart.CartService/AddToCart"
-	CartService_ListCart_FullMethodName      = "/cart.CartService/ListCart"
-	CartService_DeleteCart_FullMethodName    = "/cart.CartService/DeleteCart"
-	CartService_UpdateCartQty_FullMethodName = "/cart.CartService/UpdateCartQty"
+	CartService_AddToCart_FullMethodName       = "/cart.CartService/AddToCart"
+	CartService_ListCart_FullMethodName        = "/cart.CartService/ListCart"
+	CartService_DeleteCart_FullMethodName      = "/cart.CartService/DeleteCart"
+	CartService_UpdateCartQty_FullMethodName   = "/cart.CartService/UpdateCartQty"
+	CartService_CreateGuestCart_FullMethodName = "/cart.CartService/CreateGuestCart"
+	CartService_BulkUpdateCart_FullMethodName  = "/cart.CartService/BulkUpdateCart"
+	CartService_ClearCart_FullMethodName       = "/cart.CartService/ClearCart"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -33,6 +36,9 @@ type CartServiceClient interface {
 	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
 	DeleteCart(ctx context.Context, in *DeleteCartRequest, opts ...grpc.CallOption) (*DeleteCartResponse, error)
 	UpdateCartQty(ctx context.Context, in *UpdateCartQtyRequest, opts ...grpc.CallOption) (*UpdateCartQtyResponse, error)
+	CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
+	BulkUpdateCart(ctx context.Context, in *BulkUpdateCartRequest, opts ...grpc.CallOption) (*BulkUpdateCartResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
 }
 
 type cartServiceClient struct {
@@ -83,6 +89,36 @@ func (c *cartServiceClient) UpdateCartQty(ctx context.Context, in *UpdateCartQty
 	return out, nil
 }
 
+func (c *cartServiceClient) CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, CartService_CreateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) BulkUpdateCart(ctx context.Context, in *BulkUpdateCartRequest, opts ...grpc.CallOption) (*BulkUpdateCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateCartResponse)
+	err := c.cc.Invoke(ctx, CartService_BulkUpdateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -91,6 +127,9 @@ type CartServiceServer interface {
 	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
 	DeleteCart(context.Context, *DeleteCartRequest) (*DeleteCartResponse, error)
 	UpdateCartQty(context.Context, *UpdateCartQtyRequest) (*UpdateCartQtyResponse, error)
+	CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error)
+	BulkUpdateCart(context.Context, *BulkUpdateCartRequest) (*BulkUpdateCartResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -113,6 +152,15 @@ func (UnimplementedCartServiceServer) DeleteCart(context.Context, *DeleteCartReq
 func (UnimplementedCartServiceServer) UpdateCartQty(context.Context, *UpdateCartQtyRequest) (*UpdateCartQtyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartQty not implemented")
 }
+func (UnimplementedCartServiceServer) CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedCartServiceServer) BulkUpdateCart(context.Context, *BulkUpdateCartRequest) (*BulkUpdateCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateCart not implemented")
+}
+func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +254,60 @@ func _CartService_UpdateCartQty_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreateGuestCart(ctx, req.(*CreateGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_BulkUpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).BulkUpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_BulkUpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).BulkUpdateCart(ctx, req.(*BulkUpdateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +331,18 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCartQty",
 			Handler:    _CartService_UpdateCartQty_Handler,
 		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _CartService_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "BulkUpdateCart",
+			Handler:    _CartService_BulkUpdateCart_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _CartService_ClearCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart/cart.proto",
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: common/money.proto
+
+package common
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Money is an exact amount in a currency's minor units, e.g. 1050 for 10.50.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 4217 code. Empty means the store currency.
+	CurrencyCode  string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	MinorUnits    int64  `protobuf:"varint,2,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_common_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_common_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_common_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetMinorUnits() int64 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+var File_common_money_proto protoreflect.FileDescriptor
+
+const file_common_money_proto_rawDesc = "" +
+	"\n" +
+	"\x12common/money.proto\x12\x06common\x1a\x1bbuf/validate/validate.proto\"l\n" +
+	"\x05Money\x129\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x14\xbaH\x11r\x0f2\r^([A-Z]{3})?$R\fcurrencyCode\x12(\n" +
+	"\vminor_units\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"minorUnitsB\x82\x01\n" +
+	"\n" +
+	"com.commonB\n" +
+	"MoneyProtoP\x01Z0github.com/fahrillrizal/ecommerce-grpc/pb/common\xa2\x02\x03CXX\xaa\x02\x06Common\xca\x02\x06Common\xe2\x02\x12Common\\GPBMetadata\xea\x02\x06Commonb\x06proto3"
+
+var (
+	file_common_money_proto_rawDescOnce sync.Once
+	file_common_money_proto_rawDescData []byte
+)
+
+func file_common_money_proto_rawDescGZIP() []byte {
+	file_common_money_proto_rawDescOnce.Do(func() {
+		file_common_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_money_proto_rawDesc), len(file_common_money_proto_rawDesc)))
+	})
+	return file_common_money_proto_rawDescData
+}
+
+var file_common_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_common_money_proto_goTypes = []any{
+	(*Money)(nil), // 0: common.Money
+}
+var file_common_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_common_money_proto_init() }
+func file_common_money_proto_init() {
+	if File_common_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_money_proto_rawDesc), len(file_common_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_money_proto_goTypes,
+		DependencyIndexes: file_common_money_proto_depIdxs,
+		MessageInfos:      file_common_money_proto_msgTypes,
+	}.Build()
+	File_common_money_proto = out.File
+	file_common_money_proto_goTypes = nil
+	file_common_money_proto_depIdxs = nil
+}
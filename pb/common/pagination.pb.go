@@ -74,12 +74,19 @@ func (x *PaginationSortRequest) GetDirection() string {
 }
 
 type PaginationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Sort          *PaginationSortRequest `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PerPage     int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Sort        *PaginationSortRequest `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_cursor of the previous page. Pages after it instead of by
+	// current_page, which stays fast however deep the page is. Must be used
+	// with the same sort it was issued for.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Leaves out the total item and page counts, which cost a full count of
+	// the list.
+	SkipTotalCount bool `protobuf:"varint,5,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PaginationRequest) Reset() {
@@ -133,14 +140,32 @@ func (x *PaginationRequest) GetSort() *PaginationSortRequest {
 	return nil
 }
 
+func (x *PaginationRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PaginationRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
 type PaginationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage    int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=total_page_count,json=totalPageCount,proto3" json:"total_page_count,omitempty"`
-	PerPage        int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	TotalItemCount int32                  `protobuf:"varint,4,opt,name=total_item_count,json=totalItemCount,proto3" json:"total_item_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 when the page was picked by cursor.
+	CurrentPage    int32 `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPageCount int32 `protobuf:"varint,2,opt,name=total_page_count,json=totalPageCount,proto3" json:"total_page_count,omitempty"`
+	PerPage        int32 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	TotalItemCount int64 `protobuf:"varint,4,opt,name=total_item_count,json=totalItemCount,proto3" json:"total_item_count,omitempty"`
+	// Cursor of the next page. Empty on the last page, and when the list is
+	// sorted by a field that can be empty.
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaginationResponse) Reset() {
@@ -194,13 +219,20 @@ func (x *PaginationResponse) GetPerPage() int32 {
 	return 0
 }
 
-func (x *PaginationResponse) GetTotalItemCount() int32 {
+func (x *PaginationResponse) GetTotalItemCount() int64 {
 	if x != nil {
 		return x.TotalItemCount
 	}
 	return 0
 }
 
+func (x *PaginationResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_common_pagination_proto protoreflect.FileDescriptor
 
 const file_common_pagination_proto_rawDesc = "" +
@@ -208,16 +240,20 @@ const file_common_pagination_proto_rawDesc = "" +
 	"\x17common/pagination.proto\x12\x06common\"K\n" +
 	"\x15PaginationSortRequest\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1c\n" +
-	"\tdirection\x18\x02 \x01(\tR\tdirection\"\x84\x01\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"\xc6\x01\n" +
 	"\x11PaginationRequest\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\x05R\aperPage\x121\n" +
-	"\x04sort\x18\x03 \x01(\v2\x1d.common.PaginationSortRequestR\x04sort\"\xa6\x01\n" +
+	"\x04sort\x18\x03 \x01(\v2\x1d.common.PaginationSortRequestR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12(\n" +
+	"\x10skip_total_count\x18\x05 \x01(\bR\x0eskipTotalCount\"\xc7\x01\n" +
 	"\x12PaginationResponse\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12(\n" +
 	"\x10total_page_count\x18\x02 \x01(\x05R\x0etotalPageCount\x12\x19\n" +
 	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12(\n" +
-	"\x10total_item_count\x18\x04 \x01(\x05R\x0etotalItemCountB\x87\x01\n" +
+	"\x10total_item_count\x18\x04 \x01(\x03R\x0etotalItemCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursorB\x87\x01\n" +
 	"\n" +
 	"com.commonB\x0fPaginationProtoP\x01Z0github.com/fahrillrizal/ecommerce-grpc/pb/common\xa2\x02\x03CXX\xaa\x02\x06Common\xca\x02\x06Common\xe2\x02\x12Common\\GPBMetadata\xea\x02\x06Commonb\x06proto3"
 
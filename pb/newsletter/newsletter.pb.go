@@ -11,6 +11,7 @@ import (
 	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,9 +25,13 @@ const (
 )
 
 type SubscribeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty subscribes to every topic.
+	Topics []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Language of the emails, id when empty.
+	Locale        string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,6 +80,20 @@ func (x *SubscribeRequest) GetName() string {
 	return ""
 }
 
+func (x *SubscribeRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SubscribeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -119,20 +138,1920 @@ func (x *SubscribeResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
-var File_newsletter_newsletter_proto protoreflect.FileDescriptor
+type ConfirmSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the confirmation email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_newsletter_newsletter_proto_rawDesc = "" +
-	"\n" +
-	"\x1bnewsletter/newsletter.proto\x12\n" +
-	"newsletter\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"V\n" +
-	"\x10SubscribeRequest\x12\"\n" +
-	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x05\x18\xff\x01`\x01R\x05email\x12\x1e\n" +
-	"\x04name\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\"=\n" +
-	"\x11SubscribeResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2]\n" +
+func (x *ConfirmSubscriptionRequest) Reset() {
+	*x = ConfirmSubscriptionRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSubscriptionRequest) ProtoMessage() {}
+
+func (x *ConfirmSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfirmSubscriptionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmSubscriptionResponse) Reset() {
+	*x = ConfirmSubscriptionResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSubscriptionResponse) ProtoMessage() {}
+
+func (x *ConfirmSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfirmSubscriptionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the unsubscribe link of any newsletter email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnsubscribeResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type GetPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the unsubscribe link of any newsletter email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPreferencesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetPreferencesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Base   *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Topics []string               `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Every topic that can be chosen.
+	AvailableTopics []string `protobuf:"bytes,6,rep,name=available_topics,json=availableTopics,proto3" json:"available_topics,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPreferencesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPreferencesResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetPreferencesResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPreferencesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetPreferencesResponse) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *GetPreferencesResponse) GetAvailableTopics() []string {
+	if x != nil {
+		return x.AvailableTopics
+	}
+	return nil
+}
+
+type UpdatePreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the unsubscribe link of any newsletter email.
+	Token         string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Topics        []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePreferencesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type UpdatePreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesResponse) Reset() {
+	*x = UpdatePreferencesResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesResponse) ProtoMessage() {}
+
+func (x *UpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePreferencesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListSubscribersRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Status     string                    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Topic      string                    `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Matches part of the email address.
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersRequest) Reset() {
+	*x = ListSubscribersRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersRequest) ProtoMessage() {}
+
+func (x *ListSubscribersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSubscribersRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSubscribersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscribersRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ListSubscribersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Subscriber struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Topics         []string               `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	Locale         string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	ConfirmedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	UnsubscribedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=unsubscribed_at,json=unsubscribedAt,proto3" json:"unsubscribed_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscriber) Reset() {
+	*x = Subscriber{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscriber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscriber) ProtoMessage() {}
+
+func (x *Subscriber) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscriber.ProtoReflect.Descriptor instead.
+func (*Subscriber) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Subscriber) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscriber) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Subscriber) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscriber) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscriber) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Subscriber) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Subscriber) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *Subscriber) GetUnsubscribedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnsubscribedAt
+	}
+	return nil
+}
+
+func (x *Subscriber) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSubscribersResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*Subscriber              `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersResponse) Reset() {
+	*x = ListSubscribersResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersResponse) ProtoMessage() {}
+
+func (x *ListSubscribersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSubscribersResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponse) GetData() []*Subscriber {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportSubscribersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same filters as the list. Pagination is ignored, every matching
+	// subscriber is exported.
+	Filter        *ListSubscribersRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        string                  `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscribersRequest) Reset() {
+	*x = ExportSubscribersRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscribersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscribersRequest) ProtoMessage() {}
+
+func (x *ExportSubscribersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscribersRequest.ProtoReflect.Descriptor instead.
+func (*ExportSubscribersRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportSubscribersRequest) GetFilter() *ListSubscribersRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportSubscribersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportSubscribersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first message only.
+	FileName    string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The next part of the file.
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSubscribersResponse) Reset() {
+	*x = ExportSubscribersResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSubscribersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscribersResponse) ProtoMessage() {}
+
+func (x *ExportSubscribersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscribersResponse.ProtoReflect.Descriptor instead.
+func (*ExportSubscribersResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportSubscribersResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportSubscribersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportSubscribersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type CampaignContent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subject string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Go templates rendered for every recipient with .Name, .Email,
+	// .PreferencesURL and .UnsubscribeURL. The HTML body goes inside the
+	// store's email layout, which adds the unsubscribe footer.
+	HtmlBody string `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	TextBody string `protobuf:"bytes,4,opt,name=text_body,json=textBody,proto3" json:"text_body,omitempty"`
+	// Only subscribers of the topic get the campaign. Empty sends to all.
+	Topic string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Only subscribers reading the language get the campaign. Empty sends to
+	// all.
+	Locale        string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignContent) Reset() {
+	*x = CampaignContent{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignContent) ProtoMessage() {}
+
+func (x *CampaignContent) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignContent.ProtoReflect.Descriptor instead.
+func (*CampaignContent) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CampaignContent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CampaignContent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CampaignContent) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *CampaignContent) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *CampaignContent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *CampaignContent) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type CreateCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       *CampaignContent       `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCampaignRequest) GetContent() *CampaignContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreateCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignResponse) Reset() {
+	*x = CreateCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignResponse) ProtoMessage() {}
+
+func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCampaignResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       *CampaignContent       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCampaignRequest) Reset() {
+	*x = UpdateCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCampaignRequest) ProtoMessage() {}
+
+func (x *UpdateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCampaignRequest) GetContent() *CampaignContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UpdateCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCampaignResponse) Reset() {
+	*x = UpdateCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCampaignResponse) ProtoMessage() {}
+
+func (x *UpdateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type SendTestCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestCampaignRequest) Reset() {
+	*x = SendTestCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestCampaignRequest) ProtoMessage() {}
+
+func (x *SendTestCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestCampaignRequest.ProtoReflect.Descriptor instead.
+func (*SendTestCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendTestCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SendTestCampaignRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SendTestCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestCampaignResponse) Reset() {
+	*x = SendTestCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestCampaignResponse) ProtoMessage() {}
+
+func (x *SendTestCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestCampaignResponse.ProtoReflect.Descriptor instead.
+func (*SendTestCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SendTestCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ScheduleCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When sending starts. Empty starts right away.
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCampaignRequest) Reset() {
+	*x = ScheduleCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCampaignRequest) ProtoMessage() {}
+
+func (x *ScheduleCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduleCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleCampaignRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+type ScheduleCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCampaignResponse) Reset() {
+	*x = ScheduleCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCampaignResponse) ProtoMessage() {}
+
+func (x *ScheduleCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduleCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type CancelCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignRequest) Reset() {
+	*x = CancelCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignRequest) ProtoMessage() {}
+
+func (x *CancelCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CancelCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignResponse) Reset() {
+	*x = CancelCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignResponse) ProtoMessage() {}
+
+func (x *CancelCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CancelCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListCampaignsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Status        string                    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsRequest) Reset() {
+	*x = ListCampaignsRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsRequest) ProtoMessage() {}
+
+func (x *ListCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCampaignsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListCampaignsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CampaignStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Subscribers the campaign was addressed to when it started.
+	Recipients int64 `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	Pending    int64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Sent       int64 `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	Failed     int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Recipients who unsubscribed before their email went out.
+	Unsubscribed  int64 `protobuf:"varint,5,opt,name=unsubscribed,proto3" json:"unsubscribed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignStats) Reset() {
+	*x = CampaignStats{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStats) ProtoMessage() {}
+
+func (x *CampaignStats) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStats.ProtoReflect.Descriptor instead.
+func (*CampaignStats) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CampaignStats) GetRecipients() int64 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetUnsubscribed() int64 {
+	if x != nil {
+		return x.Unsubscribed
+	}
+	return 0
+}
+
+type Campaign struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody      string                 `protobuf:"bytes,4,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	TextBody      string                 `protobuf:"bytes,5,opt,name=text_body,json=textBody,proto3" json:"text_body,omitempty"`
+	Topic         string                 `protobuf:"bytes,6,opt,name=topic,proto3" json:"topic,omitempty"`
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Stats         *CampaignStats         `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campaign) ProtoMessage() {}
+
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Campaign) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Campaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Campaign) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Campaign) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *Campaign) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *Campaign) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Campaign) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Campaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Campaign) GetStats() *CampaignStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Campaign) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Campaign) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Campaign) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Campaign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCampaignsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*Campaign                `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsResponse) Reset() {
+	*x = ListCampaignsResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsResponse) ProtoMessage() {}
+
+func (x *ListCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCampaignsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponse) GetData() []*Campaign {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DetailCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailCampaignRequest) Reset() {
+	*x = DetailCampaignRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCampaignRequest) ProtoMessage() {}
+
+func (x *DetailCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCampaignRequest.ProtoReflect.Descriptor instead.
+func (*DetailCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DetailCampaignRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DetailCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Campaign              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailCampaignResponse) Reset() {
+	*x = DetailCampaignResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCampaignResponse) ProtoMessage() {}
+
+func (x *DetailCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCampaignResponse.ProtoReflect.Descriptor instead.
+func (*DetailCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DetailCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetData() *Campaign {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_newsletter_newsletter_proto protoreflect.FileDescriptor
+
+const file_newsletter_newsletter_proto_rawDesc = "" +
+	"\n" +
+	"\x1bnewsletter/newsletter.proto\x12\n" +
+	"newsletter\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\x10SubscribeRequest\x12\"\n" +
+	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x05\x18\xff\x01`\x01R\x05email\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12&\n" +
+	"\x06topics\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x182R\x06topics\x12'\n" +
+	"\x06locale\x18\x04 \x01(\tB\x0f\xbaH\fr\n" +
+	"R\x00R\x02idR\x02enR\x06locale\"=\n" +
+	"\x11SubscribeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\">\n" +
+	"\x1aConfirmSubscriptionRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\"G\n" +
+	"\x1bConfirmSubscriptionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"6\n" +
+	"\x12UnsubscribeRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\"?\n" +
+	"\x13UnsubscribeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"9\n" +
+	"\x15GetPreferencesRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\"\xc7\x01\n" +
+	"\x16GetPreferencesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06topics\x18\x05 \x03(\tR\x06topics\x12)\n" +
+	"\x10available_topics\x18\x06 \x03(\tR\x0favailableTopics\"f\n" +
+	"\x18UpdatePreferencesRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\x12(\n" +
+	"\x06topics\x18\x02 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\b\x01\"\x06r\x04\x10\x01\x182R\x06topics\"E\n" +
+	"\x19UpdatePreferencesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xd6\x01\n" +
+	"\x16ListSubscribersRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12B\n" +
+	"\x06status\x18\x02 \x01(\tB*\xbaH'r%R\x00R\apendingR\n" +
+	"subscribedR\funsubscribedR\x06status\x12\x1d\n" +
+	"\x05topic\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x05topic\x12\x1e\n" +
+	"\x05email\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05email\"\xcd\x02\n" +
+	"\n" +
+	"Subscriber\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06topics\x18\x05 \x03(\tR\x06topics\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x12=\n" +
+	"\fconfirmed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x12C\n" +
+	"\x0funsubscribed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0eunsubscribedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xab\x01\n" +
+	"\x17ListSubscribersResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12*\n" +
+	"\x04data\x18\x03 \x03(\v2\x16.newsletter.SubscriberR\x04data\"\x80\x01\n" +
+	"\x18ExportSubscribersRequest\x12:\n" +
+	"\x06filter\x18\x01 \x01(\v2\".newsletter.ListSubscribersRequestR\x06filter\x12(\n" +
+	"\x06format\x18\x02 \x01(\tB\x10\xbaH\rr\vR\x03csvR\x04xlsxR\x06format\"q\n" +
+	"\x19ExportSubscribersResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\xf3\x01\n" +
+	"\x0fCampaignContent\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12$\n" +
+	"\asubject\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\asubject\x12(\n" +
+	"\thtml_body\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\xc0\x9a\fR\bhtmlBody\x12(\n" +
+	"\ttext_body\x18\x04 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\xa0\x8d\x06R\btextBody\x12\x1d\n" +
+	"\x05topic\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x182R\x05topic\x12'\n" +
+	"\x06locale\x18\x06 \x01(\tB\x0f\xbaH\fr\n" +
+	"R\x00R\x02idR\x02enR\x06locale\"V\n" +
+	"\x15CreateCampaignRequest\x12=\n" +
+	"\acontent\x18\x01 \x01(\v2\x1b.newsletter.CampaignContentB\x06\xbaH\x03\xc8\x01\x01R\acontent\"R\n" +
+	"\x16CreateCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"o\n" +
+	"\x15UpdateCampaignRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12=\n" +
+	"\acontent\x18\x02 \x01(\v2\x1b.newsletter.CampaignContentB\x06\xbaH\x03\xc8\x01\x01R\acontent\"B\n" +
+	"\x16UpdateCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"V\n" +
+	"\x17SendTestCampaignRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\"\n" +
+	"\x05email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x05\x18\xff\x01`\x01R\x05email\"D\n" +
+	"\x18SendTestCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"g\n" +
+	"\x17ScheduleCampaignRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x123\n" +
+	"\asend_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\"D\n" +
+	"\x18ScheduleCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"0\n" +
+	"\x15CancelCampaignRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"B\n" +
+	"\x16CancelCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x9d\x01\n" +
+	"\x14ListCampaignsRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12J\n" +
+	"\x06status\x18\x02 \x01(\tB2\xbaH/r-R\x00R\x05draftR\tscheduledR\asendingR\x04sentR\bcanceledR\x06status\"\x99\x01\n" +
+	"\rCampaignStats\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x01(\x03R\n" +
+	"recipients\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x03R\apending\x12\x12\n" +
+	"\x04sent\x18\x03 \x01(\x03R\x04sent\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12\"\n" +
+	"\funsubscribed\x18\x05 \x01(\x03R\funsubscribed\"\xeb\x03\n" +
+	"\bCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1b\n" +
+	"\thtml_body\x18\x04 \x01(\tR\bhtmlBody\x12\x1b\n" +
+	"\ttext_body\x18\x05 \x01(\tR\btextBody\x12\x14\n" +
+	"\x05topic\x18\x06 \x01(\tR\x05topic\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12/\n" +
+	"\x05stats\x18\t \x01(\v2\x19.newsletter.CampaignStatsR\x05stats\x12=\n" +
+	"\fscheduled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa7\x01\n" +
+	"\x15ListCampaignsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12(\n" +
+	"\x04data\x18\x03 \x03(\v2\x14.newsletter.CampaignR\x04data\"0\n" +
+	"\x15DetailCampaignRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"l\n" +
+	"\x16DetailCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12(\n" +
+	"\x04data\x18\x02 \x01(\v2\x14.newsletter.CampaignR\x04data2\x88\n" +
+	"\n" +
 	"\x11NewsletterService\x12H\n" +
-	"\tSubscribe\x12\x1c.newsletter.SubscribeRequest\x1a\x1d.newsletter.SubscribeResponseB\x9f\x01\n" +
+	"\tSubscribe\x12\x1c.newsletter.SubscribeRequest\x1a\x1d.newsletter.SubscribeResponse\x12f\n" +
+	"\x13ConfirmSubscription\x12&.newsletter.ConfirmSubscriptionRequest\x1a'.newsletter.ConfirmSubscriptionResponse\x12N\n" +
+	"\vUnsubscribe\x12\x1e.newsletter.UnsubscribeRequest\x1a\x1f.newsletter.UnsubscribeResponse\x12W\n" +
+	"\x0eGetPreferences\x12!.newsletter.GetPreferencesRequest\x1a\".newsletter.GetPreferencesResponse\x12`\n" +
+	"\x11UpdatePreferences\x12$.newsletter.UpdatePreferencesRequest\x1a%.newsletter.UpdatePreferencesResponse\x12Z\n" +
+	"\x0fListSubscribers\x12\".newsletter.ListSubscribersRequest\x1a#.newsletter.ListSubscribersResponse\x12b\n" +
+	"\x11ExportSubscribers\x12$.newsletter.ExportSubscribersRequest\x1a%.newsletter.ExportSubscribersResponse0\x01\x12W\n" +
+	"\x0eCreateCampaign\x12!.newsletter.CreateCampaignRequest\x1a\".newsletter.CreateCampaignResponse\x12W\n" +
+	"\x0eUpdateCampaign\x12!.newsletter.UpdateCampaignRequest\x1a\".newsletter.UpdateCampaignResponse\x12]\n" +
+	"\x10SendTestCampaign\x12#.newsletter.SendTestCampaignRequest\x1a$.newsletter.SendTestCampaignResponse\x12]\n" +
+	"\x10ScheduleCampaign\x12#.newsletter.ScheduleCampaignRequest\x1a$.newsletter.ScheduleCampaignResponse\x12W\n" +
+	"\x0eCancelCampaign\x12!.newsletter.CancelCampaignRequest\x1a\".newsletter.CancelCampaignResponse\x12T\n" +
+	"\rListCampaigns\x12 .newsletter.ListCampaignsRequest\x1a!.newsletter.ListCampaignsResponse\x12W\n" +
+	"\x0eDetailCampaign\x12!.newsletter.DetailCampaignRequest\x1a\".newsletter.DetailCampaignResponseB\x9f\x01\n" +
 	"\x0ecom.newsletterB\x0fNewsletterProtoP\x01Z4github.com/fahrillrizal/ecommerce-grpc/pb/newsletter\xa2\x02\x03NXX\xaa\x02\n" +
 	"Newsletter\xca\x02\n" +
 	"Newsletter\xe2\x02\x16Newsletter\\GPBMetadata\xea\x02\n" +
@@ -150,21 +2069,111 @@ func file_newsletter_newsletter_proto_rawDescGZIP() []byte {
 	return file_newsletter_newsletter_proto_rawDescData
 }
 
-var file_newsletter_newsletter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_newsletter_newsletter_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_newsletter_newsletter_proto_goTypes = []any{
-	(*SubscribeRequest)(nil),    // 0: newsletter.SubscribeRequest
-	(*SubscribeResponse)(nil),   // 1: newsletter.SubscribeResponse
-	(*common.BaseResponse)(nil), // 2: common.BaseResponse
+	(*SubscribeRequest)(nil),            // 0: newsletter.SubscribeRequest
+	(*SubscribeResponse)(nil),           // 1: newsletter.SubscribeResponse
+	(*ConfirmSubscriptionRequest)(nil),  // 2: newsletter.ConfirmSubscriptionRequest
+	(*ConfirmSubscriptionResponse)(nil), // 3: newsletter.ConfirmSubscriptionResponse
+	(*UnsubscribeRequest)(nil),          // 4: newsletter.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),         // 5: newsletter.UnsubscribeResponse
+	(*GetPreferencesRequest)(nil),       // 6: newsletter.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),      // 7: newsletter.GetPreferencesResponse
+	(*UpdatePreferencesRequest)(nil),    // 8: newsletter.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil),   // 9: newsletter.UpdatePreferencesResponse
+	(*ListSubscribersRequest)(nil),      // 10: newsletter.ListSubscribersRequest
+	(*Subscriber)(nil),                  // 11: newsletter.Subscriber
+	(*ListSubscribersResponse)(nil),     // 12: newsletter.ListSubscribersResponse
+	(*ExportSubscribersRequest)(nil),    // 13: newsletter.ExportSubscribersRequest
+	(*ExportSubscribersResponse)(nil),   // 14: newsletter.ExportSubscribersResponse
+	(*CampaignContent)(nil),             // 15: newsletter.CampaignContent
+	(*CreateCampaignRequest)(nil),       // 16: newsletter.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),      // 17: newsletter.CreateCampaignResponse
+	(*UpdateCampaignRequest)(nil),       // 18: newsletter.UpdateCampaignRequest
+	(*UpdateCampaignResponse)(nil),      // 19: newsletter.UpdateCampaignResponse
+	(*SendTestCampaignRequest)(nil),     // 20: newsletter.SendTestCampaignRequest
+	(*SendTestCampaignResponse)(nil),    // 21: newsletter.SendTestCampaignResponse
+	(*ScheduleCampaignRequest)(nil),     // 22: newsletter.ScheduleCampaignRequest
+	(*ScheduleCampaignResponse)(nil),    // 23: newsletter.ScheduleCampaignResponse
+	(*CancelCampaignRequest)(nil),       // 24: newsletter.CancelCampaignRequest
+	(*CancelCampaignResponse)(nil),      // 25: newsletter.CancelCampaignResponse
+	(*ListCampaignsRequest)(nil),        // 26: newsletter.ListCampaignsRequest
+	(*CampaignStats)(nil),               // 27: newsletter.CampaignStats
+	(*Campaign)(nil),                    // 28: newsletter.Campaign
+	(*ListCampaignsResponse)(nil),       // 29: newsletter.ListCampaignsResponse
+	(*DetailCampaignRequest)(nil),       // 30: newsletter.DetailCampaignRequest
+	(*DetailCampaignResponse)(nil),      // 31: newsletter.DetailCampaignResponse
+	(*common.BaseResponse)(nil),         // 32: common.BaseResponse
+	(*common.PaginationRequest)(nil),    // 33: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),       // 34: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),   // 35: common.PaginationResponse
 }
 var file_newsletter_newsletter_proto_depIdxs = []int32{
-	2, // 0: newsletter.SubscribeResponse.base:type_name -> common.BaseResponse
-	0, // 1: newsletter.NewsletterService.Subscribe:input_type -> newsletter.SubscribeRequest
-	1, // 2: newsletter.NewsletterService.Subscribe:output_type -> newsletter.SubscribeResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	32, // 0: newsletter.SubscribeResponse.base:type_name -> common.BaseResponse
+	32, // 1: newsletter.ConfirmSubscriptionResponse.base:type_name -> common.BaseResponse
+	32, // 2: newsletter.UnsubscribeResponse.base:type_name -> common.BaseResponse
+	32, // 3: newsletter.GetPreferencesResponse.base:type_name -> common.BaseResponse
+	32, // 4: newsletter.UpdatePreferencesResponse.base:type_name -> common.BaseResponse
+	33, // 5: newsletter.ListSubscribersRequest.pagination:type_name -> common.PaginationRequest
+	34, // 6: newsletter.Subscriber.confirmed_at:type_name -> google.protobuf.Timestamp
+	34, // 7: newsletter.Subscriber.unsubscribed_at:type_name -> google.protobuf.Timestamp
+	34, // 8: newsletter.Subscriber.created_at:type_name -> google.protobuf.Timestamp
+	32, // 9: newsletter.ListSubscribersResponse.base:type_name -> common.BaseResponse
+	35, // 10: newsletter.ListSubscribersResponse.pagination:type_name -> common.PaginationResponse
+	11, // 11: newsletter.ListSubscribersResponse.data:type_name -> newsletter.Subscriber
+	10, // 12: newsletter.ExportSubscribersRequest.filter:type_name -> newsletter.ListSubscribersRequest
+	15, // 13: newsletter.CreateCampaignRequest.content:type_name -> newsletter.CampaignContent
+	32, // 14: newsletter.CreateCampaignResponse.base:type_name -> common.BaseResponse
+	15, // 15: newsletter.UpdateCampaignRequest.content:type_name -> newsletter.CampaignContent
+	32, // 16: newsletter.UpdateCampaignResponse.base:type_name -> common.BaseResponse
+	32, // 17: newsletter.SendTestCampaignResponse.base:type_name -> common.BaseResponse
+	34, // 18: newsletter.ScheduleCampaignRequest.send_at:type_name -> google.protobuf.Timestamp
+	32, // 19: newsletter.ScheduleCampaignResponse.base:type_name -> common.BaseResponse
+	32, // 20: newsletter.CancelCampaignResponse.base:type_name -> common.BaseResponse
+	33, // 21: newsletter.ListCampaignsRequest.pagination:type_name -> common.PaginationRequest
+	27, // 22: newsletter.Campaign.stats:type_name -> newsletter.CampaignStats
+	34, // 23: newsletter.Campaign.scheduled_at:type_name -> google.protobuf.Timestamp
+	34, // 24: newsletter.Campaign.started_at:type_name -> google.protobuf.Timestamp
+	34, // 25: newsletter.Campaign.finished_at:type_name -> google.protobuf.Timestamp
+	34, // 26: newsletter.Campaign.created_at:type_name -> google.protobuf.Timestamp
+	32, // 27: newsletter.ListCampaignsResponse.base:type_name -> common.BaseResponse
+	35, // 28: newsletter.ListCampaignsResponse.pagination:type_name -> common.PaginationResponse
+	28, // 29: newsletter.ListCampaignsResponse.data:type_name -> newsletter.Campaign
+	32, // 30: newsletter.DetailCampaignResponse.base:type_name -> common.BaseResponse
+	28, // 31: newsletter.DetailCampaignResponse.data:type_name -> newsletter.Campaign
+	0,  // 32: newsletter.NewsletterService.Subscribe:input_type -> newsletter.SubscribeRequest
+	2,  // 33: newsletter.NewsletterService.ConfirmSubscription:input_type -> newsletter.ConfirmSubscriptionRequest
+	4,  // 34: newsletter.NewsletterService.Unsubscribe:input_type -> newsletter.UnsubscribeRequest
+	6,  // 35: newsletter.NewsletterService.GetPreferences:input_type -> newsletter.GetPreferencesRequest
+	8,  // 36: newsletter.NewsletterService.UpdatePreferences:input_type -> newsletter.UpdatePreferencesRequest
+	10, // 37: newsletter.NewsletterService.ListSubscribers:input_type -> newsletter.ListSubscribersRequest
+	13, // 38: newsletter.NewsletterService.ExportSubscribers:input_type -> newsletter.ExportSubscribersRequest
+	16, // 39: newsletter.NewsletterService.CreateCampaign:input_type -> newsletter.CreateCampaignRequest
+	18, // 40: newsletter.NewsletterService.UpdateCampaign:input_type -> newsletter.UpdateCampaignRequest
+	20, // 41: newsletter.NewsletterService.SendTestCampaign:input_type -> newsletter.SendTestCampaignRequest
+	22, // 42: newsletter.NewsletterService.ScheduleCampaign:input_type -> newsletter.ScheduleCampaignRequest
+	24, // 43: newsletter.NewsletterService.CancelCampaign:input_type -> newsletter.CancelCampaignRequest
+	26, // 44: newsletter.NewsletterService.ListCampaigns:input_type -> newsletter.ListCampaignsRequest
+	30, // 45: newsletter.NewsletterService.DetailCampaign:input_type -> newsletter.DetailCampaignRequest
+	1,  // 46: newsletter.NewsletterService.Subscribe:output_type -> newsletter.SubscribeResponse
+	3,  // 47: newsletter.NewsletterService.ConfirmSubscription:output_type -> newsletter.ConfirmSubscriptionResponse
+	5,  // 48: newsletter.NewsletterService.Unsubscribe:output_type -> newsletter.UnsubscribeResponse
+	7,  // 49: newsletter.NewsletterService.GetPreferences:output_type -> newsletter.GetPreferencesResponse
+	9,  // 50: newsletter.NewsletterService.UpdatePreferences:output_type -> newsletter.UpdatePreferencesResponse
+	12, // 51: newsletter.NewsletterService.ListSubscribers:output_type -> newsletter.ListSubscribersResponse
+	14, // 52: newsletter.NewsletterService.ExportSubscribers:output_type -> newsletter.ExportSubscribersResponse
+	17, // 53: newsletter.NewsletterService.CreateCampaign:output_type -> newsletter.CreateCampaignResponse
+	19, // 54: newsletter.NewsletterService.UpdateCampaign:output_type -> newsletter.UpdateCampaignResponse
+	21, // 55: newsletter.NewsletterService.SendTestCampaign:output_type -> newsletter.SendTestCampaignResponse
+	23, // 56: newsletter.NewsletterService.ScheduleCampaign:output_type -> newsletter.ScheduleCampaignResponse
+	25, // 57: newsletter.NewsletterService.CancelCampaign:output_type -> newsletter.CancelCampaignResponse
+	29, // 58: newsletter.NewsletterService.ListCampaigns:output_type -> newsletter.ListCampaignsResponse
+	31, // 59: newsletter.NewsletterService.DetailCampaign:output_type -> newsletter.DetailCampaignResponse
+	46, // [46:60] is the sub-list for method output_type
+	32, // [32:46] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_newsletter_newsletter_proto_init() }
@@ -178,7 +2187,7 @@ func file_newsletter_newsletter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_newsletter_newsletter_proto_rawDesc), len(file_newsletter_newsletter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
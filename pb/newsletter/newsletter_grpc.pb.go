@@ -19,7 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NewsletterService_Subscribe_FullMethodName = "/newsletter.NewsletterService/Subscribe"
+	NewsletterService_Subscribe_FullMethodName           = "/newsletter.NewsletterService/Subscribe"
+	NewsletterService_ConfirmSubscription_FullMethodName = "/newsletter.NewsletterService/ConfirmSubscription"
+	NewsletterService_Unsubscribe_FullMethodName         = "/newsletter.NewsletterService/Unsubscribe"
+	NewsletterService_GetPreferences_FullMethodName      = "/newsletter.NewsletterService/GetPreferences"
+	NewsletterService_UpdatePreferences_FullMethodName   = "/newsletter.NewsletterService/UpdatePreferences"
+	NewsletterService_ListSubscribers_FullMethodName     = "/newsletter.NewsletterService/ListSubscribers"
+	NewsletterService_ExportSubscribers_FullMethodName   = "/newsletter.NewsletterService/ExportSubscribers"
+	NewsletterService_CreateCampaign_FullMethodName      = "/newsletter.NewsletterService/CreateCampaign"
+	NewsletterService_UpdateCampaign_FullMethodName      = "/newsletter.NewsletterService/UpdateCampaign"
+	NewsletterService_SendTestCampaign_FullMethodName    = "/newsletter.NewsletterService/SendTestCampaign"
+	NewsletterService_ScheduleCampaign_FullMethodName    = "/newsletter.NewsletterService/ScheduleCampaign"
+	NewsletterService_CancelCampaign_FullMethodName      = "/newsletter.NewsletterService/CancelCampaign"
+	NewsletterService_ListCampaigns_FullMethodName       = "/newsletter.NewsletterService/ListCampaigns"
+	NewsletterService_DetailCampaign_FullMethodName      = "/newsletter.NewsletterService/DetailCampaign"
 )
 
 // NewsletterServiceClient is the client API for NewsletterService service.
@@ -27,6 +40,19 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NewsletterServiceClient interface {
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmSubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	ExportSubscribers(ctx context.Context, in *ExportSubscribersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSubscribersResponse], error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	UpdateCampaign(ctx context.Context, in *UpdateCampaignRequest, opts ...grpc.CallOption) (*UpdateCampaignResponse, error)
+	SendTestCampaign(ctx context.Context, in *SendTestCampaignRequest, opts ...grpc.CallOption) (*SendTestCampaignResponse, error)
+	ScheduleCampaign(ctx context.Context, in *ScheduleCampaignRequest, opts ...grpc.CallOption) (*ScheduleCampaignResponse, error)
+	CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	DetailCampaign(ctx context.Context, in *DetailCampaignRequest, opts ...grpc.CallOption) (*DetailCampaignResponse, error)
 }
 
 type newsletterServiceClient struct {
@@ -47,11 +73,163 @@ func (c *newsletterServiceClient) Subscribe(ctx context.Context, in *SubscribeRe
 	return out, nil
 }
 
+func (c *newsletterServiceClient) ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ConfirmSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ListSubscribers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ExportSubscribers(ctx context.Context, in *ExportSubscribersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSubscribersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NewsletterService_ServiceDesc.Streams[0], NewsletterService_ExportSubscribers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSubscribersRequest, ExportSubscribersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NewsletterService_ExportSubscribersClient = grpc.ServerStreamingClient[ExportSubscribersResponse]
+
+func (c *newsletterServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) UpdateCampaign(ctx context.Context, in *UpdateCampaignRequest, opts ...grpc.CallOption) (*UpdateCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_UpdateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) SendTestCampaign(ctx context.Context, in *SendTestCampaignRequest, opts ...grpc.CallOption) (*SendTestCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTestCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_SendTestCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ScheduleCampaign(ctx context.Context, in *ScheduleCampaignRequest, opts ...grpc.CallOption) (*ScheduleCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ScheduleCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) DetailCampaign(ctx context.Context, in *DetailCampaignRequest, opts ...grpc.CallOption) (*DetailCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_DetailCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NewsletterServiceServer is the server API for NewsletterService service.
 // All implementations must embed UnimplementedNewsletterServiceServer
 // for forward compatibility.
 type NewsletterServiceServer interface {
 	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*ConfirmSubscriptionResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	ExportSubscribers(*ExportSubscribersRequest, grpc.ServerStreamingServer[ExportSubscribersResponse]) error
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	UpdateCampaign(context.Context, *UpdateCampaignRequest) (*UpdateCampaignResponse, error)
+	SendTestCampaign(context.Context, *SendTestCampaignRequest) (*SendTestCampaignResponse, error)
+	ScheduleCampaign(context.Context, *ScheduleCampaignRequest) (*ScheduleCampaignResponse, error)
+	CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error)
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error)
+	DetailCampaign(context.Context, *DetailCampaignRequest) (*DetailCampaignResponse, error)
 	mustEmbedUnimplementedNewsletterServiceServer()
 }
 
@@ -65,6 +243,45 @@ type UnimplementedNewsletterServiceServer struct{}
 func (UnimplementedNewsletterServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedNewsletterServiceServer) ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*ConfirmSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSubscription not implemented")
+}
+func (UnimplementedNewsletterServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedNewsletterServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNewsletterServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribers not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ExportSubscribers(*ExportSubscribersRequest, grpc.ServerStreamingServer[ExportSubscribersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSubscribers not implemented")
+}
+func (UnimplementedNewsletterServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedNewsletterServiceServer) UpdateCampaign(context.Context, *UpdateCampaignRequest) (*UpdateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCampaign not implemented")
+}
+func (UnimplementedNewsletterServiceServer) SendTestCampaign(context.Context, *SendTestCampaignRequest) (*SendTestCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTestCampaign not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ScheduleCampaign(context.Context, *ScheduleCampaignRequest) (*ScheduleCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCampaign not implemented")
+}
+func (UnimplementedNewsletterServiceServer) CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedNewsletterServiceServer) DetailCampaign(context.Context, *DetailCampaignRequest) (*DetailCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailCampaign not implemented")
+}
 func (UnimplementedNewsletterServiceServer) mustEmbedUnimplementedNewsletterServiceServer() {}
 func (UnimplementedNewsletterServiceServer) testEmbeddedByValue()                           {}
 
@@ -104,6 +321,233 @@ func _NewsletterService_Subscribe_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsletterService_ConfirmSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ConfirmSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ConfirmSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ConfirmSubscription(ctx, req.(*ConfirmSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ListSubscribers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ExportSubscribers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSubscribersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NewsletterServiceServer).ExportSubscribers(m, &grpc.GenericServerStream[ExportSubscribersRequest, ExportSubscribersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NewsletterService_ExportSubscribersServer = grpc.ServerStreamingServer[ExportSubscribersResponse]
+
+func _NewsletterService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_UpdateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).UpdateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_UpdateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).UpdateCampaign(ctx, req.(*UpdateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_SendTestCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).SendTestCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_SendTestCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).SendTestCampaign(ctx, req.(*SendTestCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ScheduleCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ScheduleCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ScheduleCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ScheduleCampaign(ctx, req.(*ScheduleCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).CancelCampaign(ctx, req.(*CancelCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_DetailCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).DetailCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_DetailCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).DetailCampaign(ctx, req.(*DetailCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NewsletterService_ServiceDesc is the grpc.ServiceDesc for NewsletterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +559,61 @@ var NewsletterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Subscribe",
 			Handler:    _NewsletterService_Subscribe_Handler,
 		},
+		{
+			MethodName: "ConfirmSubscription",
+			Handler:    _NewsletterService_ConfirmSubscription_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NewsletterService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NewsletterService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _NewsletterService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _NewsletterService_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _NewsletterService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "UpdateCampaign",
+			Handler:    _NewsletterService_UpdateCampaign_Handler,
+		},
+		{
+			MethodName: "SendTestCampaign",
+			Handler:    _NewsletterService_SendTestCampaign_Handler,
+		},
+		{
+			MethodName: "ScheduleCampaign",
+			Handler:    _NewsletterService_ScheduleCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _NewsletterService_CancelCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _NewsletterService_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "DetailCampaign",
+			Handler:    _NewsletterService_DetailCampaign_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSubscribers",
+			Handler:       _NewsletterService_ExportSubscribers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "newsletter/newsletter.proto",
 }
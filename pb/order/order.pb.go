@@ -77,14 +77,22 @@ func (x *CreateOrderRequestProductItem) GetQuantity() int64 {
 }
 
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	FullName      string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Address       string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	PhoneNumber   string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Notes         string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	Products      []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState           `protogen:"open.v1"`
+	FullName    string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Address     string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Notes       string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Products    []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	CouponCode  string                           `protobuf:"bytes,6,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	// Defaults to the store currency.
+	CurrencyCode string `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Code of an option from QuoteShipping. Required once any shipping
+	// method is active.
+	ShippingMethodCode string `protobuf:"bytes,8,opt,name=shipping_method_code,json=shippingMethodCode,proto3" json:"shipping_method_code,omitempty"`
+	// Region of the address, used to pick the shipping rate.
+	ShippingRegion string `protobuf:"bytes,9,opt,name=shipping_region,json=shippingRegion,proto3" json:"shipping_region,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
@@ -152,6 +160,34 @@ func (x *CreateOrderRequest) GetProducts() []*CreateOrderRequestProductItem {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingMethodCode() string {
+	if x != nil {
+		return x.ShippingMethodCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingRegion() string {
+	if x != nil {
+		return x.ShippingRegion
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -205,8 +241,22 @@ func (x *CreateOrderResponse) GetOrderId() string {
 }
 
 type ListOrderAdminRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sortable by created_at, updated_at, number, order_status_code, total
+	// and user_full_name. Defaults to created_at desc.
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Matches any of the statuses.
+	StatusCodes []string               `protobuf:"bytes,2,rep,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive.
+	CreatedTo *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// Limits the list to orders in the currency of the amounts.
+	MinTotal *common.Money `protobuf:"bytes,5,opt,name=min_total,json=minTotal,proto3" json:"min_total,omitempty"`
+	MaxTotal *common.Money `protobuf:"bytes,6,opt,name=max_total,json=maxTotal,proto3" json:"max_total,omitempty"`
+	// Part of the customer's email or full name.
+	Customer      string `protobuf:"bytes,7,opt,name=customer,proto3" json:"customer,omitempty"`
+	NumberPrefix  string `protobuf:"bytes,8,opt,name=number_prefix,json=numberPrefix,proto3" json:"number_prefix,omitempty"`
+	PaymentMethod string `protobuf:"bytes,9,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -248,11 +298,67 @@ func (x *ListOrderAdminRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *ListOrderAdminRequest) GetStatusCodes() []string {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *ListOrderAdminRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListOrderAdminRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListOrderAdminRequest) GetMinTotal() *common.Money {
+	if x != nil {
+		return x.MinTotal
+	}
+	return nil
+}
+
+func (x *ListOrderAdminRequest) GetMaxTotal() *common.Money {
+	if x != nil {
+		return x.MaxTotal
+	}
+	return nil
+}
+
+func (x *ListOrderAdminRequest) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
+func (x *ListOrderAdminRequest) GetNumberPrefix() string {
+	if x != nil {
+		return x.NumberPrefix
+	}
+	return ""
+}
+
+func (x *ListOrderAdminRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
 type ListOrderAdminResponseItemProduct struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -302,11 +408,11 @@ func (x *ListOrderAdminResponseItemProduct) GetName() string {
 	return ""
 }
 
-func (x *ListOrderAdminResponseItemProduct) GetPrice() float64 {
+func (x *ListOrderAdminResponseItemProduct) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *ListOrderAdminResponseItemProduct) GetQuantity() int64 {
@@ -322,7 +428,7 @@ type ListOrderAdminResponseItem struct {
 	Number        string                               `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
 	Customer      string                               `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
 	StatusCode    string                               `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Total         float64                              `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	Total         *common.Money                        `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
 	CreatedAt     *timestamppb.Timestamp               `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Products      []*ListOrderAdminResponseItemProduct `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -387,11 +493,11 @@ func (x *ListOrderAdminResponseItem) GetStatusCode() string {
 	return ""
 }
 
-func (x *ListOrderAdminResponseItem) GetTotal() float64 {
+func (x *ListOrderAdminResponseItem) GetTotal() *common.Money {
 	if x != nil {
 		return x.Total
 	}
-	return 0
+	return nil
 }
 
 func (x *ListOrderAdminResponseItem) GetCreatedAt() *timestamppb.Timestamp {
@@ -469,8 +575,13 @@ func (x *ListOrderAdminResponse) GetOrders() []*ListOrderAdminResponseItem {
 }
 
 type ListOrderRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sortable like ListOrderAdmin.
+	Pagination  *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	StatusCodes []string                  `protobuf:"bytes,2,rep,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
+	CreatedFrom *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -512,6 +623,27 @@ func (x *ListOrderRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *ListOrderRequest) GetStatusCodes() []string {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *ListOrderRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListOrderRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
 type ListOrderResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -578,7 +710,7 @@ type ListOrderResponseItem struct {
 	Number           string                          `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
 	Customer         string                          `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
 	StatusCode       string                          `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Total            float64                         `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	Total            *common.Money                   `protobuf:"bytes,9,opt,name=total,proto3" json:"total,omitempty"`
 	CreatedAt        *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Products         []*ListOrderResponseItemProduct `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
 	XenditInvoiceUrl string                          `protobuf:"bytes,8,opt,name=xendit_invoice_url,json=xenditInvoiceUrl,proto3" json:"xendit_invoice_url,omitempty"`
@@ -644,11 +776,11 @@ func (x *ListOrderResponseItem) GetStatusCode() string {
 	return ""
 }
 
-func (x *ListOrderResponseItem) GetTotal() float64 {
+func (x *ListOrderResponseItem) GetTotal() *common.Money {
 	if x != nil {
 		return x.Total
 	}
-	return 0
+	return nil
 }
 
 func (x *ListOrderResponseItem) GetCreatedAt() *timestamppb.Timestamp {
@@ -676,7 +808,7 @@ type ListOrderResponseItemProduct struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -726,11 +858,11 @@ func (x *ListOrderResponseItemProduct) GetName() string {
 	return ""
 }
 
-func (x *ListOrderResponseItemProduct) GetPrice() float64 {
+func (x *ListOrderResponseItemProduct) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *ListOrderResponseItemProduct) GetQuantity() int64 {
@@ -788,8 +920,11 @@ type DetailOrderResponseItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TaxRate       float64                `protobuf:"fixed64,6,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount     *common.Money          `protobuf:"bytes,7,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	OrderItemId   uint64                 `protobuf:"varint,8,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -838,11 +973,11 @@ func (x *DetailOrderResponseItem) GetName() string {
 	return ""
 }
 
-func (x *DetailOrderResponseItem) GetPrice() float64 {
+func (x *DetailOrderResponseItem) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *DetailOrderResponseItem) GetQuantity() int64 {
@@ -852,37 +987,50 @@ func (x *DetailOrderResponseItem) GetQuantity() int64 {
 	return 0
 }
 
-type DetailOrderResponse struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	Base             *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id               string                     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Number           string                     `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
-	UserFullName     string                     `protobuf:"bytes,4,opt,name=user_full_name,json=userFullName,proto3" json:"user_full_name,omitempty"`
-	Address          string                     `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	PhoneNumber      string                     `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Notes            string                     `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	OrderStatusCode  string                     `protobuf:"bytes,8,opt,name=order_status_code,json=orderStatusCode,proto3" json:"order_status_code,omitempty"`
-	CreatedAt        *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	XenditInvoiceUrl string                     `protobuf:"bytes,10,opt,name=xendit_invoice_url,json=xenditInvoiceUrl,proto3" json:"xendit_invoice_url,omitempty"`
-	Items            []*DetailOrderResponseItem `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DetailOrderResponseItem) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
 }
 
-func (x *DetailOrderResponse) Reset() {
-	*x = DetailOrderResponse{}
+func (x *DetailOrderResponseItem) GetTaxAmount() *common.Money {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseItem) GetOrderItemId() uint64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+type DetailOrderResponseDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *common.Money          `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponseDiscount) Reset() {
+	*x = DetailOrderResponseDiscount{}
 	mi := &file_order_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetailOrderResponse) String() string {
+func (x *DetailOrderResponseDiscount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetailOrderResponse) ProtoMessage() {}
+func (*DetailOrderResponseDiscount) ProtoMessage() {}
 
-func (x *DetailOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *DetailOrderResponseDiscount) ProtoReflect() protoreflect.Message {
 	mi := &file_order_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,111 +1042,120 @@ func (x *DetailOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetailOrderResponse.ProtoReflect.Descriptor instead.
-func (*DetailOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetailOrderResponseDiscount.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseDiscount) Descriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DetailOrderResponse) GetBase() *common.BaseResponse {
+func (x *DetailOrderResponseDiscount) GetCode() string {
 	if x != nil {
-		return x.Base
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *DetailOrderResponse) GetId() string {
+func (x *DetailOrderResponseDiscount) GetDescription() string {
 	if x != nil {
-		return x.Id
+		return x.Description
 	}
 	return ""
 }
 
-func (x *DetailOrderResponse) GetNumber() string {
+func (x *DetailOrderResponseDiscount) GetAmount() *common.Money {
 	if x != nil {
-		return x.Number
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *DetailOrderResponse) GetUserFullName() string {
-	if x != nil {
-		return x.UserFullName
-	}
-	return ""
+type DetailOrderResponseShipmentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   uint64                 `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetailOrderResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x *DetailOrderResponseShipmentItem) Reset() {
+	*x = DetailOrderResponseShipmentItem{}
+	mi := &file_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetailOrderResponse) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
+func (x *DetailOrderResponseShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DetailOrderResponse) GetNotes() string {
+func (*DetailOrderResponseShipmentItem) ProtoMessage() {}
+
+func (x *DetailOrderResponseShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[14]
 	if x != nil {
-		return x.Notes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DetailOrderResponse) GetOrderStatusCode() string {
-	if x != nil {
-		return x.OrderStatusCode
-	}
-	return ""
+// Deprecated: Use DetailOrderResponseShipmentItem.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseShipmentItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DetailOrderResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DetailOrderResponseShipmentItem) GetOrderItemId() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.OrderItemId
 	}
-	return nil
+	return 0
 }
 
-func (x *DetailOrderResponse) GetXenditInvoiceUrl() string {
+func (x *DetailOrderResponseShipmentItem) GetName() string {
 	if x != nil {
-		return x.XenditInvoiceUrl
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DetailOrderResponse) GetItems() []*DetailOrderResponseItem {
+func (x *DetailOrderResponseShipmentItem) GetQuantity() int64 {
 	if x != nil {
-		return x.Items
+		return x.Quantity
 	}
-	return nil
+	return 0
 }
 
-type UpdateOrderStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	NewStatusCode string                 `protobuf:"bytes,2,opt,name=new_status_code,json=newStatusCode,proto3" json:"new_status_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DetailOrderResponseShipment struct {
+	state          protoimpl.MessageState             `protogen:"open.v1"`
+	Id             uint64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Carrier        string                             `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                             `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         string                             `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ShippedAt      *timestamppb.Timestamp             `protobuf:"bytes,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp             `protobuf:"bytes,6,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	Items          []*DetailOrderResponseShipmentItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *DetailOrderResponseShipment) Reset() {
+	*x = DetailOrderResponseShipment{}
+	mi := &file_order_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *DetailOrderResponseShipment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*DetailOrderResponseShipment) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *DetailOrderResponseShipment) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,47 +1166,86 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DetailOrderResponseShipment.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseShipment) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderId() string {
+func (x *DetailOrderResponseShipment) GetId() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseShipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetNewStatusCode() string {
+func (x *DetailOrderResponseShipment) GetTrackingNumber() string {
 	if x != nil {
-		return x.NewStatusCode
+		return x.TrackingNumber
 	}
 	return ""
 }
 
-type UpdateOrderStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DetailOrderResponseShipment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *DetailOrderResponseShipment) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseShipment) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseShipment) GetItems() []*DetailOrderResponseShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DetailOrderResponseReturnItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId uint64                 `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity    int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Set once the goods have been inspected.
+	AcceptedQuantity *int64 `protobuf:"varint,4,opt,name=accepted_quantity,json=acceptedQuantity,proto3,oneof" json:"accepted_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponseReturnItem) Reset() {
+	*x = DetailOrderResponseReturnItem{}
+	mi := &file_order_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *DetailOrderResponseReturnItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*DetailOrderResponseReturnItem) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *DetailOrderResponseReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,95 +1256,1722 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DetailOrderResponseReturnItem.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseReturnItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateOrderStatusResponse) GetBase() *common.BaseResponse {
+func (x *DetailOrderResponseReturnItem) GetOrderItemId() uint64 {
 	if x != nil {
-		return x.Base
+		return x.OrderItemId
 	}
-	return nil
+	return 0
 }
 
-var File_order_order_proto protoreflect.FileDescriptor
-
-const file_order_order_proto_rawDesc = "" +
-	"\n" +
-	"\x11order/order.proto\x12\x05order\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17common/pagination.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
+func (x *DetailOrderResponseReturnItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseReturnItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseReturnItem) GetAcceptedQuantity() int64 {
+	if x != nil && x.AcceptedQuantity != nil {
+		return *x.AcceptedQuantity
+	}
+	return 0
+}
+
+type DetailOrderResponseReturn struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Id            uint64                           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                           `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items         []*DetailOrderResponseReturnItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	RefundAmount  *common.Money                    `protobuf:"bytes,5,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	RefundStatus  string                           `protobuf:"bytes,6,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp           `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Number        string                           `protobuf:"bytes,8,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponseReturn) Reset() {
+	*x = DetailOrderResponseReturn{}
+	mi := &file_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailOrderResponseReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailOrderResponseReturn) ProtoMessage() {}
+
+func (x *DetailOrderResponseReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailOrderResponseReturn.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseReturn) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DetailOrderResponseReturn) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseReturn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseReturn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseReturn) GetItems() []*DetailOrderResponseReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseReturn) GetRefundAmount() *common.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseReturn) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseReturn) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseReturn) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type OrderStatusHistoryItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for the entry written when the order was placed.
+	FromStatusCode string  `protobuf:"bytes,1,opt,name=from_status_code,json=fromStatusCode,proto3" json:"from_status_code,omitempty"`
+	ToStatusCode   string  `protobuf:"bytes,2,opt,name=to_status_code,json=toStatusCode,proto3" json:"to_status_code,omitempty"`
+	ActorUserId    *uint64 `protobuf:"varint,3,opt,name=actor_user_id,json=actorUserId,proto3,oneof" json:"actor_user_id,omitempty"`
+	// One of user, admin, system or webhook.
+	ActorType     string                 `protobuf:"bytes,4,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorName     string                 `protobuf:"bytes,5,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusHistoryItem) Reset() {
+	*x = OrderStatusHistoryItem{}
+	mi := &file_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusHistoryItem) ProtoMessage() {}
+
+func (x *OrderStatusHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusHistoryItem.ProtoReflect.Descriptor instead.
+func (*OrderStatusHistoryItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OrderStatusHistoryItem) GetFromStatusCode() string {
+	if x != nil {
+		return x.FromStatusCode
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryItem) GetToStatusCode() string {
+	if x != nil {
+		return x.ToStatusCode
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryItem) GetActorUserId() uint64 {
+	if x != nil && x.ActorUserId != nil {
+		return *x.ActorUserId
+	}
+	return 0
+}
+
+func (x *OrderStatusHistoryItem) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryItem) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusHistoryItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DetailOrderResponse struct {
+	state              protoimpl.MessageState         `protogen:"open.v1"`
+	Base               *common.BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id                 string                         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Number             string                         `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	UserFullName       string                         `protobuf:"bytes,4,opt,name=user_full_name,json=userFullName,proto3" json:"user_full_name,omitempty"`
+	Address            string                         `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber        string                         `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Notes              string                         `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	OrderStatusCode    string                         `protobuf:"bytes,8,opt,name=order_status_code,json=orderStatusCode,proto3" json:"order_status_code,omitempty"`
+	CreatedAt          *timestamppb.Timestamp         `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XenditInvoiceUrl   string                         `protobuf:"bytes,10,opt,name=xendit_invoice_url,json=xenditInvoiceUrl,proto3" json:"xendit_invoice_url,omitempty"`
+	Items              []*DetailOrderResponseItem     `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	Subtotal           *common.Money                  `protobuf:"bytes,16,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal      *common.Money                  `protobuf:"bytes,17,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	TaxTotal           *common.Money                  `protobuf:"bytes,19,opt,name=tax_total,json=taxTotal,proto3" json:"tax_total,omitempty"`
+	ShippingTotal      *common.Money                  `protobuf:"bytes,20,opt,name=shipping_total,json=shippingTotal,proto3" json:"shipping_total,omitempty"`
+	TaxInclusive       bool                           `protobuf:"varint,21,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	ShippingMethodName string                         `protobuf:"bytes,22,opt,name=shipping_method_name,json=shippingMethodName,proto3" json:"shipping_method_name,omitempty"`
+	ShippingRegion     string                         `protobuf:"bytes,23,opt,name=shipping_region,json=shippingRegion,proto3" json:"shipping_region,omitempty"`
+	Total              *common.Money                  `protobuf:"bytes,18,opt,name=total,proto3" json:"total,omitempty"`
+	Discounts          []*DetailOrderResponseDiscount `protobuf:"bytes,15,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Shipments          []*DetailOrderResponseShipment `protobuf:"bytes,24,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	StatusHistory      []*OrderStatusHistoryItem      `protobuf:"bytes,25,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	// Empty when nothing was refunded, otherwise pending, succeeded or failed.
+	RefundStatus  string                       `protobuf:"bytes,26,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	RefundAmount  *common.Money                `protobuf:"bytes,27,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	Returns       []*DetailOrderResponseReturn `protobuf:"bytes,28,rep,name=returns,proto3" json:"returns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponse) Reset() {
+	*x = DetailOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailOrderResponse) ProtoMessage() {}
+
+func (x *DetailOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailOrderResponse.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DetailOrderResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetUserFullName() string {
+	if x != nil {
+		return x.UserFullName
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetOrderStatusCode() string {
+	if x != nil {
+		return x.OrderStatusCode
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetXenditInvoiceUrl() string {
+	if x != nil {
+		return x.XenditInvoiceUrl
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetItems() []*DetailOrderResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetSubtotal() *common.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetDiscountTotal() *common.Money {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetTaxTotal() *common.Money {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetShippingTotal() *common.Money {
+	if x != nil {
+		return x.ShippingTotal
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetTaxInclusive() bool {
+	if x != nil {
+		return x.TaxInclusive
+	}
+	return false
+}
+
+func (x *DetailOrderResponse) GetShippingMethodName() string {
+	if x != nil {
+		return x.ShippingMethodName
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetShippingRegion() string {
+	if x != nil {
+		return x.ShippingRegion
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetDiscounts() []*DetailOrderResponseDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetShipments() []*DetailOrderResponseShipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetStatusHistory() []*OrderStatusHistoryItem {
+	if x != nil {
+		return x.StatusHistory
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetRefundAmount() *common.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetReturns() []*DetailOrderResponseReturn {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	NewStatusCode string                 `protobuf:"bytes,2,opt,name=new_status_code,json=newStatusCode,proto3" json:"new_status_code,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetNewStatusCode() string {
+	if x != nil {
+		return x.NewStatusCode
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_order_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateOrderStatusResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type CreateShipmentRequestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   uint64                 `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequestItem) Reset() {
+	*x = CreateShipmentRequestItem{}
+	mi := &file_order_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequestItem) ProtoMessage() {}
+
+func (x *CreateShipmentRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequestItem.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequestItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateShipmentRequestItem) GetOrderItemId() uint64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *CreateShipmentRequestItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	// Defaults to now.
+	ShippedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	// Leave empty to ship everything that has not been shipped yet.
+	Items         []*CreateShipmentRequestItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *CreateShipmentRequest) GetItems() []*CreateShipmentRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ShipmentId    uint64                 `protobuf:"varint,2,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	mi := &file_order_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateShipmentResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateShipmentResponse) GetShipmentId() uint64 {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return 0
+}
+
+type ListOrderStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusHistoryRequest) Reset() {
+	*x = ListOrderStatusHistoryRequest{}
+	mi := &file_order_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusHistoryRequest) ProtoMessage() {}
+
+func (x *ListOrderStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListOrderStatusHistoryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListOrderStatusHistoryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Base          *common.BaseResponse      `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*OrderStatusHistoryItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusHistoryResponse) Reset() {
+	*x = ListOrderStatusHistoryResponse{}
+	mi := &file_order_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusHistoryResponse) ProtoMessage() {}
+
+func (x *ListOrderStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListOrderStatusHistoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListOrderStatusHistoryResponse) GetData() []*OrderStatusHistoryItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListOrderStatusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusesRequest) Reset() {
+	*x = ListOrderStatusesRequest{}
+	mi := &file_order_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusesRequest) ProtoMessage() {}
+
+func (x *ListOrderStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{27}
+}
+
+type ListOrderStatusesResponseTransition struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ToStatusCode string                 `protobuf:"bytes,1,opt,name=to_status_code,json=toStatusCode,proto3" json:"to_status_code,omitempty"`
+	// Any of user, admin, system or webhook.
+	AllowedActorTypes []string `protobuf:"bytes,2,rep,name=allowed_actor_types,json=allowedActorTypes,proto3" json:"allowed_actor_types,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusesResponseTransition) Reset() {
+	*x = ListOrderStatusesResponseTransition{}
+	mi := &file_order_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusesResponseTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusesResponseTransition) ProtoMessage() {}
+
+func (x *ListOrderStatusesResponseTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusesResponseTransition.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusesResponseTransition) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListOrderStatusesResponseTransition) GetToStatusCode() string {
+	if x != nil {
+		return x.ToStatusCode
+	}
+	return ""
+}
+
+func (x *ListOrderStatusesResponseTransition) GetAllowedActorTypes() []string {
+	if x != nil {
+		return x.AllowedActorTypes
+	}
+	return nil
+}
+
+type ListOrderStatusesResponseItem struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Code          string                                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Transitions   []*ListOrderStatusesResponseTransition `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusesResponseItem) Reset() {
+	*x = ListOrderStatusesResponseItem{}
+	mi := &file_order_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusesResponseItem) ProtoMessage() {}
+
+func (x *ListOrderStatusesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusesResponseItem.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusesResponseItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListOrderStatusesResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListOrderStatusesResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListOrderStatusesResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListOrderStatusesResponseItem) GetTransitions() []*ListOrderStatusesResponseTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type ListOrderStatusesResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Base          *common.BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListOrderStatusesResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusesResponse) Reset() {
+	*x = ListOrderStatusesResponse{}
+	mi := &file_order_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusesResponse) ProtoMessage() {}
+
+func (x *ListOrderStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListOrderStatusesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListOrderStatusesResponse) GetData() []*ListOrderStatusesResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same filters as the admin list. Pagination is ignored, every matching
+	// order is exported newest first.
+	Filter        *ListOrderAdminRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_order_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersRequest) ProtoMessage() {}
+
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportOrdersRequest) GetFilter() *ListOrderAdminRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first message only.
+	FileName    string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The next part of the file.
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersResponse) Reset() {
+	*x = ExportOrdersResponse{}
+	mi := &file_order_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersResponse) ProtoMessage() {}
+
+func (x *ExportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportOrdersResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportOrdersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportOrdersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type GetOrderReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReceiptRequest) Reset() {
+	*x = GetOrderReceiptRequest{}
+	mi := &file_order_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReceiptRequest) ProtoMessage() {}
+
+func (x *GetOrderReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetOrderReceiptRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderReceiptResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Base        *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The receipt as a PDF file.
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReceiptResponse) Reset() {
+	*x = GetOrderReceiptResponse{}
+	mi := &file_order_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReceiptResponse) ProtoMessage() {}
+
+func (x *GetOrderReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetOrderReceiptResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetOrderReceiptResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetOrderReceiptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetOrderReceiptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type WatchOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only changes to these statuses are sent. Empty sends every change.
+	StatusCodes   []string `protobuf:"bytes,1,rep,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_order_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WatchOrdersRequest) GetStatusCodes() []string {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+type WatchOrderResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderId     string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNumber string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	// Empty for the first message of WatchOrder, which carries the status
+	// the order had when the stream started.
+	FromStatusCode string                 `protobuf:"bytes,3,opt,name=from_status_code,json=fromStatusCode,proto3" json:"from_status_code,omitempty"`
+	ToStatusCode   string                 `protobuf:"bytes,4,opt,name=to_status_code,json=toStatusCode,proto3" json:"to_status_code,omitempty"`
+	ActorType      string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	Reason         string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchOrderResponse) Reset() {
+	*x = WatchOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderResponse) ProtoMessage() {}
+
+func (x *WatchOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderResponse.ProtoReflect.Descriptor instead.
+func (*WatchOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetFromStatusCode() string {
+	if x != nil {
+		return x.FromStatusCode
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetToStatusCode() string {
+	if x != nil {
+		return x.ToStatusCode
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WatchOrderResponse) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Set when the order was paid and is being refunded.
+	RefundStatus  string `protobuf:"bytes,2,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelOrderResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CancelOrderResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+var File_order_order_proto protoreflect.FileDescriptor
+
+const file_order_order_proto_rawDesc = "" +
+	"\n" +
+	"\x11order/order.proto\x12\x05order\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17common/pagination.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
 	"\x1dCreateOrderRequestProductItem\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x12#\n" +
-	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\xee\x01\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\xc3\x03\n" +
 	"\x12CreateOrderRequest\x12'\n" +
 	"\tfull_name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\x12!\n" +
 	"\aaddress\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aaddress\x12*\n" +
 	"\fphone_number\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vphoneNumber\x12\x14\n" +
 	"\x05notes\x18\x04 \x01(\tR\x05notes\x12J\n" +
-	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemB\b\xbaH\x05\x92\x01\x02\b\x01R\bproducts\"Z\n" +
+	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemB\b\xbaH\x05\x92\x01\x02\b\x01R\bproducts\x12(\n" +
+	"\vcoupon_code\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x182R\n" +
+	"couponCode\x12<\n" +
+	"\rcurrency_code\x18\a \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\x129\n" +
+	"\x14shipping_method_code\x18\b \x01(\tB\a\xbaH\x04r\x02\x182R\x12shippingMethodCode\x120\n" +
+	"\x0fshipping_region\x18\t \x01(\tB\a\xbaH\x04r\x02\x18dR\x0eshippingRegion\"Z\n" +
 	"\x13CreateOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\tR\aorderId\"R\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"\xdc\x03\n" +
 	"\x15ListOrderAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"y\n" +
+	"pagination\x121\n" +
+	"\fstatus_codes\x18\x02 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x182R\vstatusCodes\x12=\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12*\n" +
+	"\tmin_total\x18\x05 \x01(\v2\r.common.MoneyR\bminTotal\x12*\n" +
+	"\tmax_total\x18\x06 \x01(\v2\r.common.MoneyR\bmaxTotal\x12$\n" +
+	"\bcustomer\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bcustomer\x12,\n" +
+	"\rnumber_prefix\x18\b \x01(\tB\a\xbaH\x04r\x02\x18dR\fnumberPrefix\x12/\n" +
+	"\x0epayment_method\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\rpaymentMethod\"\x8e\x01\n" +
 	"!ListOrderAdminResponseItemProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\x98\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.common.MoneyR\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantityJ\x04\b\x03\x10\x04\"\xad\x02\n" +
 	"\x1aListOrderAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
 	"\bcustomer\x18\x03 \x01(\tR\bcustomer\x12\x1f\n" +
 	"\vstatus_code\x18\x04 \x01(\tR\n" +
-	"statusCode\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x01R\x05total\x129\n" +
+	"statusCode\x12#\n" +
+	"\x05total\x18\b \x01(\v2\r.common.MoneyR\x05total\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12D\n" +
-	"\bproducts\x18\a \x03(\v2(.order.ListOrderAdminResponseItemProductR\bproducts\"\xb9\x01\n" +
+	"\bproducts\x18\a \x03(\v2(.order.ListOrderAdminResponseItemProductR\bproductsJ\x04\b\x05\x10\x06\"\xb9\x01\n" +
 	"\x16ListOrderAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x129\n" +
-	"\x06orders\x18\x03 \x03(\v2!.order.ListOrderAdminResponseItemR\x06orders\"M\n" +
+	"\x06orders\x18\x03 \x03(\v2!.order.ListOrderAdminResponseItemR\x06orders\"\xfa\x01\n" +
 	"\x10ListOrderRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\xaf\x01\n" +
+	"pagination\x121\n" +
+	"\fstatus_codes\x18\x02 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x182R\vstatusCodes\x12=\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"\xaf\x01\n" +
 	"\x11ListOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x124\n" +
-	"\x06orders\x18\x03 \x03(\v2\x1c.order.ListOrderResponseItemR\x06orders\"\xbc\x02\n" +
+	"\x06orders\x18\x03 \x03(\v2\x1c.order.ListOrderResponseItemR\x06orders\"\xd1\x02\n" +
 	"\x15ListOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
 	"\bcustomer\x18\x03 \x01(\tR\bcustomer\x12\x1f\n" +
 	"\vstatus_code\x18\x04 \x01(\tR\n" +
-	"statusCode\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x01R\x05total\x129\n" +
+	"statusCode\x12#\n" +
+	"\x05total\x18\t \x01(\v2\r.common.MoneyR\x05total\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12?\n" +
 	"\bproducts\x18\a \x03(\v2#.order.ListOrderResponseItemProductR\bproducts\x12,\n" +
-	"\x12xendit_invoice_url\x18\b \x01(\tR\x10xenditInvoiceUrl\"t\n" +
+	"\x12xendit_invoice_url\x18\b \x01(\tR\x10xenditInvoiceUrlJ\x04\b\x05\x10\x06\"\x89\x01\n" +
 	"\x1cListOrderResponseItemProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"8\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.common.MoneyR\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantityJ\x04\b\x03\x10\x04\"8\n" +
 	"\x12DetailOrderRequest\x12\"\n" +
-	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"o\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"\xf1\x01\n" +
 	"\x17DetailOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xab\x03\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.common.MoneyR\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x19\n" +
+	"\btax_rate\x18\x06 \x01(\x01R\ataxRate\x12,\n" +
+	"\n" +
+	"tax_amount\x18\a \x01(\v2\r.common.MoneyR\ttaxAmount\x12\"\n" +
+	"\rorder_item_id\x18\b \x01(\x04R\vorderItemIdJ\x04\b\x03\x10\x04\"\x80\x01\n" +
+	"\x1bDetailOrderResponseDiscount\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12%\n" +
+	"\x06amount\x18\x04 \x01(\v2\r.common.MoneyR\x06amountJ\x04\b\x03\x10\x04\"u\n" +
+	"\x1fDetailOrderResponseShipmentItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\x04R\vorderItemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\"\xc0\x02\n" +
+	"\x1bDetailOrderResponseShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12=\n" +
+	"\fdelivered_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x12<\n" +
+	"\x05items\x18\a \x03(\v2&.order.DetailOrderResponseShipmentItemR\x05items\"\xbb\x01\n" +
+	"\x1dDetailOrderResponseReturnItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\x04R\vorderItemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x120\n" +
+	"\x11accepted_quantity\x18\x04 \x01(\x03H\x00R\x10acceptedQuantity\x88\x01\x01B\x14\n" +
+	"\x12_accepted_quantity\"\xc3\x02\n" +
+	"\x19DetailOrderResponseReturn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12:\n" +
+	"\x05items\x18\x04 \x03(\v2$.order.DetailOrderResponseReturnItemR\x05items\x122\n" +
+	"\rrefund_amount\x18\x05 \x01(\v2\r.common.MoneyR\frefundAmount\x12#\n" +
+	"\rrefund_status\x18\x06 \x01(\tR\frefundStatus\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06number\x18\b \x01(\tR\x06number\"\xb4\x02\n" +
+	"\x16OrderStatusHistoryItem\x12(\n" +
+	"\x10from_status_code\x18\x01 \x01(\tR\x0efromStatusCode\x12$\n" +
+	"\x0eto_status_code\x18\x02 \x01(\tR\ftoStatusCode\x12'\n" +
+	"\ractor_user_id\x18\x03 \x01(\x04H\x00R\vactorUserId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x04 \x01(\tR\tactorType\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x05 \x01(\tR\tactorName\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
+	"\x0e_actor_user_id\"\x84\t\n" +
 	"\x13DetailOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
@@ -1162,18 +2985,108 @@ const file_order_order_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12,\n" +
 	"\x12xendit_invoice_url\x18\n" +
 	" \x01(\tR\x10xenditInvoiceUrl\x124\n" +
-	"\x05items\x18\v \x03(\v2\x1e.order.DetailOrderResponseItemR\x05items\"q\n" +
+	"\x05items\x18\v \x03(\v2\x1e.order.DetailOrderResponseItemR\x05items\x12)\n" +
+	"\bsubtotal\x18\x10 \x01(\v2\r.common.MoneyR\bsubtotal\x124\n" +
+	"\x0ediscount_total\x18\x11 \x01(\v2\r.common.MoneyR\rdiscountTotal\x12*\n" +
+	"\ttax_total\x18\x13 \x01(\v2\r.common.MoneyR\btaxTotal\x124\n" +
+	"\x0eshipping_total\x18\x14 \x01(\v2\r.common.MoneyR\rshippingTotal\x12#\n" +
+	"\rtax_inclusive\x18\x15 \x01(\bR\ftaxInclusive\x120\n" +
+	"\x14shipping_method_name\x18\x16 \x01(\tR\x12shippingMethodName\x12'\n" +
+	"\x0fshipping_region\x18\x17 \x01(\tR\x0eshippingRegion\x12#\n" +
+	"\x05total\x18\x12 \x01(\v2\r.common.MoneyR\x05total\x12@\n" +
+	"\tdiscounts\x18\x0f \x03(\v2\".order.DetailOrderResponseDiscountR\tdiscounts\x12@\n" +
+	"\tshipments\x18\x18 \x03(\v2\".order.DetailOrderResponseShipmentR\tshipments\x12D\n" +
+	"\x0estatus_history\x18\x19 \x03(\v2\x1d.order.OrderStatusHistoryItemR\rstatusHistory\x12#\n" +
+	"\rrefund_status\x18\x1a \x01(\tR\frefundStatus\x122\n" +
+	"\rrefund_amount\x18\x1b \x01(\v2\r.common.MoneyR\frefundAmount\x12:\n" +
+	"\areturns\x18\x1c \x03(\v2 .order.DetailOrderResponseReturnR\areturnsJ\x04\b\f\x10\rJ\x04\b\r\x10\x0eJ\x04\b\x0e\x10\x0f\"\x93\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\"\n" +
 	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x121\n" +
-	"\x0fnew_status_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rnewStatusCode\"E\n" +
+	"\x0fnew_status_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rnewStatusCode\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"E\n" +
 	"\x19UpdateOrderStatusResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x81\x03\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"m\n" +
+	"\x19CreateShipmentRequestItem\x12+\n" +
+	"\rorder_item_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\vorderItemId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\x87\x02\n" +
+	"\x15CreateShipmentRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12#\n" +
+	"\acarrier\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\acarrier\x122\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x0etrackingNumber\x129\n" +
+	"\n" +
+	"shipped_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x126\n" +
+	"\x05items\x18\x05 \x03(\v2 .order.CreateShipmentRequestItemR\x05items\"c\n" +
+	"\x16CreateShipmentResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1f\n" +
+	"\vshipment_id\x18\x02 \x01(\x04R\n" +
+	"shipmentId\"C\n" +
+	"\x1dListOrderStatusHistoryRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"}\n" +
+	"\x1eListOrderStatusHistoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x121\n" +
+	"\x04data\x18\x02 \x03(\v2\x1d.order.OrderStatusHistoryItemR\x04data\"\x1a\n" +
+	"\x18ListOrderStatusesRequest\"{\n" +
+	"#ListOrderStatusesResponseTransition\x12$\n" +
+	"\x0eto_status_code\x18\x01 \x01(\tR\ftoStatusCode\x12.\n" +
+	"\x13allowed_actor_types\x18\x02 \x03(\tR\x11allowedActorTypes\"\xb7\x01\n" +
+	"\x1dListOrderStatusesResponseItem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12L\n" +
+	"\vtransitions\x18\x04 \x03(\v2*.order.ListOrderStatusesResponseTransitionR\vtransitions\"\x7f\n" +
+	"\x19ListOrderStatusesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x128\n" +
+	"\x04data\x18\x02 \x03(\v2$.order.ListOrderStatusesResponseItemR\x04data\"u\n" +
+	"\x13ExportOrdersRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.order.ListOrderAdminRequestR\x06filter\x12(\n" +
+	"\x06format\x18\x02 \x01(\tB\x10\xbaH\rr\vR\x03csvR\x04xlsxR\x06format\"l\n" +
+	"\x14ExportOrdersResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"<\n" +
+	"\x16GetOrderReceiptRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"\x9d\x01\n" +
+	"\x17GetOrderReceiptResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"7\n" +
+	"\x11WatchOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"G\n" +
+	"\x12WatchOrdersRequest\x121\n" +
+	"\fstatus_codes\x18\x01 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x182R\vstatusCodes\"\x94\x02\n" +
+	"\x12WatchOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x12(\n" +
+	"\x10from_status_code\x18\x03 \x01(\tR\x0efromStatusCode\x12$\n" +
+	"\x0eto_status_code\x18\x04 \x01(\tR\ftoStatusCode\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x05 \x01(\tR\tactorType\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\\\n" +
+	"\x12CancelOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x06reason\"d\n" +
+	"\x13CancelOrderResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12#\n" +
+	"\rrefund_status\x18\x02 \x01(\tR\frefundStatus2\xfe\a\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12M\n" +
 	"\x0eListOrderAdmin\x12\x1c.order.ListOrderAdminRequest\x1a\x1d.order.ListOrderAdminResponse\x12>\n" +
 	"\tListOrder\x12\x17.order.ListOrderRequest\x1a\x18.order.ListOrderResponse\x12D\n" +
 	"\vDetailOrder\x12\x19.order.DetailOrderRequest\x1a\x1a.order.DetailOrderResponse\x12V\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponseB|\n" +
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12M\n" +
+	"\x0eCreateShipment\x12\x1c.order.CreateShipmentRequest\x1a\x1d.order.CreateShipmentResponse\x12e\n" +
+	"\x16ListOrderStatusHistory\x12$.order.ListOrderStatusHistoryRequest\x1a%.order.ListOrderStatusHistoryResponse\x12V\n" +
+	"\x11ListOrderStatuses\x12\x1f.order.ListOrderStatusesRequest\x1a .order.ListOrderStatusesResponse\x12D\n" +
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12I\n" +
+	"\fExportOrders\x12\x1a.order.ExportOrdersRequest\x1a\x1b.order.ExportOrdersResponse0\x01\x12P\n" +
+	"\x0fGetOrderReceipt\x12\x1d.order.GetOrderReceiptRequest\x1a\x1e.order.GetOrderReceiptResponse\x12C\n" +
+	"\n" +
+	"WatchOrder\x12\x18.order.WatchOrderRequest\x1a\x19.order.WatchOrderResponse0\x01\x12E\n" +
+	"\vWatchOrders\x12\x19.order.WatchOrdersRequest\x1a\x19.order.WatchOrderResponse0\x01B|\n" +
 	"\tcom.orderB\n" +
 	"OrderProtoP\x01Z/github.com/fahrillrizal/ecommerce-grpc/pb/order\xa2\x02\x03OXX\xaa\x02\x05Order\xca\x02\x05Order\xe2\x02\x11Order\\GPBMetadata\xea\x02\x05Orderb\x06proto3"
 
@@ -1189,63 +3102,146 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_order_order_proto_goTypes = []any{
-	(*CreateOrderRequestProductItem)(nil),     // 0: order.CreateOrderRequestProductItem
-	(*CreateOrderRequest)(nil),                // 1: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),               // 2: order.CreateOrderResponse
-	(*ListOrderAdminRequest)(nil),             // 3: order.ListOrderAdminRequest
-	(*ListOrderAdminResponseItemProduct)(nil), // 4: order.ListOrderAdminResponseItemProduct
-	(*ListOrderAdminResponseItem)(nil),        // 5: order.ListOrderAdminResponseItem
-	(*ListOrderAdminResponse)(nil),            // 6: order.ListOrderAdminResponse
-	(*ListOrderRequest)(nil),                  // 7: order.ListOrderRequest
-	(*ListOrderResponse)(nil),                 // 8: order.ListOrderResponse
-	(*ListOrderResponseItem)(nil),             // 9: order.ListOrderResponseItem
-	(*ListOrderResponseItemProduct)(nil),      // 10: order.ListOrderResponseItemProduct
-	(*DetailOrderRequest)(nil),                // 11: order.DetailOrderRequest
-	(*DetailOrderResponseItem)(nil),           // 12: order.DetailOrderResponseItem
-	(*DetailOrderResponse)(nil),               // 13: order.DetailOrderResponse
-	(*UpdateOrderStatusRequest)(nil),          // 14: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),         // 15: order.UpdateOrderStatusResponse
-	(*common.BaseResponse)(nil),               // 16: common.BaseResponse
-	(*common.PaginationRequest)(nil),          // 17: common.PaginationRequest
-	(*timestamppb.Timestamp)(nil),             // 18: google.protobuf.Timestamp
-	(*common.PaginationResponse)(nil),         // 19: common.PaginationResponse
+	(*CreateOrderRequestProductItem)(nil),       // 0: order.CreateOrderRequestProductItem
+	(*CreateOrderRequest)(nil),                  // 1: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),                 // 2: order.CreateOrderResponse
+	(*ListOrderAdminRequest)(nil),               // 3: order.ListOrderAdminRequest
+	(*ListOrderAdminResponseItemProduct)(nil),   // 4: order.ListOrderAdminResponseItemProduct
+	(*ListOrderAdminResponseItem)(nil),          // 5: order.ListOrderAdminResponseItem
+	(*ListOrderAdminResponse)(nil),              // 6: order.ListOrderAdminResponse
+	(*ListOrderRequest)(nil),                    // 7: order.ListOrderRequest
+	(*ListOrderResponse)(nil),                   // 8: order.ListOrderResponse
+	(*ListOrderResponseItem)(nil),               // 9: order.ListOrderResponseItem
+	(*ListOrderResponseItemProduct)(nil),        // 10: order.ListOrderResponseItemProduct
+	(*DetailOrderRequest)(nil),                  // 11: order.DetailOrderRequest
+	(*DetailOrderResponseItem)(nil),             // 12: order.DetailOrderResponseItem
+	(*DetailOrderResponseDiscount)(nil),         // 13: order.DetailOrderResponseDiscount
+	(*DetailOrderResponseShipmentItem)(nil),     // 14: order.DetailOrderResponseShipmentItem
+	(*DetailOrderResponseShipment)(nil),         // 15: order.DetailOrderResponseShipment
+	(*DetailOrderResponseReturnItem)(nil),       // 16: order.DetailOrderResponseReturnItem
+	(*DetailOrderResponseReturn)(nil),           // 17: order.DetailOrderResponseReturn
+	(*OrderStatusHistoryItem)(nil),              // 18: order.OrderStatusHistoryItem
+	(*DetailOrderResponse)(nil),                 // 19: order.DetailOrderResponse
+	(*UpdateOrderStatusRequest)(nil),            // 20: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),           // 21: order.UpdateOrderStatusResponse
+	(*CreateShipmentRequestItem)(nil),           // 22: order.CreateShipmentRequestItem
+	(*CreateShipmentRequest)(nil),               // 23: order.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil),              // 24: order.CreateShipmentResponse
+	(*ListOrderStatusHistoryRequest)(nil),       // 25: order.ListOrderStatusHistoryRequest
+	(*ListOrderStatusHistoryResponse)(nil),      // 26: order.ListOrderStatusHistoryResponse
+	(*ListOrderStatusesRequest)(nil),            // 27: order.ListOrderStatusesRequest
+	(*ListOrderStatusesResponseTransition)(nil), // 28: order.ListOrderStatusesResponseTransition
+	(*ListOrderStatusesResponseItem)(nil),       // 29: order.ListOrderStatusesResponseItem
+	(*ListOrderStatusesResponse)(nil),           // 30: order.ListOrderStatusesResponse
+	(*ExportOrdersRequest)(nil),                 // 31: order.ExportOrdersRequest
+	(*ExportOrdersResponse)(nil),                // 32: order.ExportOrdersResponse
+	(*GetOrderReceiptRequest)(nil),              // 33: order.GetOrderReceiptRequest
+	(*GetOrderReceiptResponse)(nil),             // 34: order.GetOrderReceiptResponse
+	(*WatchOrderRequest)(nil),                   // 35: order.WatchOrderRequest
+	(*WatchOrdersRequest)(nil),                  // 36: order.WatchOrdersRequest
+	(*WatchOrderResponse)(nil),                  // 37: order.WatchOrderResponse
+	(*CancelOrderRequest)(nil),                  // 38: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                 // 39: order.CancelOrderResponse
+	(*common.BaseResponse)(nil),                 // 40: common.BaseResponse
+	(*common.PaginationRequest)(nil),            // 41: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),               // 42: google.protobuf.Timestamp
+	(*common.Money)(nil),                        // 43: common.Money
+	(*common.PaginationResponse)(nil),           // 44: common.PaginationResponse
 }
 var file_order_order_proto_depIdxs = []int32{
 	0,  // 0: order.CreateOrderRequest.products:type_name -> order.CreateOrderRequestProductItem
-	16, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
-	17, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
-	18, // 3: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProduct
-	16, // 5: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
-	19, // 6: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
-	5,  // 7: order.ListOrderAdminResponse.orders:type_name -> order.ListOrderAdminResponseItem
-	17, // 8: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
-	16, // 9: order.ListOrderResponse.base:type_name -> common.BaseResponse
-	19, // 10: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 11: order.ListOrderResponse.orders:type_name -> order.ListOrderResponseItem
-	18, // 12: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	10, // 13: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProduct
-	16, // 14: order.DetailOrderResponse.base:type_name -> common.BaseResponse
-	18, // 15: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
-	12, // 16: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
-	16, // 17: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
-	1,  // 18: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 19: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
-	7,  // 20: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
-	11, // 21: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
-	14, // 22: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	2,  // 23: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	6,  // 24: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
-	8,  // 25: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
-	13, // 26: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
-	15, // 27: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	40, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
+	41, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
+	42, // 3: order.ListOrderAdminRequest.created_from:type_name -> google.protobuf.Timestamp
+	42, // 4: order.ListOrderAdminRequest.created_to:type_name -> google.protobuf.Timestamp
+	43, // 5: order.ListOrderAdminRequest.min_total:type_name -> common.Money
+	43, // 6: order.ListOrderAdminRequest.max_total:type_name -> common.Money
+	43, // 7: order.ListOrderAdminResponseItemProduct.price:type_name -> common.Money
+	43, // 8: order.ListOrderAdminResponseItem.total:type_name -> common.Money
+	42, // 9: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProduct
+	40, // 11: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
+	44, // 12: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
+	5,  // 13: order.ListOrderAdminResponse.orders:type_name -> order.ListOrderAdminResponseItem
+	41, // 14: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
+	42, // 15: order.ListOrderRequest.created_from:type_name -> google.protobuf.Timestamp
+	42, // 16: order.ListOrderRequest.created_to:type_name -> google.protobuf.Timestamp
+	40, // 17: order.ListOrderResponse.base:type_name -> common.BaseResponse
+	44, // 18: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 19: order.ListOrderResponse.orders:type_name -> order.ListOrderResponseItem
+	43, // 20: order.ListOrderResponseItem.total:type_name -> common.Money
+	42, // 21: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	10, // 22: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProduct
+	43, // 23: order.ListOrderResponseItemProduct.price:type_name -> common.Money
+	43, // 24: order.DetailOrderResponseItem.price:type_name -> common.Money
+	43, // 25: order.DetailOrderResponseItem.tax_amount:type_name -> common.Money
+	43, // 26: order.DetailOrderResponseDiscount.amount:type_name -> common.Money
+	42, // 27: order.DetailOrderResponseShipment.shipped_at:type_name -> google.protobuf.Timestamp
+	42, // 28: order.DetailOrderResponseShipment.delivered_at:type_name -> google.protobuf.Timestamp
+	14, // 29: order.DetailOrderResponseShipment.items:type_name -> order.DetailOrderResponseShipmentItem
+	16, // 30: order.DetailOrderResponseReturn.items:type_name -> order.DetailOrderResponseReturnItem
+	43, // 31: order.DetailOrderResponseReturn.refund_amount:type_name -> common.Money
+	42, // 32: order.DetailOrderResponseReturn.created_at:type_name -> google.protobuf.Timestamp
+	42, // 33: order.OrderStatusHistoryItem.created_at:type_name -> google.protobuf.Timestamp
+	40, // 34: order.DetailOrderResponse.base:type_name -> common.BaseResponse
+	42, // 35: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 36: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
+	43, // 37: order.DetailOrderResponse.subtotal:type_name -> common.Money
+	43, // 38: order.DetailOrderResponse.discount_total:type_name -> common.Money
+	43, // 39: order.DetailOrderResponse.tax_total:type_name -> common.Money
+	43, // 40: order.DetailOrderResponse.shipping_total:type_name -> common.Money
+	43, // 41: order.DetailOrderResponse.total:type_name -> common.Money
+	13, // 42: order.DetailOrderResponse.discounts:type_name -> order.DetailOrderResponseDiscount
+	15, // 43: order.DetailOrderResponse.shipments:type_name -> order.DetailOrderResponseShipment
+	18, // 44: order.DetailOrderResponse.status_history:type_name -> order.OrderStatusHistoryItem
+	43, // 45: order.DetailOrderResponse.refund_amount:type_name -> common.Money
+	17, // 46: order.DetailOrderResponse.returns:type_name -> order.DetailOrderResponseReturn
+	40, // 47: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
+	42, // 48: order.CreateShipmentRequest.shipped_at:type_name -> google.protobuf.Timestamp
+	22, // 49: order.CreateShipmentRequest.items:type_name -> order.CreateShipmentRequestItem
+	40, // 50: order.CreateShipmentResponse.base:type_name -> common.BaseResponse
+	40, // 51: order.ListOrderStatusHistoryResponse.base:type_name -> common.BaseResponse
+	18, // 52: order.ListOrderStatusHistoryResponse.data:type_name -> order.OrderStatusHistoryItem
+	28, // 53: order.ListOrderStatusesResponseItem.transitions:type_name -> order.ListOrderStatusesResponseTransition
+	40, // 54: order.ListOrderStatusesResponse.base:type_name -> common.BaseResponse
+	29, // 55: order.ListOrderStatusesResponse.data:type_name -> order.ListOrderStatusesResponseItem
+	3,  // 56: order.ExportOrdersRequest.filter:type_name -> order.ListOrderAdminRequest
+	40, // 57: order.GetOrderReceiptResponse.base:type_name -> common.BaseResponse
+	42, // 58: order.WatchOrderResponse.changed_at:type_name -> google.protobuf.Timestamp
+	40, // 59: order.CancelOrderResponse.base:type_name -> common.BaseResponse
+	1,  // 60: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	3,  // 61: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
+	7,  // 62: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
+	11, // 63: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
+	20, // 64: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	23, // 65: order.OrderService.CreateShipment:input_type -> order.CreateShipmentRequest
+	25, // 66: order.OrderService.ListOrderStatusHistory:input_type -> order.ListOrderStatusHistoryRequest
+	27, // 67: order.OrderService.ListOrderStatuses:input_type -> order.ListOrderStatusesRequest
+	38, // 68: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	31, // 69: order.OrderService.ExportOrders:input_type -> order.ExportOrdersRequest
+	33, // 70: order.OrderService.GetOrderReceipt:input_type -> order.GetOrderReceiptRequest
+	35, // 71: order.OrderService.WatchOrder:input_type -> order.WatchOrderRequest
+	36, // 72: order.OrderService.WatchOrders:input_type -> order.WatchOrdersRequest
+	2,  // 73: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	6,  // 74: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
+	8,  // 75: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
+	19, // 76: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
+	21, // 77: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	24, // 78: order.OrderService.CreateShipment:output_type -> order.CreateShipmentResponse
+	26, // 79: order.OrderService.ListOrderStatusHistory:output_type -> order.ListOrderStatusHistoryResponse
+	30, // 80: order.OrderService.ListOrderStatuses:output_type -> order.ListOrderStatusesResponse
+	39, // 81: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	32, // 82: order.OrderService.ExportOrders:output_type -> order.ExportOrdersResponse
+	34, // 83: order.OrderService.GetOrderReceipt:output_type -> order.GetOrderReceiptResponse
+	37, // 84: order.OrderService.WatchOrder:output_type -> order.WatchOrderResponse
+	37, // 85: order.OrderService.WatchOrders:output_type -> order.WatchOrderResponse
+	73, // [73:86] is the sub-list for method output_type
+	60, // [60:73] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -1253,13 +3249,15 @@ func file_order_order_proto_init() {
 	if File_order_order_proto != nil {
 		return
 	}
+	file_order_order_proto_msgTypes[16].OneofWrappers = []any{}
+	file_order_order_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_proto_rawDesc), len(file_order_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
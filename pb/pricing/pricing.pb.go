@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: pricing/pricing.proto
+
+package pricing
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Sets the price of a product in a currency other than the store currency.
+type SetProductPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPriceRequest) Reset() {
+	*x = SetProductPriceRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceRequest) ProtoMessage() {}
+
+func (x *SetProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetProductPriceRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type SetProductPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPriceResponse) Reset() {
+	*x = SetProductPriceResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceResponse) ProtoMessage() {}
+
+func (x *SetProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*SetProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetProductPriceResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeleteProductPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductPriceRequest) Reset() {
+	*x = DeleteProductPriceRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductPriceRequest) ProtoMessage() {}
+
+func (x *DeleteProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteProductPriceRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *DeleteProductPriceRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type DeleteProductPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductPriceResponse) Reset() {
+	*x = DeleteProductPriceResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductPriceResponse) ProtoMessage() {}
+
+func (x *DeleteProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteProductPriceResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListProductPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductPricesRequest) Reset() {
+	*x = ListProductPricesRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductPricesRequest) ProtoMessage() {}
+
+func (x *ListProductPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductPricesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductPricesRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProductPricesRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListProductPricesResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         *common.Money          `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	IsDerived     bool                   `protobuf:"varint,2,opt,name=is_derived,json=isDerived,proto3" json:"is_derived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductPricesResponseItem) Reset() {
+	*x = ListProductPricesResponseItem{}
+	mi := &file_pricing_pricing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductPricesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductPricesResponseItem) ProtoMessage() {}
+
+func (x *ListProductPricesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductPricesResponseItem.ProtoReflect.Descriptor instead.
+func (*ListProductPricesResponseItem) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProductPricesResponseItem) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ListProductPricesResponseItem) GetIsDerived() bool {
+	if x != nil {
+		return x.IsDerived
+	}
+	return false
+}
+
+type ListProductPricesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The store currency price comes first.
+	Prices        []*ListProductPricesResponseItem `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductPricesResponse) Reset() {
+	*x = ListProductPricesResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductPricesResponse) ProtoMessage() {}
+
+func (x *ListProductPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductPricesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductPricesResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProductPricesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductPricesResponse) GetPrices() []*ListProductPricesResponseItem {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// One unit of the store currency is worth rate units of currency_code.
+type SetExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateRequest) ProtoMessage() {}
+
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetExchangeRateRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type SetExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateResponse) Reset() {
+	*x = SetExchangeRateResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateResponse) ProtoMessage() {}
+
+func (x *SetExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetExchangeRateResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{9}
+}
+
+type ListExchangeRatesResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponseItem) Reset() {
+	*x = ListExchangeRatesResponseItem{}
+	mi := &file_pricing_pricing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponseItem) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponseItem.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponseItem) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListExchangeRatesResponseItem) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesResponseItem) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesResponseItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListExchangeRatesResponse struct {
+	state            protoimpl.MessageState           `protogen:"open.v1"`
+	Base             *common.BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BaseCurrencyCode string                           `protobuf:"bytes,2,opt,name=base_currency_code,json=baseCurrencyCode,proto3" json:"base_currency_code,omitempty"`
+	Rates            []*ListExchangeRatesResponseItem `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListExchangeRatesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesResponse) GetBaseCurrencyCode() string {
+	if x != nil {
+		return x.BaseCurrencyCode
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ListExchangeRatesResponseItem {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// Computes prices in currency_code for every product from the exchange rate.
+type DeriveProductPricesRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Also replace prices that were set by hand.
+	Overwrite     bool `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeriveProductPricesRequest) Reset() {
+	*x = DeriveProductPricesRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeriveProductPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeriveProductPricesRequest) ProtoMessage() {}
+
+func (x *DeriveProductPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeriveProductPricesRequest.ProtoReflect.Descriptor instead.
+func (*DeriveProductPricesRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeriveProductPricesRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *DeriveProductPricesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type DeriveProductPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	UpdatedCount  int64                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeriveProductPricesResponse) Reset() {
+	*x = DeriveProductPricesResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeriveProductPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeriveProductPricesResponse) ProtoMessage() {}
+
+func (x *DeriveProductPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeriveProductPricesResponse.ProtoReflect.Descriptor instead.
+func (*DeriveProductPricesResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeriveProductPricesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeriveProductPricesResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+var File_pricing_pricing_proto protoreflect.FileDescriptor
+
+const file_pricing_pricing_proto_rawDesc = "" +
+	"\n" +
+	"\x15pricing/pricing.proto\x12\apricing\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
+	"\x16SetProductPriceRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x12+\n" +
+	"\x05price\x18\x02 \x01(\v2\r.common.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x05price\"C\n" +
+	"\x17SetProductPriceResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"~\n" +
+	"\x19DeleteProductPriceRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x129\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x14\xbaH\x11r\x0f2\r^[A-Za-z]{3}$R\fcurrencyCode\"F\n" +
+	"\x1aDeleteProductPriceResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"B\n" +
+	"\x18ListProductPricesRequest\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\"c\n" +
+	"\x1dListProductPricesResponseItem\x12#\n" +
+	"\x05price\x18\x01 \x01(\v2\r.common.MoneyR\x05price\x12\x1d\n" +
+	"\n" +
+	"is_derived\x18\x02 \x01(\bR\tisDerived\"\x85\x01\n" +
+	"\x19ListProductPricesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12>\n" +
+	"\x06prices\x18\x02 \x03(\v2&.pricing.ListProductPricesResponseItemR\x06prices\"w\n" +
+	"\x16SetExchangeRateRequest\x129\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x14\xbaH\x11r\x0f2\r^[A-Za-z]{3}$R\fcurrencyCode\x12\"\n" +
+	"\x04rate\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\"C\n" +
+	"\x17SetExchangeRateResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x1a\n" +
+	"\x18ListExchangeRatesRequest\"\x93\x01\n" +
+	"\x1dListExchangeRatesResponseItem\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x01\n" +
+	"\x19ListExchangeRatesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12,\n" +
+	"\x12base_currency_code\x18\x02 \x01(\tR\x10baseCurrencyCode\x12<\n" +
+	"\x05rates\x18\x03 \x03(\v2&.pricing.ListExchangeRatesResponseItemR\x05rates\"u\n" +
+	"\x1aDeriveProductPricesRequest\x129\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x14\xbaH\x11r\x0f2\r^[A-Za-z]{3}$R\fcurrencyCode\x12\x1c\n" +
+	"\toverwrite\x18\x02 \x01(\bR\toverwrite\"l\n" +
+	"\x1bDeriveProductPricesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x03R\fupdatedCount2\xb5\x04\n" +
+	"\x0ePricingService\x12T\n" +
+	"\x0fSetProductPrice\x12\x1f.pricing.SetProductPriceRequest\x1a .pricing.SetProductPriceResponse\x12]\n" +
+	"\x12DeleteProductPrice\x12\".pricing.DeleteProductPriceRequest\x1a#.pricing.DeleteProductPriceResponse\x12Z\n" +
+	"\x11ListProductPrices\x12!.pricing.ListProductPricesRequest\x1a\".pricing.ListProductPricesResponse\x12T\n" +
+	"\x0fSetExchangeRate\x12\x1f.pricing.SetExchangeRateRequest\x1a .pricing.SetExchangeRateResponse\x12Z\n" +
+	"\x11ListExchangeRates\x12!.pricing.ListExchangeRatesRequest\x1a\".pricing.ListExchangeRatesResponse\x12`\n" +
+	"\x13DeriveProductPrices\x12#.pricing.DeriveProductPricesRequest\x1a$.pricing.DeriveProductPricesResponseB\x8a\x01\n" +
+	"\vcom.pricingB\fPricingProtoP\x01Z1github.com/fahrillrizal/ecommerce-grpc/pb/pricing\xa2\x02\x03PXX\xaa\x02\aPricing\xca\x02\aPricing\xe2\x02\x13Pricing\\GPBMetadata\xea\x02\aPricingb\x06proto3"
+
+var (
+	file_pricing_pricing_proto_rawDescOnce sync.Once
+	file_pricing_pricing_proto_rawDescData []byte
+)
+
+func file_pricing_pricing_proto_rawDescGZIP() []byte {
+	file_pricing_pricing_proto_rawDescOnce.Do(func() {
+		file_pricing_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pricing_pricing_proto_rawDesc), len(file_pricing_pricing_proto_rawDesc)))
+	})
+	return file_pricing_pricing_proto_rawDescData
+}
+
+var file_pricing_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_pricing_pricing_proto_goTypes = []any{
+	(*SetProductPriceRequest)(nil),        // 0: pricing.SetProductPriceRequest
+	(*SetProductPriceResponse)(nil),       // 1: pricing.SetProductPriceResponse
+	(*DeleteProductPriceRequest)(nil),     // 2: pricing.DeleteProductPriceRequest
+	(*DeleteProductPriceResponse)(nil),    // 3: pricing.DeleteProductPriceResponse
+	(*ListProductPricesRequest)(nil),      // 4: pricing.ListProductPricesRequest
+	(*ListProductPricesResponseItem)(nil), // 5: pricing.ListProductPricesResponseItem
+	(*ListProductPricesResponse)(nil),     // 6: pricing.ListProductPricesResponse
+	(*SetExchangeRateRequest)(nil),        // 7: pricing.SetExchangeRateRequest
+	(*SetExchangeRateResponse)(nil),       // 8: pricing.SetExchangeRateResponse
+	(*ListExchangeRatesRequest)(nil),      // 9: pricing.ListExchangeRatesRequest
+	(*ListExchangeRatesResponseItem)(nil), // 10: pricing.ListExchangeRatesResponseItem
+	(*ListExchangeRatesResponse)(nil),     // 11: pricing.ListExchangeRatesResponse
+	(*DeriveProductPricesRequest)(nil),    // 12: pricing.DeriveProductPricesRequest
+	(*DeriveProductPricesResponse)(nil),   // 13: pricing.DeriveProductPricesResponse
+	(*common.Money)(nil),                  // 14: common.Money
+	(*common.BaseResponse)(nil),           // 15: common.BaseResponse
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
+}
+var file_pricing_pricing_proto_depIdxs = []int32{
+	14, // 0: pricing.SetProductPriceRequest.price:type_name -> common.Money
+	15, // 1: pricing.SetProductPriceResponse.base:type_name -> common.BaseResponse
+	15, // 2: pricing.DeleteProductPriceResponse.base:type_name -> common.BaseResponse
+	14, // 3: pricing.ListProductPricesResponseItem.price:type_name -> common.Money
+	15, // 4: pricing.ListProductPricesResponse.base:type_name -> common.BaseResponse
+	5,  // 5: pricing.ListProductPricesResponse.prices:type_name -> pricing.ListProductPricesResponseItem
+	15, // 6: pricing.SetExchangeRateResponse.base:type_name -> common.BaseResponse
+	16, // 7: pricing.ListExchangeRatesResponseItem.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 8: pricing.ListExchangeRatesResponse.base:type_name -> common.BaseResponse
+	10, // 9: pricing.ListExchangeRatesResponse.rates:type_name -> pricing.ListExchangeRatesResponseItem
+	15, // 10: pricing.DeriveProductPricesResponse.base:type_name -> common.BaseResponse
+	0,  // 11: pricing.PricingService.SetProductPrice:input_type -> pricing.SetProductPriceRequest
+	2,  // 12: pricing.PricingService.DeleteProductPrice:input_type -> pricing.DeleteProductPriceRequest
+	4,  // 13: pricing.PricingService.ListProductPrices:input_type -> pricing.ListProductPricesRequest
+	7,  // 14: pricing.PricingService.SetExchangeRate:input_type -> pricing.SetExchangeRateRequest
+	9,  // 15: pricing.PricingService.ListExchangeRates:input_type -> pricing.ListExchangeRatesRequest
+	12, // 16: pricing.PricingService.DeriveProductPrices:input_type -> pricing.DeriveProductPricesRequest
+	1,  // 17: pricing.PricingService.SetProductPrice:output_type -> pricing.SetProductPriceResponse
+	3,  // 18: pricing.PricingService.DeleteProductPrice:output_type -> pricing.DeleteProductPriceResponse
+	6,  // 19: pricing.PricingService.ListProductPrices:output_type -> pricing.ListProductPricesResponse
+	8,  // 20: pricing.PricingService.SetExchangeRate:output_type -> pricing.SetExchangeRateResponse
+	11, // 21: pricing.PricingService.ListExchangeRates:output_type -> pricing.ListExchangeRatesResponse
+	13, // 22: pricing.PricingService.DeriveProductPrices:output_type -> pricing.DeriveProductPricesResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_pricing_pricing_proto_init() }
+func file_pricing_pricing_proto_init() {
+	if File_pricing_pricing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pricing_pricing_proto_rawDesc), len(file_pricing_pricing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pricing_pricing_proto_goTypes,
+		DependencyIndexes: file_pricing_pricing_proto_depIdxs,
+		MessageInfos:      file_pricing_pricing_proto_msgTypes,
+	}.Build()
+	File_pricing_pricing_proto = out.File
+	file_pricing_pricing_proto_goTypes = nil
+	file_pricing_pricing_proto_depIdxs = nil
+}
@@ -27,10 +27,15 @@ type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	ImageData     []byte                 `protobuf:"bytes,5,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
 	ImageFilename string                 `protobuf:"bytes,6,opt,name=image_filename,json=imageFilename,proto3" json:"image_filename,omitempty"`
+	Stock         *int32                 `protobuf:"varint,7,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	MaxPerOrder   *int32                 `protobuf:"varint,8,opt,name=max_per_order,json=maxPerOrder,proto3,oneof" json:"max_per_order,omitempty"`
+	Category      string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	// Shipping weight in grams.
+	WeightGrams   int32 `protobuf:"varint,11,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -79,11 +84,11 @@ func (x *CreateProductRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *CreateProductRequest) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *CreateProductRequest) GetImageUrl() string {
@@ -107,6 +112,34 @@ func (x *CreateProductRequest) GetImageFilename() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetMaxPerOrder() int32 {
+	if x != nil && x.MaxPerOrder != nil {
+		return *x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -160,8 +193,10 @@ func (x *CreateProductResponse) GetId() string {
 }
 
 type DetailProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,14 +238,26 @@ func (x *DetailProductRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *DetailProductRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type DetailProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Base        *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id          uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Unset when the product has no price in the requested currency.
+	Price         *common.Money `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         *int32        `protobuf:"varint,7,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	MaxPerOrder   *int32        `protobuf:"varint,8,opt,name=max_per_order,json=maxPerOrder,proto3,oneof" json:"max_per_order,omitempty"`
+	Category      string        `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	WeightGrams   int32         `protobuf:"varint,11,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -273,11 +320,11 @@ func (x *DetailProductResponse) GetDescription() string {
 	return ""
 }
 
-func (x *DetailProductResponse) GetPrice() float64 {
+func (x *DetailProductResponse) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *DetailProductResponse) GetImageUrl() string {
@@ -287,15 +334,48 @@ func (x *DetailProductResponse) GetImageUrl() string {
 	return ""
 }
 
+func (x *DetailProductResponse) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetMaxPerOrder() int32 {
+	if x != nil && x.MaxPerOrder != nil {
+		return *x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
 type UpdateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	ImageData     []byte                 `protobuf:"bytes,6,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
 	ImageFilename string                 `protobuf:"bytes,7,opt,name=image_filename,json=imageFilename,proto3" json:"image_filename,omitempty"`
+	Stock         *int32                 `protobuf:"varint,8,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	// 0 removes the limit.
+	MaxPerOrder   *int32 `protobuf:"varint,9,opt,name=max_per_order,json=maxPerOrder,proto3,oneof" json:"max_per_order,omitempty"`
+	Category      string `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	WeightGrams   *int32 `protobuf:"varint,12,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,11 +431,11 @@ func (x *UpdateProductRequest) GetDescription() string {
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPrice() float64 {
+func (x *UpdateProductRequest) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateProductRequest) GetImageUrl() string {
@@ -379,14 +459,46 @@ func (x *UpdateProductRequest) GetImageFilename() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetMaxPerOrder() int32 {
+	if x != nil && x.MaxPerOrder != nil {
+		return *x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
 type UpdateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         *int32                 `protobuf:"varint,7,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	MaxPerOrder   *int32                 `protobuf:"varint,8,opt,name=max_per_order,json=maxPerOrder,proto3,oneof" json:"max_per_order,omitempty"`
+	Category      string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	WeightGrams   int32                  `protobuf:"varint,11,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -449,11 +561,11 @@ func (x *UpdateProductResponse) GetDescription() string {
 	return ""
 }
 
-func (x *UpdateProductResponse) GetPrice() float64 {
+func (x *UpdateProductResponse) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateProductResponse) GetImageUrl() string {
@@ -463,6 +575,34 @@ func (x *UpdateProductResponse) GetImageUrl() string {
 	return ""
 }
 
+func (x *UpdateProductResponse) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductResponse) GetMaxPerOrder() int32 {
+	if x != nil && x.MaxPerOrder != nil {
+		return *x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *UpdateProductResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductResponse) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
 type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -552,8 +692,10 @@ func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
 }
 
 type ListProductRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -595,13 +737,21 @@ func (x *ListProductRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *ListProductRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type ListProductResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Unset when the product has no price in the requested currency.
+	Price         *common.Money `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -657,11 +807,11 @@ func (x *ListProductResponseItem) GetDescription() string {
 	return ""
 }
 
-func (x *ListProductResponseItem) GetPrice() float64 {
+func (x *ListProductResponseItem) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *ListProductResponseItem) GetImageUrl() string {
@@ -780,8 +930,11 @@ type ListProductAdminResponseItem struct {
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *common.Money          `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         *int32                 `protobuf:"varint,6,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	MaxPerOrder   *int32                 `protobuf:"varint,7,opt,name=max_per_order,json=maxPerOrder,proto3,oneof" json:"max_per_order,omitempty"`
+	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -837,11 +990,11 @@ func (x *ListProductAdminResponseItem) GetDescription() string {
 	return ""
 }
 
-func (x *ListProductAdminResponseItem) GetPrice() float64 {
+func (x *ListProductAdminResponseItem) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *ListProductAdminResponseItem) GetImageUrl() string {
@@ -851,6 +1004,27 @@ func (x *ListProductAdminResponseItem) GetImageUrl() string {
 	return ""
 }
 
+func (x *ListProductAdminResponseItem) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *ListProductAdminResponseItem) GetMaxPerOrder() int32 {
+	if x != nil && x.MaxPerOrder != nil {
+		return *x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *ListProductAdminResponseItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type ListProductAdminResponse struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -912,7 +1086,9 @@ func (x *ListProductAdminResponse) GetData() []*ListProductAdminResponseItem {
 }
 
 type HighlightProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -947,13 +1123,21 @@ func (*HighlightProductsRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{14}
 }
 
+func (x *HighlightProductsRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type HighlightProductsResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Unset when the product has no price in the requested currency.
+	Price         *common.Money `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1009,11 +1193,11 @@ func (x *HighlightProductsResponseItem) GetDescription() string {
 	return ""
 }
 
-func (x *HighlightProductsResponseItem) GetPrice() float64 {
+func (x *HighlightProductsResponseItem) GetPrice() *common.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *HighlightProductsResponseItem) GetImageUrl() string {
@@ -1079,59 +1263,90 @@ var File_product_product_proto protoreflect.FileDescriptor
 
 const file_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\"\xed\x01\n" +
+	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\"\xbd\x03\n" +
 	"\x14CreateProductRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\vdescription\x12$\n" +
-	"\x05price\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12\x1b\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\vdescription\x12+\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\r.common.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x05price\x12\x1b\n" +
 	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1d\n" +
 	"\n" +
 	"image_data\x18\x05 \x01(\fR\timageData\x12%\n" +
-	"\x0eimage_filename\x18\x06 \x01(\tR\rimageFilename\"Q\n" +
+	"\x0eimage_filename\x18\x06 \x01(\tR\rimageFilename\x12\"\n" +
+	"\x05stock\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x00R\x05stock\x88\x01\x01\x120\n" +
+	"\rmax_per_order\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\vmaxPerOrder\x88\x01\x01\x12#\n" +
+	"\bcategory\x18\t \x01(\tB\a\xbaH\x04r\x02\x18dR\bcategory\x12*\n" +
+	"\fweight_grams\x18\v \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\vweightGramsB\b\n" +
+	"\x06_stockB\x10\n" +
+	"\x0e_max_per_orderJ\x04\b\x03\x10\x04\"Q\n" +
 	"\x15CreateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"&\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"d\n" +
 	"\x14DetailProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\xba\x01\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12<\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"\xee\x02\n" +
 	"\x15DetailProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"\xf2\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x19\n" +
+	"\x05stock\x18\a \x01(\x05H\x00R\x05stock\x88\x01\x01\x12'\n" +
+	"\rmax_per_order\x18\b \x01(\x05H\x01R\vmaxPerOrder\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12!\n" +
+	"\fweight_grams\x18\v \x01(\x05R\vweightGramsB\b\n" +
+	"\x06_stockB\x10\n" +
+	"\x0e_max_per_orderJ\x04\b\x05\x10\x06\"\xe0\x03\n" +
 	"\x14UpdateProductRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1c\n" +
 	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x04name\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12#\n" +
+	"\x05price\x18\v \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
 	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1d\n" +
 	"\n" +
 	"image_data\x18\x06 \x01(\fR\timageData\x12%\n" +
-	"\x0eimage_filename\x18\a \x01(\tR\rimageFilename\"\xba\x01\n" +
+	"\x0eimage_filename\x18\a \x01(\tR\rimageFilename\x12\"\n" +
+	"\x05stock\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x00R\x05stock\x88\x01\x01\x120\n" +
+	"\rmax_per_order\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\vmaxPerOrder\x88\x01\x01\x12#\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x18dR\bcategory\x12/\n" +
+	"\fweight_grams\x18\f \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x02R\vweightGrams\x88\x01\x01B\b\n" +
+	"\x06_stockB\x10\n" +
+	"\x0e_max_per_orderB\x0f\n" +
+	"\r_weight_gramsJ\x04\b\x04\x10\x05\"\xee\x02\n" +
 	"\x15UpdateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"&\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x19\n" +
+	"\x05stock\x18\a \x01(\x05H\x00R\x05stock\x88\x01\x01\x12'\n" +
+	"\rmax_per_order\x18\b \x01(\x05H\x01R\vmaxPerOrder\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12!\n" +
+	"\fweight_grams\x18\v \x01(\x05R\vweightGramsB\b\n" +
+	"\x06_stockB\x10\n" +
+	"\x0e_max_per_orderJ\x04\b\x05\x10\x06\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"A\n" +
 	"\x15DeleteProductResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"O\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x8d\x01\n" +
 	"\x12ListProductRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x92\x01\n" +
+	"pagination\x12<\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"\xa7\x01\n" +
 	"\x17ListProductResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xb1\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\x05price\x18\x06 \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrlJ\x04\b\x04\x10\x05\"\xb1\x01\n" +
 	"\x13ListProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
@@ -1141,26 +1356,32 @@ const file_product_product_proto_rawDesc = "" +
 	"\x17ListProductAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x97\x01\n" +
+	"pagination\"\xa8\x02\n" +
 	"\x1cListProductAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xbb\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\x05price\x18\t \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x19\n" +
+	"\x05stock\x18\x06 \x01(\x05H\x00R\x05stock\x88\x01\x01\x12'\n" +
+	"\rmax_per_order\x18\a \x01(\x05H\x01R\vmaxPerOrder\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategoryB\b\n" +
+	"\x06_stockB\x10\n" +
+	"\x0e_max_per_orderJ\x04\b\x04\x10\x05\"\xbb\x01\n" +
 	"\x18ListProductAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x129\n" +
-	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"\x1a\n" +
-	"\x18HighlightProductsRequest\"\x98\x01\n" +
+	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"X\n" +
+	"\x18HighlightProductsRequest\x12<\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"\xad\x01\n" +
 	"\x1dHighlightProductsResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\x81\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\x05price\x18\x06 \x01(\v2\r.common.MoneyR\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrlJ\x04\b\x04\x10\x05\"\x81\x01\n" +
 	"\x19HighlightProductsResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\x04data\x18\x02 \x03(\v2&.product.HighlightProductsResponseItemR\x04data2\xcf\x04\n" +
@@ -1205,44 +1426,52 @@ var file_product_product_proto_goTypes = []any{
 	(*HighlightProductsRequest)(nil),      // 14: product.HighlightProductsRequest
 	(*HighlightProductsResponseItem)(nil), // 15: product.HighlightProductsResponseItem
 	(*HighlightProductsResponse)(nil),     // 16: product.HighlightProductsResponse
-	(*common.BaseResponse)(nil),           // 17: common.BaseResponse
-	(*common.PaginationRequest)(nil),      // 18: common.PaginationRequest
-	(*common.PaginationResponse)(nil),     // 19: common.PaginationResponse
+	(*common.Money)(nil),                  // 17: common.Money
+	(*common.BaseResponse)(nil),           // 18: common.BaseResponse
+	(*common.PaginationRequest)(nil),      // 19: common.PaginationRequest
+	(*common.PaginationResponse)(nil),     // 20: common.PaginationResponse
 }
 var file_product_product_proto_depIdxs = []int32{
-	17, // 0: product.CreateProductResponse.base:type_name -> common.BaseResponse
-	17, // 1: product.DetailProductResponse.base:type_name -> common.BaseResponse
-	17, // 2: product.UpdateProductResponse.base:type_name -> common.BaseResponse
-	17, // 3: product.DeleteProductResponse.base:type_name -> common.BaseResponse
-	18, // 4: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
-	17, // 5: product.ListProductResponse.base:type_name -> common.BaseResponse
-	19, // 6: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 7: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
-	18, // 8: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
-	17, // 9: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
-	19, // 10: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
-	12, // 11: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
-	17, // 12: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
-	15, // 13: product.HighlightProductsResponse.data:type_name -> product.HighlightProductsResponseItem
-	0,  // 14: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	2,  // 15: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
-	4,  // 16: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	6,  // 17: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	8,  // 18: product.ProductService.ListProduct:input_type -> product.ListProductRequest
-	11, // 19: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
-	14, // 20: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
-	1,  // 21: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	3,  // 22: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
-	5,  // 23: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
-	7,  // 24: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	10, // 25: product.ProductService.ListProduct:output_type -> product.ListProductResponse
-	13, // 26: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
-	16, // 27: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	17, // 0: product.CreateProductRequest.price:type_name -> common.Money
+	18, // 1: product.CreateProductResponse.base:type_name -> common.BaseResponse
+	18, // 2: product.DetailProductResponse.base:type_name -> common.BaseResponse
+	17, // 3: product.DetailProductResponse.price:type_name -> common.Money
+	17, // 4: product.UpdateProductRequest.price:type_name -> common.Money
+	18, // 5: product.UpdateProductResponse.base:type_name -> common.BaseResponse
+	17, // 6: product.UpdateProductResponse.price:type_name -> common.Money
+	18, // 7: product.DeleteProductResponse.base:type_name -> common.BaseResponse
+	19, // 8: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
+	17, // 9: product.ListProductResponseItem.price:type_name -> common.Money
+	18, // 10: product.ListProductResponse.base:type_name -> common.BaseResponse
+	20, // 11: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 12: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
+	19, // 13: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
+	17, // 14: product.ListProductAdminResponseItem.price:type_name -> common.Money
+	18, // 15: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
+	20, // 16: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
+	12, // 17: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
+	17, // 18: product.HighlightProductsResponseItem.price:type_name -> common.Money
+	18, // 19: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
+	15, // 20: product.HighlightProductsResponse.data:type_name -> product.HighlightProductsResponseItem
+	0,  // 21: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	2,  // 22: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
+	4,  // 23: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	6,  // 24: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	8,  // 25: product.ProductService.ListProduct:input_type -> product.ListProductRequest
+	11, // 26: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
+	14, // 27: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
+	1,  // 28: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	3,  // 29: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
+	5,  // 30: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	7,  // 31: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	10, // 32: product.ProductService.ListProduct:output_type -> product.ListProductResponse
+	13, // 33: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
+	16, // 34: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
+	28, // [28:35] is the sub-list for method output_type
+	21, // [21:28] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1250,6 +1479,11 @@ func file_product_product_proto_init() {
 	if File_product_product_proto != nil {
 		return
 	}
+	file_product_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[3].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[4].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[5].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,1386 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: promotion/promotion.proto
+
+package promotion
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePromotionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// "percentage" or "fixed".
+	DiscountType string `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// Percent off, used by percentage promotions.
+	DiscountValue float64 `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// Caps a percentage discount when set.
+	MaxDiscount  *common.Money          `protobuf:"bytes,15,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	MinSpend     *common.Money          `protobuf:"bytes,16,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit   *int32                 `protobuf:"varint,8,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	PerUserLimit *int32                 `protobuf:"varint,9,opt,name=per_user_limit,json=perUserLimit,proto3,oneof" json:"per_user_limit,omitempty"`
+	StartsAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive     bool                   `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ProductIds   []uint64               `protobuf:"varint,13,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories   []string               `protobuf:"bytes,14,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Amount off, used by fixed promotions.
+	DiscountAmount *common.Money `protobuf:"bytes,17,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxDiscount() *common.Money {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetMinSpend() *common.Money {
+	if x != nil {
+		return x.MinSpend
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetPerUserLimit() int32 {
+	if x != nil && x.PerUserLimit != nil {
+		return *x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreatePromotionRequest) GetProductIds() []uint64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetDiscountAmount() *common.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePromotionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreatePromotionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Replaces every field of the promotion except its code.
+type UpdatePromotionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue  float64                `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	MaxDiscount    *common.Money          `protobuf:"bytes,15,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	MinSpend       *common.Money          `protobuf:"bytes,16,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit     *int32                 `protobuf:"varint,8,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	PerUserLimit   *int32                 `protobuf:"varint,9,opt,name=per_user_limit,json=perUserLimit,proto3,oneof" json:"per_user_limit,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive       bool                   `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ProductIds     []uint64               `protobuf:"varint,13,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories     []string               `protobuf:"bytes,14,rep,name=categories,proto3" json:"categories,omitempty"`
+	DiscountAmount *common.Money          `protobuf:"bytes,17,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePromotionRequest) Reset() {
+	*x = UpdatePromotionRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromotionRequest) ProtoMessage() {}
+
+func (x *UpdatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdatePromotionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePromotionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePromotionRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *UpdatePromotionRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetMaxDiscount() *common.Money {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetMinSpend() *common.Money {
+	if x != nil {
+		return x.MinSpend
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetPerUserLimit() int32 {
+	if x != nil && x.PerUserLimit != nil {
+		return *x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdatePromotionRequest) GetProductIds() []uint64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetDiscountAmount() *common.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type UpdatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromotionResponse) Reset() {
+	*x = UpdatePromotionResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromotionResponse) ProtoMessage() {}
+
+func (x *UpdatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePromotionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeletePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromotionRequest) Reset() {
+	*x = DeletePromotionRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromotionRequest) ProtoMessage() {}
+
+func (x *DeletePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromotionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePromotionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromotionResponse) Reset() {
+	*x = DeletePromotionResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromotionResponse) ProtoMessage() {}
+
+func (x *DeletePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromotionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePromotionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DetailPromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailPromotionRequest) Reset() {
+	*x = DetailPromotionRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailPromotionRequest) ProtoMessage() {}
+
+func (x *DetailPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailPromotionRequest.ProtoReflect.Descriptor instead.
+func (*DetailPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DetailPromotionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DetailPromotionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id             uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,6,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue  float64                `protobuf:"fixed64,7,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	MaxDiscount    *common.Money          `protobuf:"bytes,18,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	MinSpend       *common.Money          `protobuf:"bytes,19,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit     *int32                 `protobuf:"varint,10,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	PerUserLimit   *int32                 `protobuf:"varint,11,opt,name=per_user_limit,json=perUserLimit,proto3,oneof" json:"per_user_limit,omitempty"`
+	UsedCount      int32                  `protobuf:"varint,12,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive       bool                   `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ProductIds     []uint64               `protobuf:"varint,16,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories     []string               `protobuf:"bytes,17,rep,name=categories,proto3" json:"categories,omitempty"`
+	DiscountAmount *common.Money          `protobuf:"bytes,20,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DetailPromotionResponse) Reset() {
+	*x = DetailPromotionResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailPromotionResponse) ProtoMessage() {}
+
+func (x *DetailPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailPromotionResponse.ProtoReflect.Descriptor instead.
+func (*DetailPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DetailPromotionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DetailPromotionResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DetailPromotionResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailPromotionResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DetailPromotionResponse) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *DetailPromotionResponse) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *DetailPromotionResponse) GetMaxDiscount() *common.Money {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetMinSpend() *common.Money {
+	if x != nil {
+		return x.MinSpend
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *DetailPromotionResponse) GetPerUserLimit() int32 {
+	if x != nil && x.PerUserLimit != nil {
+		return *x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *DetailPromotionResponse) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *DetailPromotionResponse) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *DetailPromotionResponse) GetProductIds() []uint64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *DetailPromotionResponse) GetDiscountAmount() *common.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type ListPromotionRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionRequest) Reset() {
+	*x = ListPromotionRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionRequest) ProtoMessage() {}
+
+func (x *ListPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPromotionRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListPromotionResponseItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue  float64                `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	UsedCount      int32                  `protobuf:"varint,6,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	UsageLimit     *int32                 `protobuf:"varint,7,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive       bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DiscountAmount *common.Money          `protobuf:"bytes,11,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPromotionResponseItem) Reset() {
+	*x = ListPromotionResponseItem{}
+	mi := &file_promotion_promotion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionResponseItem) ProtoMessage() {}
+
+func (x *ListPromotionResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionResponseItem.ProtoReflect.Descriptor instead.
+func (*ListPromotionResponseItem) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPromotionResponseItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListPromotionResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListPromotionResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPromotionResponseItem) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *ListPromotionResponseItem) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *ListPromotionResponseItem) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *ListPromotionResponseItem) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *ListPromotionResponseItem) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ListPromotionResponseItem) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ListPromotionResponseItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ListPromotionResponseItem) GetDiscountAmount() *common.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+type ListPromotionResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Base          *common.BaseResponse         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*ListPromotionResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionResponse) Reset() {
+	*x = ListPromotionResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionResponse) ProtoMessage() {}
+
+func (x *ListPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPromotionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListPromotionResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListPromotionResponse) GetData() []*ListPromotionResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Checks a coupon against the caller's current cart.
+type ValidateCouponRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCouponRequest) Reset() {
+	*x = ValidateCouponRequest{}
+	mi := &file_promotion_promotion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponRequest) ProtoMessage() {}
+
+func (x *ValidateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidateCouponRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ValidateCouponResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Base    *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IsValid bool                   `protobuf:"varint,2,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	// Why the coupon cannot be used when is_valid is false.
+	Reason             string        `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Code               string        `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Subtotal           *common.Money `protobuf:"bytes,9,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount           *common.Money `protobuf:"bytes,10,opt,name=discount,proto3" json:"discount,omitempty"`
+	Total              *common.Money `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	EligibleProductIds []uint64      `protobuf:"varint,8,rep,packed,name=eligible_product_ids,json=eligibleProductIds,proto3" json:"eligible_product_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ValidateCouponResponse) Reset() {
+	*x = ValidateCouponResponse{}
+	mi := &file_promotion_promotion_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponResponse) ProtoMessage() {}
+
+func (x *ValidateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promotion_promotion_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_promotion_promotion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidateCouponResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ValidateCouponResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateCouponResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidateCouponResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidateCouponResponse) GetSubtotal() *common.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *ValidateCouponResponse) GetDiscount() *common.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ValidateCouponResponse) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ValidateCouponResponse) GetEligibleProductIds() []uint64 {
+	if x != nil {
+		return x.EligibleProductIds
+	}
+	return nil
+}
+
+var File_promotion_promotion_proto protoreflect.FileDescriptor
+
+const file_promotion_promotion_proto_rawDesc = "" +
+	"\n" +
+	"\x19promotion/promotion.proto\x12\tpromotion\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x06\n" +
+	"\x16CreatePromotionRequest\x12/\n" +
+	"\x04code\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04code\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\rdiscount_type\x18\x04 \x01(\tB\x18\xbaH\x15r\x13R\n" +
+	"percentageR\x05fixedR\fdiscountType\x12>\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\rdiscountValue\x120\n" +
+	"\fmax_discount\x18\x0f \x01(\v2\r.common.MoneyR\vmaxDiscount\x12*\n" +
+	"\tmin_spend\x18\x10 \x01(\v2\r.common.MoneyR\bminSpend\x12-\n" +
+	"\vusage_limit\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\n" +
+	"usageLimit\x88\x01\x01\x122\n" +
+	"\x0eper_user_limit\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\fperUserLimit\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\f \x01(\bR\bisActive\x12\x1f\n" +
+	"\vproduct_ids\x18\r \x03(\x04R\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x0e \x03(\tR\n" +
+	"categories\x126\n" +
+	"\x0fdiscount_amount\x18\x11 \x01(\v2\r.common.MoneyR\x0ediscountAmountB\x0e\n" +
+	"\f_usage_limitB\x11\n" +
+	"\x0f_per_user_limitJ\x04\b\x06\x10\aJ\x04\b\a\x10\b\"S\n" +
+	"\x17CreatePromotionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"\xf0\x05\n" +
+	"\x16UpdatePromotionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\rdiscount_type\x18\x04 \x01(\tB\x18\xbaH\x15r\x13R\n" +
+	"percentageR\x05fixedR\fdiscountType\x12>\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\rdiscountValue\x120\n" +
+	"\fmax_discount\x18\x0f \x01(\v2\r.common.MoneyR\vmaxDiscount\x12*\n" +
+	"\tmin_spend\x18\x10 \x01(\v2\r.common.MoneyR\bminSpend\x12-\n" +
+	"\vusage_limit\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\n" +
+	"usageLimit\x88\x01\x01\x122\n" +
+	"\x0eper_user_limit\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\fperUserLimit\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\f \x01(\bR\bisActive\x12\x1f\n" +
+	"\vproduct_ids\x18\r \x03(\x04R\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x0e \x03(\tR\n" +
+	"categories\x126\n" +
+	"\x0fdiscount_amount\x18\x11 \x01(\v2\r.common.MoneyR\x0ediscountAmountB\x0e\n" +
+	"\f_usage_limitB\x11\n" +
+	"\x0f_per_user_limitJ\x04\b\x06\x10\aJ\x04\b\a\x10\b\"C\n" +
+	"\x17UpdatePromotionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"1\n" +
+	"\x16DeletePromotionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"C\n" +
+	"\x17DeletePromotionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"1\n" +
+	"\x16DetailPromotionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"\xea\x05\n" +
+	"\x17DetailPromotionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x06 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\a \x01(\x01R\rdiscountValue\x120\n" +
+	"\fmax_discount\x18\x12 \x01(\v2\r.common.MoneyR\vmaxDiscount\x12*\n" +
+	"\tmin_spend\x18\x13 \x01(\v2\r.common.MoneyR\bminSpend\x12$\n" +
+	"\vusage_limit\x18\n" +
+	" \x01(\x05H\x00R\n" +
+	"usageLimit\x88\x01\x01\x12)\n" +
+	"\x0eper_user_limit\x18\v \x01(\x05H\x01R\fperUserLimit\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\f \x01(\x05R\tusedCount\x127\n" +
+	"\tstarts_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\x0f \x01(\bR\bisActive\x12\x1f\n" +
+	"\vproduct_ids\x18\x10 \x03(\x04R\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x11 \x03(\tR\n" +
+	"categories\x126\n" +
+	"\x0fdiscount_amount\x18\x14 \x01(\v2\r.common.MoneyR\x0ediscountAmountB\x0e\n" +
+	"\f_usage_limitB\x11\n" +
+	"\x0f_per_user_limitJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"\"Q\n" +
+	"\x14ListPromotionRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xb7\x03\n" +
+	"\x19ListPromotionResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01R\rdiscountValue\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\x06 \x01(\x05R\tusedCount\x12$\n" +
+	"\vusage_limit\x18\a \x01(\x05H\x00R\n" +
+	"usageLimit\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\n" +
+	" \x01(\bR\bisActive\x126\n" +
+	"\x0fdiscount_amount\x18\v \x01(\v2\r.common.MoneyR\x0ediscountAmountB\x0e\n" +
+	"\f_usage_limit\"\xb7\x01\n" +
+	"\x15ListPromotionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x128\n" +
+	"\x04data\x18\x03 \x03(\v2$.promotion.ListPromotionResponseItemR\x04data\"t\n" +
+	"\x15ValidateCouponRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04code\x12<\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"\xc8\x02\n" +
+	"\x16ValidateCouponResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x19\n" +
+	"\bis_valid\x18\x02 \x01(\bR\aisValid\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12)\n" +
+	"\bsubtotal\x18\t \x01(\v2\r.common.MoneyR\bsubtotal\x12)\n" +
+	"\bdiscount\x18\n" +
+	" \x01(\v2\r.common.MoneyR\bdiscount\x12#\n" +
+	"\x05total\x18\v \x01(\v2\r.common.MoneyR\x05total\x120\n" +
+	"\x14eligible_product_ids\x18\b \x03(\x04R\x12eligibleProductIdsJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aJ\x04\b\a\x10\b2\xa5\x04\n" +
+	"\x10PromotionService\x12X\n" +
+	"\x0fCreatePromotion\x12!.promotion.CreatePromotionRequest\x1a\".promotion.CreatePromotionResponse\x12X\n" +
+	"\x0fUpdatePromotion\x12!.promotion.UpdatePromotionRequest\x1a\".promotion.UpdatePromotionResponse\x12X\n" +
+	"\x0fDeletePromotion\x12!.promotion.DeletePromotionRequest\x1a\".promotion.DeletePromotionResponse\x12X\n" +
+	"\x0fDetailPromotion\x12!.promotion.DetailPromotionRequest\x1a\".promotion.DetailPromotionResponse\x12R\n" +
+	"\rListPromotion\x12\x1f.promotion.ListPromotionRequest\x1a .promotion.ListPromotionResponse\x12U\n" +
+	"\x0eValidateCoupon\x12 .promotion.ValidateCouponRequest\x1a!.promotion.ValidateCouponResponseB\x98\x01\n" +
+	"\rcom.promotionB\x0ePromotionProtoP\x01Z3github.com/fahrillrizal/ecommerce-grpc/pb/promotion\xa2\x02\x03PXX\xaa\x02\tPromotion\xca\x02\tPromotion\xe2\x02\x15Promotion\\GPBMetadata\xea\x02\tPromotionb\x06proto3"
+
+var (
+	file_promotion_promotion_proto_rawDescOnce sync.Once
+	file_promotion_promotion_proto_rawDescData []byte
+)
+
+func file_promotion_promotion_proto_rawDescGZIP() []byte {
+	file_promotion_promotion_proto_rawDescOnce.Do(func() {
+		file_promotion_promotion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_promotion_promotion_proto_rawDesc), len(file_promotion_promotion_proto_rawDesc)))
+	})
+	return file_promotion_promotion_proto_rawDescData
+}
+
+var file_promotion_promotion_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_promotion_promotion_proto_goTypes = []any{
+	(*CreatePromotionRequest)(nil),    // 0: promotion.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),   // 1: promotion.CreatePromotionResponse
+	(*UpdatePromotionRequest)(nil),    // 2: promotion.UpdatePromotionRequest
+	(*UpdatePromotionResponse)(nil),   // 3: promotion.UpdatePromotionResponse
+	(*DeletePromotionRequest)(nil),    // 4: promotion.DeletePromotionRequest
+	(*DeletePromotionResponse)(nil),   // 5: promotion.DeletePromotionResponse
+	(*DetailPromotionRequest)(nil),    // 6: promotion.DetailPromotionRequest
+	(*DetailPromotionResponse)(nil),   // 7: promotion.DetailPromotionResponse
+	(*ListPromotionRequest)(nil),      // 8: promotion.ListPromotionRequest
+	(*ListPromotionResponseItem)(nil), // 9: promotion.ListPromotionResponseItem
+	(*ListPromotionResponse)(nil),     // 10: promotion.ListPromotionResponse
+	(*ValidateCouponRequest)(nil),     // 11: promotion.ValidateCouponRequest
+	(*ValidateCouponResponse)(nil),    // 12: promotion.ValidateCouponResponse
+	(*common.Money)(nil),              // 13: common.Money
+	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),       // 15: common.BaseResponse
+	(*common.PaginationRequest)(nil),  // 16: common.PaginationRequest
+	(*common.PaginationResponse)(nil), // 17: common.PaginationResponse
+}
+var file_promotion_promotion_proto_depIdxs = []int32{
+	13, // 0: promotion.CreatePromotionRequest.max_discount:type_name -> common.Money
+	13, // 1: promotion.CreatePromotionRequest.min_spend:type_name -> common.Money
+	14, // 2: promotion.CreatePromotionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	14, // 3: promotion.CreatePromotionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	13, // 4: promotion.CreatePromotionRequest.discount_amount:type_name -> common.Money
+	15, // 5: promotion.CreatePromotionResponse.base:type_name -> common.BaseResponse
+	13, // 6: promotion.UpdatePromotionRequest.max_discount:type_name -> common.Money
+	13, // 7: promotion.UpdatePromotionRequest.min_spend:type_name -> common.Money
+	14, // 8: promotion.UpdatePromotionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	14, // 9: promotion.UpdatePromotionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	13, // 10: promotion.UpdatePromotionRequest.discount_amount:type_name -> common.Money
+	15, // 11: promotion.UpdatePromotionResponse.base:type_name -> common.BaseResponse
+	15, // 12: promotion.DeletePromotionResponse.base:type_name -> common.BaseResponse
+	15, // 13: promotion.DetailPromotionResponse.base:type_name -> common.BaseResponse
+	13, // 14: promotion.DetailPromotionResponse.max_discount:type_name -> common.Money
+	13, // 15: promotion.DetailPromotionResponse.min_spend:type_name -> common.Money
+	14, // 16: promotion.DetailPromotionResponse.starts_at:type_name -> google.protobuf.Timestamp
+	14, // 17: promotion.DetailPromotionResponse.ends_at:type_name -> google.protobuf.Timestamp
+	13, // 18: promotion.DetailPromotionResponse.discount_amount:type_name -> common.Money
+	16, // 19: promotion.ListPromotionRequest.pagination:type_name -> common.PaginationRequest
+	14, // 20: promotion.ListPromotionResponseItem.starts_at:type_name -> google.protobuf.Timestamp
+	14, // 21: promotion.ListPromotionResponseItem.ends_at:type_name -> google.protobuf.Timestamp
+	13, // 22: promotion.ListPromotionResponseItem.discount_amount:type_name -> common.Money
+	15, // 23: promotion.ListPromotionResponse.base:type_name -> common.BaseResponse
+	17, // 24: promotion.ListPromotionResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 25: promotion.ListPromotionResponse.data:type_name -> promotion.ListPromotionResponseItem
+	15, // 26: promotion.ValidateCouponResponse.base:type_name -> common.BaseResponse
+	13, // 27: promotion.ValidateCouponResponse.subtotal:type_name -> common.Money
+	13, // 28: promotion.ValidateCouponResponse.discount:type_name -> common.Money
+	13, // 29: promotion.ValidateCouponResponse.total:type_name -> common.Money
+	0,  // 30: promotion.PromotionService.CreatePromotion:input_type -> promotion.CreatePromotionRequest
+	2,  // 31: promotion.PromotionService.UpdatePromotion:input_type -> promotion.UpdatePromotionRequest
+	4,  // 32: promotion.PromotionService.DeletePromotion:input_type -> promotion.DeletePromotionRequest
+	6,  // 33: promotion.PromotionService.DetailPromotion:input_type -> promotion.DetailPromotionRequest
+	8,  // 34: promotion.PromotionService.ListPromotion:input_type -> promotion.ListPromotionRequest
+	11, // 35: promotion.PromotionService.ValidateCoupon:input_type -> promotion.ValidateCouponRequest
+	1,  // 36: promotion.PromotionService.CreatePromotion:output_type -> promotion.CreatePromotionResponse
+	3,  // 37: promotion.PromotionService.UpdatePromotion:output_type -> promotion.UpdatePromotionResponse
+	5,  // 38: promotion.PromotionService.DeletePromotion:output_type -> promotion.DeletePromotionResponse
+	7,  // 39: promotion.PromotionService.DetailPromotion:output_type -> promotion.DetailPromotionResponse
+	10, // 40: promotion.PromotionService.ListPromotion:output_type -> promotion.ListPromotionResponse
+	12, // 41: promotion.PromotionService.ValidateCoupon:output_type -> promotion.ValidateCouponResponse
+	36, // [36:42] is the sub-list for method output_type
+	30, // [30:36] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_promotion_promotion_proto_init() }
+func file_promotion_promotion_proto_init() {
+	if File_promotion_promotion_proto != nil {
+		return
+	}
+	file_promotion_promotion_proto_msgTypes[0].OneofWrappers = []any{}
+	file_promotion_promotion_proto_msgTypes[2].OneofWrappers = []any{}
+	file_promotion_promotion_proto_msgTypes[7].OneofWrappers = []any{}
+	file_promotion_promotion_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_promotion_promotion_proto_rawDesc), len(file_promotion_promotion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_promotion_promotion_proto_goTypes,
+		DependencyIndexes: file_promotion_promotion_proto_depIdxs,
+		MessageInfos:      file_promotion_promotion_proto_msgTypes,
+	}.Build()
+	File_promotion_promotion_proto = out.File
+	file_promotion_promotion_proto_goTypes = nil
+	file_promotion_promotion_proto_depIdxs = nil
+}
@@ -0,0 +1,1568 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: returns/returns.proto
+
+package returns
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RequestReturnRequestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   uint64                 `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequestItem) Reset() {
+	*x = RequestReturnRequestItem{}
+	mi := &file_returns_returns_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequestItem) ProtoMessage() {}
+
+func (x *RequestReturnRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequestItem.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequestItem) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestReturnRequestItem) GetOrderItemId() uint64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *RequestReturnRequestItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	OrderId       string                      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items         []*RequestReturnRequestItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetItems() []*RequestReturnRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RequestReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Number        string                 `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnResponse) Reset() {
+	*x = RequestReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnResponse) ProtoMessage() {}
+
+func (x *RequestReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnResponse.ProtoReflect.Descriptor instead.
+func (*RequestReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RequestReturnResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RequestReturnResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type CancelReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReturnRequest) Reset() {
+	*x = CancelReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReturnRequest) ProtoMessage() {}
+
+func (x *CancelReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReturnRequest.ProtoReflect.Descriptor instead.
+func (*CancelReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelReturnRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReturnResponse) Reset() {
+	*x = CancelReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReturnResponse) ProtoMessage() {}
+
+func (x *CancelReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReturnResponse.ProtoReflect.Descriptor instead.
+func (*CancelReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ApproveReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReturnRequest) Reset() {
+	*x = ApproveReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReturnRequest) ProtoMessage() {}
+
+func (x *ApproveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ApproveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveReturnRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReturnResponse) Reset() {
+	*x = ApproveReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReturnResponse) ProtoMessage() {}
+
+func (x *ApproveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ApproveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type RejectReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectReturnRequest) Reset() {
+	*x = RejectReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReturnRequest) ProtoMessage() {}
+
+func (x *RejectReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReturnRequest.ProtoReflect.Descriptor instead.
+func (*RejectReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectReturnRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RejectReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectReturnResponse) Reset() {
+	*x = RejectReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReturnResponse) ProtoMessage() {}
+
+func (x *RejectReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReturnResponse.ProtoReflect.Descriptor instead.
+func (*RejectReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ReceiveReturnRequestItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ReturnItemId uint64                 `protobuf:"varint,1,opt,name=return_item_id,json=returnItemId,proto3" json:"return_item_id,omitempty"`
+	// Units that passed inspection. The rest are not refunded or restocked.
+	AcceptedQuantity int64  `protobuf:"varint,2,opt,name=accepted_quantity,json=acceptedQuantity,proto3" json:"accepted_quantity,omitempty"`
+	Note             string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequestItem) Reset() {
+	*x = ReceiveReturnRequestItem{}
+	mi := &file_returns_returns_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequestItem) ProtoMessage() {}
+
+func (x *ReceiveReturnRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequestItem.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequestItem) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReceiveReturnRequestItem) GetReturnItemId() uint64 {
+	if x != nil {
+		return x.ReturnItemId
+	}
+	return 0
+}
+
+func (x *ReceiveReturnRequestItem) GetAcceptedQuantity() int64 {
+	if x != nil {
+		return x.AcceptedQuantity
+	}
+	return 0
+}
+
+func (x *ReceiveReturnRequestItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReceiveReturnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Every item of the return must be inspected.
+	Items         []*ReceiveReturnRequestItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReceiveReturnRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReceiveReturnRequest) GetItems() []*ReceiveReturnRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReceiveReturnResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Base         *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RefundAmount *common.Money          `protobuf:"bytes,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// Empty when nothing was accepted, otherwise pending, succeeded or failed.
+	RefundStatus  string `protobuf:"bytes,3,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnResponse) Reset() {
+	*x = ReceiveReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnResponse) ProtoMessage() {}
+
+func (x *ReceiveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReceiveReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReceiveReturnResponse) GetRefundAmount() *common.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *ReceiveReturnResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+type ListReturnsRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Limits the list to one status.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_returns_returns_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListReturnsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListReturnsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListReturnsResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,3,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundAmount  *common.Money          `protobuf:"bytes,6,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	RefundStatus  string                 `protobuf:"bytes,7,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Number        string                 `protobuf:"bytes,9,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsResponseItem) Reset() {
+	*x = ListReturnsResponseItem{}
+	mi := &file_returns_returns_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsResponseItem) ProtoMessage() {}
+
+func (x *ListReturnsResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsResponseItem.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponseItem) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReturnsResponseItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListReturnsResponseItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListReturnsResponseItem) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *ListReturnsResponseItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReturnsResponseItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListReturnsResponseItem) GetRefundAmount() *common.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *ListReturnsResponseItem) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *ListReturnsResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListReturnsResponseItem) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type ListReturnsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*ListReturnsResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsResponse) Reset() {
+	*x = ListReturnsResponse{}
+	mi := &file_returns_returns_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsResponse) ProtoMessage() {}
+
+func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListReturnsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListReturnsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListReturnsResponse) GetData() []*ListReturnsResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DetailReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailReturnRequest) Reset() {
+	*x = DetailReturnRequest{}
+	mi := &file_returns_returns_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailReturnRequest) ProtoMessage() {}
+
+func (x *DetailReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailReturnRequest.ProtoReflect.Descriptor instead.
+func (*DetailReturnRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DetailReturnRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReturnItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderItemId uint64                 `protobuf:"varint,2,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity    int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Set once the goods have been inspected.
+	AcceptedQuantity *int64 `protobuf:"varint,5,opt,name=accepted_quantity,json=acceptedQuantity,proto3,oneof" json:"accepted_quantity,omitempty"`
+	InspectionNote   string `protobuf:"bytes,6,opt,name=inspection_note,json=inspectionNote,proto3" json:"inspection_note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_returns_returns_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItem) ProtoMessage() {}
+
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReturnItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetOrderItemId() uint64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetAcceptedQuantity() int64 {
+	if x != nil && x.AcceptedQuantity != nil {
+		return *x.AcceptedQuantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetInspectionNote() string {
+	if x != nil {
+		return x.InspectionNote
+	}
+	return ""
+}
+
+type DetailReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,4,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminNote     string                 `protobuf:"bytes,7,opt,name=admin_note,json=adminNote,proto3" json:"admin_note,omitempty"`
+	Items         []*ReturnItem          `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	RefundAmount  *common.Money          `protobuf:"bytes,9,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	RefundStatus  string                 `protobuf:"bytes,10,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	RefundedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Number        string                 `protobuf:"bytes,13,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailReturnResponse) Reset() {
+	*x = DetailReturnResponse{}
+	mi := &file_returns_returns_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailReturnResponse) ProtoMessage() {}
+
+func (x *DetailReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailReturnResponse.ProtoReflect.Descriptor instead.
+func (*DetailReturnResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DetailReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailReturnResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DetailReturnResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetAdminNote() string {
+	if x != nil {
+		return x.AdminNote
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DetailReturnResponse) GetRefundAmount() *common.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *DetailReturnResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *DetailReturnResponse) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
+func (x *DetailReturnResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DetailReturnResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type RefundReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundReportRequest) Reset() {
+	*x = RefundReportRequest{}
+	mi := &file_returns_returns_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReportRequest) ProtoMessage() {}
+
+func (x *RefundReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReportRequest.ProtoReflect.Descriptor instead.
+func (*RefundReportRequest) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefundReportRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RefundReportRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type RefundReportResponseItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Refunds of canceled orders.
+	OrderRefundTotal *common.Money `protobuf:"bytes,2,opt,name=order_refund_total,json=orderRefundTotal,proto3" json:"order_refund_total,omitempty"`
+	OrderRefundCount int64         `protobuf:"varint,3,opt,name=order_refund_count,json=orderRefundCount,proto3" json:"order_refund_count,omitempty"`
+	// Refunds of returned items.
+	ReturnRefundTotal *common.Money `protobuf:"bytes,4,opt,name=return_refund_total,json=returnRefundTotal,proto3" json:"return_refund_total,omitempty"`
+	ReturnRefundCount int64         `protobuf:"varint,5,opt,name=return_refund_count,json=returnRefundCount,proto3" json:"return_refund_count,omitempty"`
+	Total             *common.Money `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RefundReportResponseItem) Reset() {
+	*x = RefundReportResponseItem{}
+	mi := &file_returns_returns_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReportResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReportResponseItem) ProtoMessage() {}
+
+func (x *RefundReportResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReportResponseItem.ProtoReflect.Descriptor instead.
+func (*RefundReportResponseItem) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RefundReportResponseItem) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *RefundReportResponseItem) GetOrderRefundTotal() *common.Money {
+	if x != nil {
+		return x.OrderRefundTotal
+	}
+	return nil
+}
+
+func (x *RefundReportResponseItem) GetOrderRefundCount() int64 {
+	if x != nil {
+		return x.OrderRefundCount
+	}
+	return 0
+}
+
+func (x *RefundReportResponseItem) GetReturnRefundTotal() *common.Money {
+	if x != nil {
+		return x.ReturnRefundTotal
+	}
+	return nil
+}
+
+func (x *RefundReportResponseItem) GetReturnRefundCount() int64 {
+	if x != nil {
+		return x.ReturnRefundCount
+	}
+	return 0
+}
+
+func (x *RefundReportResponseItem) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type RefundReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Succeeded refunds per currency.
+	Data          []*RefundReportResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundReportResponse) Reset() {
+	*x = RefundReportResponse{}
+	mi := &file_returns_returns_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReportResponse) ProtoMessage() {}
+
+func (x *RefundReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_returns_returns_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReportResponse.ProtoReflect.Descriptor instead.
+func (*RefundReportResponse) Descriptor() ([]byte, []int) {
+	return file_returns_returns_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefundReportResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RefundReportResponse) GetData() []*RefundReportResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_returns_returns_proto protoreflect.FileDescriptor
+
+const file_returns_returns_proto_rawDesc = "" +
+	"\n" +
+	"\x15returns/returns.proto\x12\areturns\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
+	"\x18RequestReturnRequestItem\x12+\n" +
+	"\rorder_item_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\vorderItemId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\xa1\x01\n" +
+	"\x14RequestReturnRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x06reason\x12A\n" +
+	"\x05items\x18\x03 \x03(\v2!.returns.RequestReturnRequestItemB\b\xbaH\x05\x92\x01\x02\b\x01R\x05items\"i\n" +
+	"\x15RequestReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\tR\x06number\".\n" +
+	"\x13CancelReturnRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"@\n" +
+	"\x14CancelReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"M\n" +
+	"\x14ApproveReturnRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1c\n" +
+	"\x04note\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x04note\"A\n" +
+	"\x15ApproveReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"N\n" +
+	"\x13RejectReturnRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1e\n" +
+	"\x04note\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x04note\"@\n" +
+	"\x14RejectReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x9d\x01\n" +
+	"\x18ReceiveReturnRequestItem\x12-\n" +
+	"\x0ereturn_item_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\freturnItemId\x124\n" +
+	"\x11accepted_quantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10acceptedQuantity\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x04note\"r\n" +
+	"\x14ReceiveReturnRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12A\n" +
+	"\x05items\x18\x02 \x03(\v2!.returns.ReceiveReturnRequestItemB\b\xbaH\x05\x92\x01\x02\b\x01R\x05items\"\x9a\x01\n" +
+	"\x15ReceiveReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x122\n" +
+	"\rrefund_amount\x18\x02 \x01(\v2\r.common.MoneyR\frefundAmount\x12#\n" +
+	"\rrefund_status\x18\x03 \x01(\tR\frefundStatus\"p\n" +
+	"\x12ListReturnsRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12\x1f\n" +
+	"\x06status\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x14R\x06status\"\xc3\x02\n" +
+	"\x17ListReturnsResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12!\n" +
+	"\forder_number\x18\x03 \x01(\tR\vorderNumber\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x122\n" +
+	"\rrefund_amount\x18\x06 \x01(\v2\r.common.MoneyR\frefundAmount\x12#\n" +
+	"\rrefund_status\x18\a \x01(\tR\frefundStatus\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06number\x18\t \x01(\tR\x06number\"\xb1\x01\n" +
+	"\x13ListReturnsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x124\n" +
+	"\x04data\x18\x03 \x03(\v2 .returns.ListReturnsResponseItemR\x04data\".\n" +
+	"\x13DetailReturnRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"\xe1\x01\n" +
+	"\n" +
+	"ReturnItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\rorder_item_id\x18\x02 \x01(\x04R\vorderItemId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x120\n" +
+	"\x11accepted_quantity\x18\x05 \x01(\x03H\x00R\x10acceptedQuantity\x88\x01\x01\x12'\n" +
+	"\x0finspection_note\x18\x06 \x01(\tR\x0einspectionNoteB\x14\n" +
+	"\x12_accepted_quantity\"\xf1\x03\n" +
+	"\x14DetailReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12!\n" +
+	"\forder_number\x18\x04 \x01(\tR\vorderNumber\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"admin_note\x18\a \x01(\tR\tadminNote\x12)\n" +
+	"\x05items\x18\b \x03(\v2\x13.returns.ReturnItemR\x05items\x122\n" +
+	"\rrefund_amount\x18\t \x01(\v2\r.common.MoneyR\frefundAmount\x12#\n" +
+	"\rrefund_status\x18\n" +
+	" \x01(\tR\frefundStatus\x12;\n" +
+	"\vrefunded_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"refundedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06number\x18\r \x01(\tR\x06number\"\x97\x01\n" +
+	"\x13RefundReportRequest\x12A\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12=\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendDate\"\xbe\x02\n" +
+	"\x18RefundReportResponseItem\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12;\n" +
+	"\x12order_refund_total\x18\x02 \x01(\v2\r.common.MoneyR\x10orderRefundTotal\x12,\n" +
+	"\x12order_refund_count\x18\x03 \x01(\x03R\x10orderRefundCount\x12=\n" +
+	"\x13return_refund_total\x18\x04 \x01(\v2\r.common.MoneyR\x11returnRefundTotal\x12.\n" +
+	"\x13return_refund_count\x18\x05 \x01(\x03R\x11returnRefundCount\x12#\n" +
+	"\x05total\x18\x06 \x01(\v2\r.common.MoneyR\x05total\"w\n" +
+	"\x14RefundReportResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x125\n" +
+	"\x04data\x18\x02 \x03(\v2!.returns.RefundReportResponseItemR\x04data2\xfd\x04\n" +
+	"\rReturnService\x12N\n" +
+	"\rRequestReturn\x12\x1d.returns.RequestReturnRequest\x1a\x1e.returns.RequestReturnResponse\x12K\n" +
+	"\fCancelReturn\x12\x1c.returns.CancelReturnRequest\x1a\x1d.returns.CancelReturnResponse\x12N\n" +
+	"\rApproveReturn\x12\x1d.returns.ApproveReturnRequest\x1a\x1e.returns.ApproveReturnResponse\x12K\n" +
+	"\fRejectReturn\x12\x1c.returns.RejectReturnRequest\x1a\x1d.returns.RejectReturnResponse\x12N\n" +
+	"\rReceiveReturn\x12\x1d.returns.ReceiveReturnRequest\x1a\x1e.returns.ReceiveReturnResponse\x12H\n" +
+	"\vListReturns\x12\x1b.returns.ListReturnsRequest\x1a\x1c.returns.ListReturnsResponse\x12K\n" +
+	"\fDetailReturn\x12\x1c.returns.DetailReturnRequest\x1a\x1d.returns.DetailReturnResponse\x12K\n" +
+	"\fRefundReport\x12\x1c.returns.RefundReportRequest\x1a\x1d.returns.RefundReportResponseB\x8a\x01\n" +
+	"\vcom.returnsB\fReturnsProtoP\x01Z1github.com/fahrillrizal/ecommerce-grpc/pb/returns\xa2\x02\x03RXX\xaa\x02\aReturns\xca\x02\aReturns\xe2\x02\x13Returns\\GPBMetadata\xea\x02\aReturnsb\x06proto3"
+
+var (
+	file_returns_returns_proto_rawDescOnce sync.Once
+	file_returns_returns_proto_rawDescData []byte
+)
+
+func file_returns_returns_proto_rawDescGZIP() []byte {
+	file_returns_returns_proto_rawDescOnce.Do(func() {
+		file_returns_returns_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_returns_returns_proto_rawDesc), len(file_returns_returns_proto_rawDesc)))
+	})
+	return file_returns_returns_proto_rawDescData
+}
+
+var file_returns_returns_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_returns_returns_proto_goTypes = []any{
+	(*RequestReturnRequestItem)(nil),  // 0: returns.RequestReturnRequestItem
+	(*RequestReturnRequest)(nil),      // 1: returns.RequestReturnRequest
+	(*RequestReturnResponse)(nil),     // 2: returns.RequestReturnResponse
+	(*CancelReturnRequest)(nil),       // 3: returns.CancelReturnRequest
+	(*CancelReturnResponse)(nil),      // 4: returns.CancelReturnResponse
+	(*ApproveReturnRequest)(nil),      // 5: returns.ApproveReturnRequest
+	(*ApproveReturnResponse)(nil),     // 6: returns.ApproveReturnResponse
+	(*RejectReturnRequest)(nil),       // 7: returns.RejectReturnRequest
+	(*RejectReturnResponse)(nil),      // 8: returns.RejectReturnResponse
+	(*ReceiveReturnRequestItem)(nil),  // 9: returns.ReceiveReturnRequestItem
+	(*ReceiveReturnRequest)(nil),      // 10: returns.ReceiveReturnRequest
+	(*ReceiveReturnResponse)(nil),     // 11: returns.ReceiveReturnResponse
+	(*ListReturnsRequest)(nil),        // 12: returns.ListReturnsRequest
+	(*ListReturnsResponseItem)(nil),   // 13: returns.ListReturnsResponseItem
+	(*ListReturnsResponse)(nil),       // 14: returns.ListReturnsResponse
+	(*DetailReturnRequest)(nil),       // 15: returns.DetailReturnRequest
+	(*ReturnItem)(nil),                // 16: returns.ReturnItem
+	(*DetailReturnResponse)(nil),      // 17: returns.DetailReturnResponse
+	(*RefundReportRequest)(nil),       // 18: returns.RefundReportRequest
+	(*RefundReportResponseItem)(nil),  // 19: returns.RefundReportResponseItem
+	(*RefundReportResponse)(nil),      // 20: returns.RefundReportResponse
+	(*common.BaseResponse)(nil),       // 21: common.BaseResponse
+	(*common.Money)(nil),              // 22: common.Money
+	(*common.PaginationRequest)(nil),  // 23: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),     // 24: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil), // 25: common.PaginationResponse
+}
+var file_returns_returns_proto_depIdxs = []int32{
+	0,  // 0: returns.RequestReturnRequest.items:type_name -> returns.RequestReturnRequestItem
+	21, // 1: returns.RequestReturnResponse.base:type_name -> common.BaseResponse
+	21, // 2: returns.CancelReturnResponse.base:type_name -> common.BaseResponse
+	21, // 3: returns.ApproveReturnResponse.base:type_name -> common.BaseResponse
+	21, // 4: returns.RejectReturnResponse.base:type_name -> common.BaseResponse
+	9,  // 5: returns.ReceiveReturnRequest.items:type_name -> returns.ReceiveReturnRequestItem
+	21, // 6: returns.ReceiveReturnResponse.base:type_name -> common.BaseResponse
+	22, // 7: returns.ReceiveReturnResponse.refund_amount:type_name -> common.Money
+	23, // 8: returns.ListReturnsRequest.pagination:type_name -> common.PaginationRequest
+	22, // 9: returns.ListReturnsResponseItem.refund_amount:type_name -> common.Money
+	24, // 10: returns.ListReturnsResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	21, // 11: returns.ListReturnsResponse.base:type_name -> common.BaseResponse
+	25, // 12: returns.ListReturnsResponse.pagination:type_name -> common.PaginationResponse
+	13, // 13: returns.ListReturnsResponse.data:type_name -> returns.ListReturnsResponseItem
+	21, // 14: returns.DetailReturnResponse.base:type_name -> common.BaseResponse
+	16, // 15: returns.DetailReturnResponse.items:type_name -> returns.ReturnItem
+	22, // 16: returns.DetailReturnResponse.refund_amount:type_name -> common.Money
+	24, // 17: returns.DetailReturnResponse.refunded_at:type_name -> google.protobuf.Timestamp
+	24, // 18: returns.DetailReturnResponse.created_at:type_name -> google.protobuf.Timestamp
+	24, // 19: returns.RefundReportRequest.start_date:type_name -> google.protobuf.Timestamp
+	24, // 20: returns.RefundReportRequest.end_date:type_name -> google.protobuf.Timestamp
+	22, // 21: returns.RefundReportResponseItem.order_refund_total:type_name -> common.Money
+	22, // 22: returns.RefundReportResponseItem.return_refund_total:type_name -> common.Money
+	22, // 23: returns.RefundReportResponseItem.total:type_name -> common.Money
+	21, // 24: returns.RefundReportResponse.base:type_name -> common.BaseResponse
+	19, // 25: returns.RefundReportResponse.data:type_name -> returns.RefundReportResponseItem
+	1,  // 26: returns.ReturnService.RequestReturn:input_type -> returns.RequestReturnRequest
+	3,  // 27: returns.ReturnService.CancelReturn:input_type -> returns.CancelReturnRequest
+	5,  // 28: returns.ReturnService.ApproveReturn:input_type -> returns.ApproveReturnRequest
+	7,  // 29: returns.ReturnService.RejectReturn:input_type -> returns.RejectReturnRequest
+	10, // 30: returns.ReturnService.ReceiveReturn:input_type -> returns.ReceiveReturnRequest
+	12, // 31: returns.ReturnService.ListReturns:input_type -> returns.ListReturnsRequest
+	15, // 32: returns.ReturnService.DetailReturn:input_type -> returns.DetailReturnRequest
+	18, // 33: returns.ReturnService.RefundReport:input_type -> returns.RefundReportRequest
+	2,  // 34: returns.ReturnService.RequestReturn:output_type -> returns.RequestReturnResponse
+	4,  // 35: returns.ReturnService.CancelReturn:output_type -> returns.CancelReturnResponse
+	6,  // 36: returns.ReturnService.ApproveReturn:output_type -> returns.ApproveReturnResponse
+	8,  // 37: returns.ReturnService.RejectReturn:output_type -> returns.RejectReturnResponse
+	11, // 38: returns.ReturnService.ReceiveReturn:output_type -> returns.ReceiveReturnResponse
+	14, // 39: returns.ReturnService.ListReturns:output_type -> returns.ListReturnsResponse
+	17, // 40: returns.ReturnService.DetailReturn:output_type -> returns.DetailReturnResponse
+	20, // 41: returns.ReturnService.RefundReport:output_type -> returns.RefundReportResponse
+	34, // [34:42] is the sub-list for method output_type
+	26, // [26:34] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_returns_returns_proto_init() }
+func file_returns_returns_proto_init() {
+	if File_returns_returns_proto != nil {
+		return
+	}
+	file_returns_returns_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_returns_returns_proto_rawDesc), len(file_returns_returns_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_returns_returns_proto_goTypes,
+		DependencyIndexes: file_returns_returns_proto_depIdxs,
+		MessageInfos:      file_returns_returns_proto_msgTypes,
+	}.Build()
+	File_returns_returns_proto = out.File
+	file_returns_returns_proto_goTypes = nil
+	file_returns_returns_proto_depIdxs = nil
+}
@@ -0,0 +1,1049 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: shipping/shipping.proto
+
+package shipping
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateShippingMethodRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// One of flat, weight or region.
+	RateType      string `protobuf:"bytes,4,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
+	IsActive      bool   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShippingMethodRequest) Reset() {
+	*x = CreateShippingMethodRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShippingMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShippingMethodRequest) ProtoMessage() {}
+
+func (x *CreateShippingMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShippingMethodRequest.ProtoReflect.Descriptor instead.
+func (*CreateShippingMethodRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateShippingMethodRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateShippingMethodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShippingMethodRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateShippingMethodRequest) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *CreateShippingMethodRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type CreateShippingMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShippingMethodResponse) Reset() {
+	*x = CreateShippingMethodResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShippingMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShippingMethodResponse) ProtoMessage() {}
+
+func (x *CreateShippingMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShippingMethodResponse.ProtoReflect.Descriptor instead.
+func (*CreateShippingMethodResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateShippingMethodResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateShippingMethodResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateShippingMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RateType      string                 `protobuf:"bytes,4,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShippingMethodRequest) Reset() {
+	*x = UpdateShippingMethodRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingMethodRequest) ProtoMessage() {}
+
+func (x *UpdateShippingMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingMethodRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShippingMethodRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateShippingMethodRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateShippingMethodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateShippingMethodRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateShippingMethodRequest) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *UpdateShippingMethodRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateShippingMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShippingMethodResponse) Reset() {
+	*x = UpdateShippingMethodResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingMethodResponse) ProtoMessage() {}
+
+func (x *UpdateShippingMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingMethodResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShippingMethodResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateShippingMethodResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeleteShippingMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingMethodRequest) Reset() {
+	*x = DeleteShippingMethodRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingMethodRequest) ProtoMessage() {}
+
+func (x *DeleteShippingMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingMethodRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShippingMethodRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteShippingMethodRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteShippingMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingMethodResponse) Reset() {
+	*x = DeleteShippingMethodResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingMethodResponse) ProtoMessage() {}
+
+func (x *DeleteShippingMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingMethodResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShippingMethodResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteShippingMethodResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ShippingRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty matches any region without a rate of its own.
+	Region         string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	MinWeightGrams int32  `protobuf:"varint,2,opt,name=min_weight_grams,json=minWeightGrams,proto3" json:"min_weight_grams,omitempty"`
+	// Exclusive upper bound. Unset has no upper bound.
+	MaxWeightGrams *int32        `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3,oneof" json:"max_weight_grams,omitempty"`
+	Price          *common.Money `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShippingRate) Reset() {
+	*x = ShippingRate{}
+	mi := &file_shipping_shipping_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingRate) ProtoMessage() {}
+
+func (x *ShippingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingRate.ProtoReflect.Descriptor instead.
+func (*ShippingRate) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShippingRate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingRate) GetMinWeightGrams() int32 {
+	if x != nil {
+		return x.MinWeightGrams
+	}
+	return 0
+}
+
+func (x *ShippingRate) GetMaxWeightGrams() int32 {
+	if x != nil && x.MaxWeightGrams != nil {
+		return *x.MaxWeightGrams
+	}
+	return 0
+}
+
+func (x *ShippingRate) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ListShippingMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingMethodsRequest) Reset() {
+	*x = ListShippingMethodsRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingMethodsRequest) ProtoMessage() {}
+
+func (x *ListShippingMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListShippingMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{7}
+}
+
+type ListShippingMethodsResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	RateType      string                 `protobuf:"bytes,5,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Rates         []*ShippingRate        `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingMethodsResponseItem) Reset() {
+	*x = ListShippingMethodsResponseItem{}
+	mi := &file_shipping_shipping_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingMethodsResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingMethodsResponseItem) ProtoMessage() {}
+
+func (x *ListShippingMethodsResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingMethodsResponseItem.ProtoReflect.Descriptor instead.
+func (*ListShippingMethodsResponseItem) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListShippingMethodsResponseItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListShippingMethodsResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListShippingMethodsResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListShippingMethodsResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListShippingMethodsResponseItem) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+func (x *ListShippingMethodsResponseItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ListShippingMethodsResponseItem) GetRates() []*ShippingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ListShippingMethodsResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Base          *common.BaseResponse               `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListShippingMethodsResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingMethodsResponse) Reset() {
+	*x = ListShippingMethodsResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingMethodsResponse) ProtoMessage() {}
+
+func (x *ListShippingMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListShippingMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListShippingMethodsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListShippingMethodsResponse) GetData() []*ListShippingMethodsResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetShippingRatesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ShippingMethodId uint64                 `protobuf:"varint,1,opt,name=shipping_method_id,json=shippingMethodId,proto3" json:"shipping_method_id,omitempty"`
+	Rates            []*ShippingRate        `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetShippingRatesRequest) Reset() {
+	*x = SetShippingRatesRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShippingRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShippingRatesRequest) ProtoMessage() {}
+
+func (x *SetShippingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShippingRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetShippingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetShippingRatesRequest) GetShippingMethodId() uint64 {
+	if x != nil {
+		return x.ShippingMethodId
+	}
+	return 0
+}
+
+func (x *SetShippingRatesRequest) GetRates() []*ShippingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetShippingRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShippingRatesResponse) Reset() {
+	*x = SetShippingRatesResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShippingRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShippingRatesResponse) ProtoMessage() {}
+
+func (x *SetShippingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShippingRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetShippingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetShippingRatesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type QuoteShippingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Region of the delivery address, e.g. the province.
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Defaults to the store currency.
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteShippingRequest) Reset() {
+	*x = QuoteShippingRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteShippingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingRequest) ProtoMessage() {}
+
+func (x *QuoteShippingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingRequest.ProtoReflect.Descriptor instead.
+func (*QuoteShippingRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuoteShippingRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *QuoteShippingRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type QuoteShippingOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Cost          *common.Money          `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteShippingOption) Reset() {
+	*x = QuoteShippingOption{}
+	mi := &file_shipping_shipping_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteShippingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingOption) ProtoMessage() {}
+
+func (x *QuoteShippingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingOption.ProtoReflect.Descriptor instead.
+func (*QuoteShippingOption) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuoteShippingOption) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QuoteShippingOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuoteShippingOption) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuoteShippingOption) GetCost() *common.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type QuoteShippingResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Base             *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TotalWeightGrams int32                  `protobuf:"varint,2,opt,name=total_weight_grams,json=totalWeightGrams,proto3" json:"total_weight_grams,omitempty"`
+	// Methods that cannot ship the cart to the region are left out.
+	Options       []*QuoteShippingOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteShippingResponse) Reset() {
+	*x = QuoteShippingResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteShippingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingResponse) ProtoMessage() {}
+
+func (x *QuoteShippingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingResponse.ProtoReflect.Descriptor instead.
+func (*QuoteShippingResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QuoteShippingResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *QuoteShippingResponse) GetTotalWeightGrams() int32 {
+	if x != nil {
+		return x.TotalWeightGrams
+	}
+	return 0
+}
+
+func (x *QuoteShippingResponse) GetOptions() []*QuoteShippingOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+var File_shipping_shipping_proto protoreflect.FileDescriptor
+
+const file_shipping_shipping_proto_rawDesc = "" +
+	"\n" +
+	"\x17shipping/shipping.proto\x12\bshipping\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\"\xde\x01\n" +
+	"\x1bCreateShippingMethodRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04code\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x128\n" +
+	"\trate_type\x18\x04 \x01(\tB\x1b\xbaH\x18r\x16R\x04flatR\x06weightR\x06regionR\brateType\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\"X\n" +
+	"\x1cCreateShippingMethodResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"\xd8\x01\n" +
+	"\x1bUpdateShippingMethodRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x128\n" +
+	"\trate_type\x18\x04 \x01(\tB\x1b\xbaH\x18r\x16R\x04flatR\x06weightR\x06regionR\brateType\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\"H\n" +
+	"\x1cUpdateShippingMethodResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"6\n" +
+	"\x1bDeleteShippingMethodRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"H\n" +
+	"\x1cDeleteShippingMethodResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xdc\x01\n" +
+	"\fShippingRate\x12\x1f\n" +
+	"\x06region\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06region\x121\n" +
+	"\x10min_weight_grams\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0eminWeightGrams\x126\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\x0emaxWeightGrams\x88\x01\x01\x12+\n" +
+	"\x05price\x18\x04 \x01(\v2\r.common.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x05priceB\x13\n" +
+	"\x11_max_weight_grams\"\x1c\n" +
+	"\x1aListShippingMethodsRequest\"\xe3\x01\n" +
+	"\x1fListShippingMethodsResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\trate_type\x18\x05 \x01(\tR\brateType\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12,\n" +
+	"\x05rates\x18\a \x03(\v2\x16.shipping.ShippingRateR\x05rates\"\x86\x01\n" +
+	"\x1bListShippingMethodsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12=\n" +
+	"\x04data\x18\x02 \x03(\v2).shipping.ListShippingMethodsResponseItemR\x04data\"~\n" +
+	"\x17SetShippingRatesRequest\x125\n" +
+	"\x12shipping_method_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x10shippingMethodId\x12,\n" +
+	"\x05rates\x18\x02 \x03(\v2\x16.shipping.ShippingRateR\x05rates\"D\n" +
+	"\x18SetShippingRatesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"u\n" +
+	"\x14QuoteShippingRequest\x12\x1f\n" +
+	"\x06region\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06region\x12<\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\"\x82\x01\n" +
+	"\x13QuoteShippingOption\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\x04cost\x18\x04 \x01(\v2\r.common.MoneyR\x04cost\"\xa8\x01\n" +
+	"\x15QuoteShippingResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12,\n" +
+	"\x12total_weight_grams\x18\x02 \x01(\x05R\x10totalWeightGrams\x127\n" +
+	"\aoptions\x18\x03 \x03(\v2\x1d.shipping.QuoteShippingOptionR\aoptions2\xd7\x04\n" +
+	"\x0fShippingService\x12e\n" +
+	"\x14CreateShippingMethod\x12%.shipping.CreateShippingMethodRequest\x1a&.shipping.CreateShippingMethodResponse\x12e\n" +
+	"\x14UpdateShippingMethod\x12%.shipping.UpdateShippingMethodRequest\x1a&.shipping.UpdateShippingMethodResponse\x12e\n" +
+	"\x14DeleteShippingMethod\x12%.shipping.DeleteShippingMethodRequest\x1a&.shipping.DeleteShippingMethodResponse\x12b\n" +
+	"\x13ListShippingMethods\x12$.shipping.ListShippingMethodsRequest\x1a%.shipping.ListShippingMethodsResponse\x12Y\n" +
+	"\x10SetShippingRates\x12!.shipping.SetShippingRatesRequest\x1a\".shipping.SetShippingRatesResponse\x12P\n" +
+	"\rQuoteShipping\x12\x1e.shipping.QuoteShippingRequest\x1a\x1f.shipping.QuoteShippingResponseB\x91\x01\n" +
+	"\fcom.shippingB\rShippingProtoP\x01Z2github.com/fahrillrizal/ecommerce-grpc/pb/shipping\xa2\x02\x03SXX\xaa\x02\bShipping\xca\x02\bShipping\xe2\x02\x14Shipping\\GPBMetadata\xea\x02\bShippingb\x06proto3"
+
+var (
+	file_shipping_shipping_proto_rawDescOnce sync.Once
+	file_shipping_shipping_proto_rawDescData []byte
+)
+
+func file_shipping_shipping_proto_rawDescGZIP() []byte {
+	file_shipping_shipping_proto_rawDescOnce.Do(func() {
+		file_shipping_shipping_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipping_shipping_proto_rawDesc), len(file_shipping_shipping_proto_rawDesc)))
+	})
+	return file_shipping_shipping_proto_rawDescData
+}
+
+var file_shipping_shipping_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_shipping_shipping_proto_goTypes = []any{
+	(*CreateShippingMethodRequest)(nil),     // 0: shipping.CreateShippingMethodRequest
+	(*CreateShippingMethodResponse)(nil),    // 1: shipping.CreateShippingMethodResponse
+	(*UpdateShippingMethodRequest)(nil),     // 2: shipping.UpdateShippingMethodRequest
+	(*UpdateShippingMethodResponse)(nil),    // 3: shipping.UpdateShippingMethodResponse
+	(*DeleteShippingMethodRequest)(nil),     // 4: shipping.DeleteShippingMethodRequest
+	(*DeleteShippingMethodResponse)(nil),    // 5: shipping.DeleteShippingMethodResponse
+	(*ShippingRate)(nil),                    // 6: shipping.ShippingRate
+	(*ListShippingMethodsRequest)(nil),      // 7: shipping.ListShippingMethodsRequest
+	(*ListShippingMethodsResponseItem)(nil), // 8: shipping.ListShippingMethodsResponseItem
+	(*ListShippingMethodsResponse)(nil),     // 9: shipping.ListShippingMethodsResponse
+	(*SetShippingRatesRequest)(nil),         // 10: shipping.SetShippingRatesRequest
+	(*SetShippingRatesResponse)(nil),        // 11: shipping.SetShippingRatesResponse
+	(*QuoteShippingRequest)(nil),            // 12: shipping.QuoteShippingRequest
+	(*QuoteShippingOption)(nil),             // 13: shipping.QuoteShippingOption
+	(*QuoteShippingResponse)(nil),           // 14: shipping.QuoteShippingResponse
+	(*common.BaseResponse)(nil),             // 15: common.BaseResponse
+	(*common.Money)(nil),                    // 16: common.Money
+}
+var file_shipping_shipping_proto_depIdxs = []int32{
+	15, // 0: shipping.CreateShippingMethodResponse.base:type_name -> common.BaseResponse
+	15, // 1: shipping.UpdateShippingMethodResponse.base:type_name -> common.BaseResponse
+	15, // 2: shipping.DeleteShippingMethodResponse.base:type_name -> common.BaseResponse
+	16, // 3: shipping.ShippingRate.price:type_name -> common.Money
+	6,  // 4: shipping.ListShippingMethodsResponseItem.rates:type_name -> shipping.ShippingRate
+	15, // 5: shipping.ListShippingMethodsResponse.base:type_name -> common.BaseResponse
+	8,  // 6: shipping.ListShippingMethodsResponse.data:type_name -> shipping.ListShippingMethodsResponseItem
+	6,  // 7: shipping.SetShippingRatesRequest.rates:type_name -> shipping.ShippingRate
+	15, // 8: shipping.SetShippingRatesResponse.base:type_name -> common.BaseResponse
+	16, // 9: shipping.QuoteShippingOption.cost:type_name -> common.Money
+	15, // 10: shipping.QuoteShippingResponse.base:type_name -> common.BaseResponse
+	13, // 11: shipping.QuoteShippingResponse.options:type_name -> shipping.QuoteShippingOption
+	0,  // 12: shipping.ShippingService.CreateShippingMethod:input_type -> shipping.CreateShippingMethodRequest
+	2,  // 13: shipping.ShippingService.UpdateShippingMethod:input_type -> shipping.UpdateShippingMethodRequest
+	4,  // 14: shipping.ShippingService.DeleteShippingMethod:input_type -> shipping.DeleteShippingMethodRequest
+	7,  // 15: shipping.ShippingService.ListShippingMethods:input_type -> shipping.ListShippingMethodsRequest
+	10, // 16: shipping.ShippingService.SetShippingRates:input_type -> shipping.SetShippingRatesRequest
+	12, // 17: shipping.ShippingService.QuoteShipping:input_type -> shipping.QuoteShippingRequest
+	1,  // 18: shipping.ShippingService.CreateShippingMethod:output_type -> shipping.CreateShippingMethodResponse
+	3,  // 19: shipping.ShippingService.UpdateShippingMethod:output_type -> shipping.UpdateShippingMethodResponse
+	5,  // 20: shipping.ShippingService.DeleteShippingMethod:output_type -> shipping.DeleteShippingMethodResponse
+	9,  // 21: shipping.ShippingService.ListShippingMethods:output_type -> shipping.ListShippingMethodsResponse
+	11, // 22: shipping.ShippingService.SetShippingRates:output_type -> shipping.SetShippingRatesResponse
+	14, // 23: shipping.ShippingService.QuoteShipping:output_type -> shipping.QuoteShippingResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_shipping_shipping_proto_init() }
+func file_shipping_shipping_proto_init() {
+	if File_shipping_shipping_proto != nil {
+		return
+	}
+	file_shipping_shipping_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipping_shipping_proto_rawDesc), len(file_shipping_shipping_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shipping_shipping_proto_goTypes,
+		DependencyIndexes: file_shipping_shipping_proto_depIdxs,
+		MessageInfos:      file_shipping_shipping_proto_msgTypes,
+	}.Build()
+	File_shipping_shipping_proto = out.File
+	file_shipping_shipping_proto_goTypes = nil
+	file_shipping_shipping_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: shipping/shipping.proto
+
+package shipping
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShippingService_CreateShippingMethod_FullMethodName = "/shipping.ShippingService/CreateShippingMethod"
+	ShippingService_UpdateShippingMethod_FullMethodName = "/shipping.ShippingService/UpdateShippingMethod"
+	ShippingService_DeleteShippingMethod_FullMethodName = "/shipping.ShippingService/DeleteShippingMethod"
+	ShippingService_ListShippingMethods_FullMethodName  = "/shipping.ShippingService/ListShippingMethods"
+	ShippingService_SetShippingRates_FullMethodName     = "/shipping.ShippingService/SetShippingRates"
+	ShippingService_QuoteShipping_FullMethodName        = "/shipping.ShippingService/QuoteShipping"
+)
+
+// ShippingServiceClient is the client API for ShippingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShippingServiceClient interface {
+	CreateShippingMethod(ctx context.Context, in *CreateShippingMethodRequest, opts ...grpc.CallOption) (*CreateShippingMethodResponse, error)
+	UpdateShippingMethod(ctx context.Context, in *UpdateShippingMethodRequest, opts ...grpc.CallOption) (*UpdateShippingMethodResponse, error)
+	DeleteShippingMethod(ctx context.Context, in *DeleteShippingMethodRequest, opts ...grpc.CallOption) (*DeleteShippingMethodResponse, error)
+	ListShippingMethods(ctx context.Context, in *ListShippingMethodsRequest, opts ...grpc.CallOption) (*ListShippingMethodsResponse, error)
+	SetShippingRates(ctx context.Context, in *SetShippingRatesRequest, opts ...grpc.CallOption) (*SetShippingRatesResponse, error)
+	QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error)
+}
+
+type shippingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShippingServiceClient(cc grpc.ClientConnInterface) ShippingServiceClient {
+	return &shippingServiceClient{cc}
+}
+
+func (c *shippingServiceClient) CreateShippingMethod(ctx context.Context, in *CreateShippingMethodRequest, opts ...grpc.CallOption) (*CreateShippingMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShippingMethodResponse)
+	err := c.cc.Invoke(ctx, ShippingService_CreateShippingMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) UpdateShippingMethod(ctx context.Context, in *UpdateShippingMethodRequest, opts ...grpc.CallOption) (*UpdateShippingMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateShippingMethodResponse)
+	err := c.cc.Invoke(ctx, ShippingService_UpdateShippingMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) DeleteShippingMethod(ctx context.Context, in *DeleteShippingMethodRequest, opts ...grpc.CallOption) (*DeleteShippingMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShippingMethodResponse)
+	err := c.cc.Invoke(ctx, ShippingService_DeleteShippingMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ListShippingMethods(ctx context.Context, in *ListShippingMethodsRequest, opts ...grpc.CallOption) (*ListShippingMethodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShippingMethodsResponse)
+	err := c.cc.Invoke(ctx, ShippingService_ListShippingMethods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) SetShippingRates(ctx context.Context, in *SetShippingRatesRequest, opts ...grpc.CallOption) (*SetShippingRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetShippingRatesResponse)
+	err := c.cc.Invoke(ctx, ShippingService_SetShippingRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteShippingResponse)
+	err := c.cc.Invoke(ctx, ShippingService_QuoteShipping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShippingServiceServer is the server API for ShippingService service.
+// All implementations must embed UnimplementedShippingServiceServer
+// for forward compatibility.
+type ShippingServiceServer interface {
+	CreateShippingMethod(context.Context, *CreateShippingMethodRequest) (*CreateShippingMethodResponse, error)
+	UpdateShippingMethod(context.Context, *UpdateShippingMethodRequest) (*UpdateShippingMethodResponse, error)
+	DeleteShippingMethod(context.Context, *DeleteShippingMethodRequest) (*DeleteShippingMethodResponse, error)
+	ListShippingMethods(context.Context, *ListShippingMethodsRequest) (*ListShippingMethodsResponse, error)
+	SetShippingRates(context.Context, *SetShippingRatesRequest) (*SetShippingRatesResponse, error)
+	QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error)
+	mustEmbedUnimplementedShippingServiceServer()
+}
+
+// UnimplementedShippingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShippingServiceServer struct{}
+
+func (UnimplementedShippingServiceServer) CreateShippingMethod(context.Context, *CreateShippingMethodRequest) (*CreateShippingMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShippingMethod not implemented")
+}
+func (UnimplementedShippingServiceServer) UpdateShippingMethod(context.Context, *UpdateShippingMethodRequest) (*UpdateShippingMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShippingMethod not implemented")
+}
+func (UnimplementedShippingServiceServer) DeleteShippingMethod(context.Context, *DeleteShippingMethodRequest) (*DeleteShippingMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShippingMethod not implemented")
+}
+func (UnimplementedShippingServiceServer) ListShippingMethods(context.Context, *ListShippingMethodsRequest) (*ListShippingMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShippingMethods not implemented")
+}
+func (UnimplementedShippingServiceServer) SetShippingRates(context.Context, *SetShippingRatesRequest) (*SetShippingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShippingRates not implemented")
+}
+func (UnimplementedShippingServiceServer) QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteShipping not implemented")
+}
+func (UnimplementedShippingServiceServer) mustEmbedUnimplementedShippingServiceServer() {}
+func (UnimplementedShippingServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeShippingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShippingServiceServer will
+// result in compilation errors.
+type UnsafeShippingServiceServer interface {
+	mustEmbedUnimplementedShippingServiceServer()
+}
+
+func RegisterShippingServiceServer(s grpc.ServiceRegistrar, srv ShippingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShippingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShippingService_ServiceDesc, srv)
+}
+
+func _ShippingService_CreateShippingMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShippingMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CreateShippingMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_CreateShippingMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CreateShippingMethod(ctx, req.(*CreateShippingMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_UpdateShippingMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShippingMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).UpdateShippingMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_UpdateShippingMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).UpdateShippingMethod(ctx, req.(*UpdateShippingMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_DeleteShippingMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShippingMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).DeleteShippingMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_DeleteShippingMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).DeleteShippingMethod(ctx, req.(*DeleteShippingMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_ListShippingMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShippingMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).ListShippingMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_ListShippingMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).ListShippingMethods(ctx, req.(*ListShippingMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_SetShippingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShippingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).SetShippingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_SetShippingRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).SetShippingRates(ctx, req.(*SetShippingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_QuoteShipping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteShippingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).QuoteShipping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_QuoteShipping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).QuoteShipping(ctx, req.(*QuoteShippingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShippingService_ServiceDesc is the grpc.ServiceDesc for ShippingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShippingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shipping.ShippingService",
+	HandlerType: (*ShippingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShippingMethod",
+			Handler:    _ShippingService_CreateShippingMethod_Handler,
+		},
+		{
+			MethodName: "UpdateShippingMethod",
+			Handler:    _ShippingService_UpdateShippingMethod_Handler,
+		},
+		{
+			MethodName: "DeleteShippingMethod",
+			Handler:    _ShippingService_DeleteShippingMethod_Handler,
+		},
+		{
+			MethodName: "ListShippingMethods",
+			Handler:    _ShippingService_ListShippingMethods_Handler,
+		},
+		{
+			MethodName: "SetShippingRates",
+			Handler:    _ShippingService_SetShippingRates_Handler,
+		},
+		{
+			MethodName: "QuoteShipping",
+			Handler:    _ShippingService_QuoteShipping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shipping/shipping.proto",
+}
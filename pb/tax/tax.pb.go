@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: tax/tax.proto
+
+package tax
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/fahrillrizal/ecommerce-grpc/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateTaxRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Percentage, e.g. 11 for PPN.
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Set when product prices already include the tax.
+	IsInclusive bool `protobuf:"varint,3,opt,name=is_inclusive,json=isInclusive,proto3" json:"is_inclusive,omitempty"`
+	// Limits the rule to orders in this currency. Empty applies to every
+	// currency without a rule of its own.
+	CurrencyCode     string   `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	ExemptCategories []string `protobuf:"bytes,5,rep,name=exempt_categories,json=exemptCategories,proto3" json:"exempt_categories,omitempty"`
+	IsActive         bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleRequest) Reset() {
+	*x = CreateTaxRuleRequest{}
+	mi := &file_tax_tax_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleRequest) ProtoMessage() {}
+
+func (x *CreateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTaxRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateTaxRuleRequest) GetIsInclusive() bool {
+	if x != nil {
+		return x.IsInclusive
+	}
+	return false
+}
+
+func (x *CreateTaxRuleRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetExemptCategories() []string {
+	if x != nil {
+		return x.ExemptCategories
+	}
+	return nil
+}
+
+func (x *CreateTaxRuleRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type CreateTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleResponse) Reset() {
+	*x = CreateTaxRuleResponse{}
+	mi := &file_tax_tax_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleResponse) ProtoMessage() {}
+
+func (x *CreateTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTaxRuleResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateTaxRuleResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateTaxRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rate             float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	IsInclusive      bool                   `protobuf:"varint,4,opt,name=is_inclusive,json=isInclusive,proto3" json:"is_inclusive,omitempty"`
+	CurrencyCode     string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	ExemptCategories []string               `protobuf:"bytes,6,rep,name=exempt_categories,json=exemptCategories,proto3" json:"exempt_categories,omitempty"`
+	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateTaxRuleRequest) Reset() {
+	*x = UpdateTaxRuleRequest{}
+	mi := &file_tax_tax_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxRuleRequest) ProtoMessage() {}
+
+func (x *UpdateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateTaxRuleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaxRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaxRuleRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *UpdateTaxRuleRequest) GetIsInclusive() bool {
+	if x != nil {
+		return x.IsInclusive
+	}
+	return false
+}
+
+func (x *UpdateTaxRuleRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *UpdateTaxRuleRequest) GetExemptCategories() []string {
+	if x != nil {
+		return x.ExemptCategories
+	}
+	return nil
+}
+
+func (x *UpdateTaxRuleRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaxRuleResponse) Reset() {
+	*x = UpdateTaxRuleResponse{}
+	mi := &file_tax_tax_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxRuleResponse) ProtoMessage() {}
+
+func (x *UpdateTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTaxRuleResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeleteTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleRequest) Reset() {
+	*x = DeleteTaxRuleRequest{}
+	mi := &file_tax_tax_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTaxRuleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleResponse) Reset() {
+	*x = DeleteTaxRuleResponse{}
+	mi := &file_tax_tax_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleResponse) ProtoMessage() {}
+
+func (x *DeleteTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTaxRuleResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListTaxRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesRequest) Reset() {
+	*x = ListTaxRulesRequest{}
+	mi := &file_tax_tax_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesRequest) ProtoMessage() {}
+
+func (x *ListTaxRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesRequest) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{6}
+}
+
+type ListTaxRulesResponseItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rate             float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	IsInclusive      bool                   `protobuf:"varint,4,opt,name=is_inclusive,json=isInclusive,proto3" json:"is_inclusive,omitempty"`
+	CurrencyCode     string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	ExemptCategories []string               `protobuf:"bytes,6,rep,name=exempt_categories,json=exemptCategories,proto3" json:"exempt_categories,omitempty"`
+	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesResponseItem) Reset() {
+	*x = ListTaxRulesResponseItem{}
+	mi := &file_tax_tax_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesResponseItem) ProtoMessage() {}
+
+func (x *ListTaxRulesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesResponseItem.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesResponseItem) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTaxRulesResponseItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListTaxRulesResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTaxRulesResponseItem) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ListTaxRulesResponseItem) GetIsInclusive() bool {
+	if x != nil {
+		return x.IsInclusive
+	}
+	return false
+}
+
+func (x *ListTaxRulesResponseItem) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListTaxRulesResponseItem) GetExemptCategories() []string {
+	if x != nil {
+		return x.ExemptCategories
+	}
+	return nil
+}
+
+func (x *ListTaxRulesResponseItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type ListTaxRulesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Base          *common.BaseResponse        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListTaxRulesResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesResponse) Reset() {
+	*x = ListTaxRulesResponse{}
+	mi := &file_tax_tax_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesResponse) ProtoMessage() {}
+
+func (x *ListTaxRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tax_tax_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesResponse) Descriptor() ([]byte, []int) {
+	return file_tax_tax_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTaxRulesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTaxRulesResponse) GetData() []*ListTaxRulesResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_tax_tax_proto protoreflect.FileDescriptor
+
+const file_tax_tax_proto_rawDesc = "" +
+	"\n" +
+	"\rtax/tax.proto\x12\x03tax\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"\x8d\x02\n" +
+	"\x14CreateTaxRuleRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12+\n" +
+	"\x04rate\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12!\n" +
+	"\fis_inclusive\x18\x03 \x01(\bR\visInclusive\x12<\n" +
+	"\rcurrency_code\x18\x04 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\x12+\n" +
+	"\x11exempt_categories\x18\x05 \x03(\tR\x10exemptCategories\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\"Q\n" +
+	"\x15CreateTaxRuleResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"\xa6\x02\n" +
+	"\x14UpdateTaxRuleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12+\n" +
+	"\x04rate\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12!\n" +
+	"\fis_inclusive\x18\x04 \x01(\bR\visInclusive\x12<\n" +
+	"\rcurrency_code\x18\x05 \x01(\tB\x17\xbaH\x14r\x122\x10^([A-Za-z]{3})?$R\fcurrencyCode\x12+\n" +
+	"\x11exempt_categories\x18\x06 \x03(\tR\x10exemptCategories\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\"A\n" +
+	"\x15UpdateTaxRuleResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"/\n" +
+	"\x14DeleteTaxRuleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id\"A\n" +
+	"\x15DeleteTaxRuleResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x15\n" +
+	"\x13ListTaxRulesRequest\"\xe4\x01\n" +
+	"\x18ListTaxRulesResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12!\n" +
+	"\fis_inclusive\x18\x04 \x01(\bR\visInclusive\x12#\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x12+\n" +
+	"\x11exempt_categories\x18\x06 \x03(\tR\x10exemptCategories\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\"s\n" +
+	"\x14ListTaxRulesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x121\n" +
+	"\x04data\x18\x02 \x03(\v2\x1d.tax.ListTaxRulesResponseItemR\x04data2\xa9\x02\n" +
+	"\n" +
+	"TaxService\x12F\n" +
+	"\rCreateTaxRule\x12\x19.tax.CreateTaxRuleRequest\x1a\x1a.tax.CreateTaxRuleResponse\x12F\n" +
+	"\rUpdateTaxRule\x12\x19.tax.UpdateTaxRuleRequest\x1a\x1a.tax.UpdateTaxRuleResponse\x12F\n" +
+	"\rDeleteTaxRule\x12\x19.tax.DeleteTaxRuleRequest\x1a\x1a.tax.DeleteTaxRuleResponse\x12C\n" +
+	"\fListTaxRules\x12\x18.tax.ListTaxRulesRequest\x1a\x19.tax.ListTaxRulesResponseBn\n" +
+	"\acom.taxB\bTaxProtoP\x01Z-github.com/fahrillrizal/ecommerce-grpc/pb/tax\xa2\x02\x03TXX\xaa\x02\x03Tax\xca\x02\x03Tax\xe2\x02\x0fTax\\GPBMetadata\xea\x02\x03Taxb\x06proto3"
+
+var (
+	file_tax_tax_proto_rawDescOnce sync.Once
+	file_tax_tax_proto_rawDescData []byte
+)
+
+func file_tax_tax_proto_rawDescGZIP() []byte {
+	file_tax_tax_proto_rawDescOnce.Do(func() {
+		file_tax_tax_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tax_tax_proto_rawDesc), len(file_tax_tax_proto_rawDesc)))
+	})
+	return file_tax_tax_proto_rawDescData
+}
+
+var file_tax_tax_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tax_tax_proto_goTypes = []any{
+	(*CreateTaxRuleRequest)(nil),     // 0: tax.CreateTaxRuleRequest
+	(*CreateTaxRuleResponse)(nil),    // 1: tax.CreateTaxRuleResponse
+	(*UpdateTaxRuleRequest)(nil),     // 2: tax.UpdateTaxRuleRequest
+	(*UpdateTaxRuleResponse)(nil),    // 3: tax.UpdateTaxRuleResponse
+	(*DeleteTaxRuleRequest)(nil),     // 4: tax.DeleteTaxRuleRequest
+	(*DeleteTaxRuleResponse)(nil),    // 5: tax.DeleteTaxRuleResponse
+	(*ListTaxRulesRequest)(nil),      // 6: tax.ListTaxRulesRequest
+	(*ListTaxRulesResponseItem)(nil), // 7: tax.ListTaxRulesResponseItem
+	(*ListTaxRulesResponse)(nil),     // 8: tax.ListTaxRulesResponse
+	(*common.BaseResponse)(nil),      // 9: common.BaseResponse
+}
+var file_tax_tax_proto_depIdxs = []int32{
+	9, // 0: tax.CreateTaxRuleResponse.base:type_name -> common.BaseResponse
+	9, // 1: tax.UpdateTaxRuleResponse.base:type_name -> common.BaseResponse
+	9, // 2: tax.DeleteTaxRuleResponse.base:type_name -> common.BaseResponse
+	9, // 3: tax.ListTaxRulesResponse.base:type_name -> common.BaseResponse
+	7, // 4: tax.ListTaxRulesResponse.data:type_name -> tax.ListTaxRulesResponseItem
+	0, // 5: tax.TaxService.CreateTaxRule:input_type -> tax.CreateTaxRuleRequest
+	2, // 6: tax.TaxService.UpdateTaxRule:input_type -> tax.UpdateTaxRuleRequest
+	4, // 7: tax.TaxService.DeleteTaxRule:input_type -> tax.DeleteTaxRuleRequest
+	6, // 8: tax.TaxService.ListTaxRules:input_type -> tax.ListTaxRulesRequest
+	1, // 9: tax.TaxService.CreateTaxRule:output_type -> tax.CreateTaxRuleResponse
+	3, // 10: tax.TaxService.UpdateTaxRule:output_type -> tax.UpdateTaxRuleResponse
+	5, // 11: tax.TaxService.DeleteTaxRule:output_type -> tax.DeleteTaxRuleResponse
+	8, // 12: tax.TaxService.ListTaxRules:output_type -> tax.ListTaxRulesResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tax_tax_proto_init() }
+func file_tax_tax_proto_init() {
+	if File_tax_tax_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tax_tax_proto_rawDesc), len(file_tax_tax_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tax_tax_proto_goTypes,
+		DependencyIndexes: file_tax_tax_proto_depIdxs,
+		MessageInfos:      file_tax_tax_proto_msgTypes,
+	}.Build()
+	File_tax_tax_proto = out.File
+	file_tax_tax_proto_goTypes = nil
+	file_tax_tax_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: tax/tax.proto
+
+package tax
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaxService_CreateTaxRule_FullMethodName = "/tax.TaxService/CreateTaxRule"
+	TaxService_UpdateTaxRule_FullMethodName = "/tax.TaxService/UpdateTaxRule"
+	TaxService_DeleteTaxRule_FullMethodName = "/tax.TaxService/DeleteTaxRule"
+	TaxService_ListTaxRules_FullMethodName  = "/tax.TaxService/ListTaxRules"
+)
+
+// TaxServiceClient is the client API for TaxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaxServiceClient interface {
+	CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleResponse, error)
+	UpdateTaxRule(ctx context.Context, in *UpdateTaxRuleRequest, opts ...grpc.CallOption) (*UpdateTaxRuleResponse, error)
+	DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error)
+	ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error)
+}
+
+type taxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxServiceClient(cc grpc.ClientConnInterface) TaxServiceClient {
+	return &taxServiceClient{cc}
+}
+
+func (c *taxServiceClient) CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaxRuleResponse)
+	err := c.cc.Invoke(ctx, TaxService_CreateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) UpdateTaxRule(ctx context.Context, in *UpdateTaxRuleRequest, opts ...grpc.CallOption) (*UpdateTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTaxRuleResponse)
+	err := c.cc.Invoke(ctx, TaxService_UpdateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaxRuleResponse)
+	err := c.cc.Invoke(ctx, TaxService_DeleteTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxServiceClient) ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRulesResponse)
+	err := c.cc.Invoke(ctx, TaxService_ListTaxRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxServiceServer is the server API for TaxService service.
+// All implementations must embed UnimplementedTaxServiceServer
+// for forward compatibility.
+type TaxServiceServer interface {
+	CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleResponse, error)
+	UpdateTaxRule(context.Context, *UpdateTaxRuleRequest) (*UpdateTaxRuleResponse, error)
+	DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error)
+	ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error)
+	mustEmbedUnimplementedTaxServiceServer()
+}
+
+// UnimplementedTaxServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaxServiceServer struct{}
+
+func (UnimplementedTaxServiceServer) CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxRule not implemented")
+}
+func (UnimplementedTaxServiceServer) UpdateTaxRule(context.Context, *UpdateTaxRuleRequest) (*UpdateTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaxRule not implemented")
+}
+func (UnimplementedTaxServiceServer) DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxRule not implemented")
+}
+func (UnimplementedTaxServiceServer) ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxRules not implemented")
+}
+func (UnimplementedTaxServiceServer) mustEmbedUnimplementedTaxServiceServer() {}
+func (UnimplementedTaxServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTaxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxServiceServer will
+// result in compilation errors.
+type UnsafeTaxServiceServer interface {
+	mustEmbedUnimplementedTaxServiceServer()
+}
+
+func RegisterTaxServiceServer(s grpc.ServiceRegistrar, srv TaxServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaxServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaxService_ServiceDesc, srv)
+}
+
+func _TaxService_CreateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).CreateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_CreateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).CreateTaxRule(ctx, req.(*CreateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_UpdateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).UpdateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_UpdateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).UpdateTaxRule(ctx, req.(*UpdateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_DeleteTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).DeleteTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_DeleteTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).DeleteTaxRule(ctx, req.(*DeleteTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxService_ListTaxRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxServiceServer).ListTaxRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxService_ListTaxRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxServiceServer).ListTaxRules(ctx, req.(*ListTaxRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxService_ServiceDesc is the grpc.ServiceDesc for TaxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tax.TaxService",
+	HandlerType: (*TaxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTaxRule",
+			Handler:    _TaxService_CreateTaxRule_Handler,
+		},
+		{
+			MethodName: "UpdateTaxRule",
+			Handler:    _TaxService_UpdateTaxRule_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRule",
+			Handler:    _TaxService_DeleteTaxRule_Handler,
+		},
+		{
+			MethodName: "ListTaxRules",
+			Handler:    _TaxService_ListTaxRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tax/tax.proto",
+}
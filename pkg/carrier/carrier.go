@@ -0,0 +1,51 @@
+// Package carrier looks up the delivery status of shipments with the
+// carriers that carry them.
+package carrier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type TrackingRequest struct {
+	Carrier        string
+	TrackingNumber string
+	ShippedAt      time.Time
+}
+
+type TrackingStatus struct {
+	Delivered   bool
+	DeliveredAt *time.Time
+	Description string
+}
+
+// Tracker is implemented for each carrier integration.
+type Tracker interface {
+	Track(ctx context.Context, req TrackingRequest) (*TrackingStatus, error)
+}
+
+// NewTrackerFromEnv returns the tracker named by CARRIER_TRACKER, or nil
+// when none is set. The fake tracker has to be asked for by name, since it
+// marks every shipment delivered without asking anyone. It delivers after
+// CARRIER_FAKE_DELIVER_AFTER_HOURS, 48 by default.
+func NewTrackerFromEnv() (Tracker, error) {
+	switch os.Getenv("CARRIER_TRACKER") {
+	case "":
+		return nil, nil
+	case "fake":
+		hours := 48
+		if raw := os.Getenv("CARRIER_FAKE_DELIVER_AFTER_HOURS"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed <= 0 {
+				return nil, fmt.Errorf("carrier: invalid CARRIER_FAKE_DELIVER_AFTER_HOURS %s", raw)
+			}
+			hours = parsed
+		}
+		return NewFakeTracker(time.Duration(hours) * time.Hour), nil
+	default:
+		return nil, fmt.Errorf("carrier: unknown CARRIER_TRACKER %s", os.Getenv("CARRIER_TRACKER"))
+	}
+}
@@ -0,0 +1,31 @@
+package carrier
+
+import (
+	"context"
+	"time"
+)
+
+// FakeTracker reports every shipment as delivered once DeliverAfter has
+// passed since it was shipped. It stands in for a real carrier integration.
+type FakeTracker struct {
+	DeliverAfter time.Duration
+}
+
+func (ft *FakeTracker) Track(ctx context.Context, req TrackingRequest) (*TrackingStatus, error) {
+	deliveredAt := req.ShippedAt.Add(ft.DeliverAfter)
+	if time.Now().Before(deliveredAt) {
+		return &TrackingStatus{Description: "In transit"}, nil
+	}
+
+	return &TrackingStatus{
+		Delivered:   true,
+		DeliveredAt: &deliveredAt,
+		Description: "Delivered",
+	}, nil
+}
+
+func NewFakeTracker(deliverAfter time.Duration) Tracker {
+	return &FakeTracker{
+		DeliverAfter: deliverAfter,
+	}
+}
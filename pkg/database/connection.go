@@ -46,7 +46,8 @@ func InitDB() (*gorm.DB, error) {
 				Colorful:                  true,
 			},
 		),
-		PrepareStmt: true,
+		PrepareStmt:    true,
+		TranslateError: true,
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
@@ -69,11 +70,26 @@ func InitDB() (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	err = prepareMigration(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare database migration: %w", err)
+	}
+
 	err = db.AutoMigrate(models.RegisteredModels...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
+	err = seedOrderStatuses(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seed order statuses: %w", err)
+	}
+
+	err = seedNumberSequences(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seed number sequences: %w", err)
+	}
+
 	return db, nil
 }
 
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+// prepareMigration fixes data that would stop AutoMigrate from adding
+// constraints.
+func prepareMigration(db *gorm.DB) error {
+	err := dedupeNewsletters(db)
+	if err != nil {
+		return err
+	}
+
+	return deactivateDuplicateTaxRules(db)
+}
+
+// dedupeNewsletters lower-cases subscriber emails and keeps only the oldest
+// row of each address, so the unique index on email can be created.
+func dedupeNewsletters(db *gorm.DB) error {
+	if !db.Migrator().HasTable("newsletter") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			DELETE FROM newsletter a
+			USING newsletter b
+			WHERE LOWER(TRIM(a.email)) = LOWER(TRIM(b.email))
+			AND a.id > b.id`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			UPDATE newsletter
+			SET email = LOWER(TRIM(email))
+			WHERE email <> LOWER(TRIM(email))`).Error
+	})
+}
+
+// deactivateDuplicateTaxRules keeps only the newest active tax rule of each
+// currency active, so the partial unique index on active rules can be created.
+func deactivateDuplicateTaxRules(db *gorm.DB) error {
+	if !db.Migrator().HasTable("tax_rule") {
+		return nil
+	}
+
+	return db.Exec(`
+		UPDATE tax_rule a
+		SET is_active = FALSE
+		FROM tax_rule b
+		WHERE a.currency_code = b.currency_code
+		AND a.is_active AND NOT a.is_deleted
+		AND b.is_active AND NOT b.is_deleted
+		AND a.id < b.id`).Error
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/numbering"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var orderStatuses = []models.OrderStatus{
+	{Code: models.OrderStatusCodeUnpaid, Name: "Unpaid", Description: "Waiting for payment"},
+	{Code: models.OrderStatusCodePaid, Name: "Paid", Description: "Paid and waiting to be shipped"},
+	{Code: models.OrderStatusCodeShipped, Name: "Shipped", Description: "Handed to the carrier"},
+	{Code: models.OrderStatusCodeCompleted, Name: "Completed", Description: "Delivered to the customer"},
+	{Code: models.OrderStatusCodeCanceled, Name: "Canceled", Description: "Canceled before it was shipped"},
+}
+
+var orderStatusTransitions = []models.OrderStatusTransition{
+	{
+		FromStatusCode:    models.OrderStatusCodeUnpaid,
+		ToStatusCode:      models.OrderStatusCodePaid,
+		AllowedActorTypes: []string{models.OrderActorTypeWebhook, models.OrderActorTypeAdmin},
+	},
+	{
+		FromStatusCode:    models.OrderStatusCodeUnpaid,
+		ToStatusCode:      models.OrderStatusCodeCanceled,
+		AllowedActorTypes: []string{models.OrderActorTypeUser, models.OrderActorTypeAdmin, models.OrderActorTypeSystem},
+	},
+	{
+		FromStatusCode:    models.OrderStatusCodePaid,
+		ToStatusCode:      models.OrderStatusCodeShipped,
+		AllowedActorTypes: []string{models.OrderActorTypeAdmin},
+	},
+	{
+		FromStatusCode:    models.OrderStatusCodePaid,
+		ToStatusCode:      models.OrderStatusCodeCanceled,
+		AllowedActorTypes: []string{models.OrderActorTypeUser, models.OrderActorTypeAdmin},
+	},
+	{
+		FromStatusCode:    models.OrderStatusCodeShipped,
+		ToStatusCode:      models.OrderStatusCodeCompleted,
+		AllowedActorTypes: []string{models.OrderActorTypeUser, models.OrderActorTypeAdmin, models.OrderActorTypeSystem},
+	},
+}
+
+// seedOrderStatuses inserts the order statuses and transitions that are
+// missing. Rows that already exist are left alone so changes made in the
+// database are kept.
+func seedOrderStatuses(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, s := range orderStatuses {
+			status := s
+			status.CreatedBy = "System"
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "code"}},
+				DoNothing: true,
+			}).Create(&status).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, t := range orderStatusTransitions {
+			transition := t
+			transition.CreatedBy = "System"
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "from_status_code"}, {Name: "to_status_code"}},
+				DoNothing: true,
+			}).Create(&transition).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.Order{}).
+			Where("order_status_code = ?", models.OrderStatusCodeDone).
+			Update("order_status_code", models.OrderStatusCodeCompleted).Error
+	})
+}
+
+// seedNumberSequences moves the counters of the old numbering table to the
+// current period of each module's number format. The old counter never
+// started over, so carrying it on keeps the numbers of this period from
+// repeating ones already issued.
+func seedNumberSequences(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var numberings []models.Numbering
+		err := tx.Find(&numberings).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		formats := numbering.FormatsFromEnv()
+		for _, n := range numberings {
+			period := strconv.Itoa(now.Year())
+			if format, exists := formats[n.Module]; exists {
+				period = format.Period(now)
+			}
+
+			// The old counter held the next number to hand out.
+			last := int64(n.Number) - 1
+			if last < 0 {
+				last = 0
+			}
+
+			err = tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "module"}, {Name: "period"}},
+				DoUpdates: clause.Set{{Column: clause.Column{Name: "number"}, Value: gorm.Expr("GREATEST(number_sequence.number, ?)", last)}},
+			}).Create(&models.NumberSequence{
+				Module: n.Module,
+				Period: period,
+				Number: last,
+			}).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Delete(&models.Numbering{}, "module = ?", n.Module).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
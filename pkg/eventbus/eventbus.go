@@ -0,0 +1,82 @@
+// Package eventbus passes events between parts of the application running in
+// the same process. Events are not stored, a subscriber only sees what is
+// published while it is subscribed.
+package eventbus
+
+import "sync"
+
+// Bus delivers every published event to the subscribers whose filter
+// accepts it.
+type Bus[T any] struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]*subscriber[T]
+}
+
+type subscriber[T any] struct {
+	events chan T
+	filter func(T) bool
+}
+
+// Subscribe returns a channel of the events filter accepts, and a function
+// that ends the subscription. A nil filter accepts every event. Publish never
+// waits for a subscriber: one that lets buffer events pile up is dropped and
+// its channel closed.
+func (b *Bus[T]) Subscribe(buffer int, filter func(T) bool) (<-chan T, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	s := &subscriber[T]{
+		events: make(chan T, buffer),
+		filter: filter,
+	}
+	b.subscribers[id] = s
+
+	var once sync.Once
+	return s.events, func() {
+		once.Do(func() { b.remove(id) })
+	}
+}
+
+func (b *Bus[T]) Publish(event T) {
+	b.mu.RLock()
+	dropped := make([]int, 0)
+	for id, s := range b.subscribers {
+		if s.filter != nil && !s.filter(event) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			dropped = append(dropped, id)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, id := range dropped {
+		b.remove(id)
+	}
+}
+
+func (b *Bus[T]) remove(id int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, exists := b.subscribers[id]
+	if !exists {
+		return
+	}
+
+	delete(b.subscribers, id)
+	close(s.events)
+}
+
+func New[T any]() *Bus[T] {
+	return &Bus[T]{
+		subscribers: make(map[int]*subscriber[T]),
+	}
+}
@@ -0,0 +1,40 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// formulaPrefixes start a cell that spreadsheet apps read as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+// WriteRow writes one record. Cells that would be read as a formula when the
+// file is opened in a spreadsheet are prefixed with a quote, so customer
+// input such as a name or address is shown as text instead of run.
+func (cw *csvWriter) WriteRow(cells []string) error {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune(formulaPrefixes, rune(cell[0])) {
+			cell = "'" + cell
+		}
+		escaped[i] = cell
+	}
+
+	return cw.writer.Write(escaped)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+func NewCSVWriter(w io.Writer) RowWriter {
+	return &csvWriter{
+		writer: csv.NewWriter(w),
+	}
+}
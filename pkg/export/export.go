@@ -0,0 +1,52 @@
+// Package export writes tabular reports as spreadsheet files, one row at a
+// time so large reports never sit in memory.
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// RowWriter writes the rows of a single sheet. Close must be called to
+// finish the file.
+type RowWriter interface {
+	WriteRow(cells []string) error
+	Close() error
+}
+
+// CheckFormat returns an error when the format is not supported, so a
+// request can be refused before any part of the file is sent.
+func CheckFormat(format string) error {
+	switch strings.ToLower(format) {
+	case FormatCSV, FormatXLSX:
+		return nil
+	default:
+		return fmt.Errorf("export: unsupported format %s", format)
+	}
+}
+
+// NewRowWriter returns a writer for the format.
+func NewRowWriter(format string, w io.Writer) (RowWriter, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatXLSX:
+		return NewXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("export: unsupported format %s", format)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func ContentType(format string) string {
+	if strings.ToLower(format) == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
@@ -0,0 +1,186 @@
+// Package mail sends email through a pluggable Transport: SMTP in
+// production, and a directory of .eml files or the log in development.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Message is an email with a plain text body and, optionally, an HTML one.
+type Message struct {
+	To      string
+	ToName  string
+	Subject string
+	Text    string
+	HTML    string
+	// Headers are added as they are, e.g. List-Unsubscribe.
+	Headers map[string]string
+}
+
+type Transport interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewTransportFromEnv picks the transport named by MAIL_TRANSPORT:
+//
+//	smtp  SMTP_HOST, SMTP_PORT, SMTP_USERNAME and SMTP_PASSWORD
+//	file  writes each message to MAIL_FILE_DIR
+//	log   logs each message, the default
+//
+// MAIL_FROM is the sender address of every transport.
+func NewTransportFromEnv() (Transport, error) {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "Store <no-reply@localhost>"
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("mail: invalid MAIL_FROM: %w", err)
+	}
+
+	switch os.Getenv("MAIL_TRANSPORT") {
+	case "smtp":
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			return nil, fmt.Errorf("mail: SMTP_HOST is not set")
+		}
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		return NewSMTPTransport(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from), nil
+	case "file":
+		dir := os.Getenv("MAIL_FILE_DIR")
+		if dir == "" {
+			dir = "mail"
+		}
+		return NewFileTransport(dir, from)
+	case "", "log":
+		return NewLogTransport(from), nil
+	default:
+		return nil, fmt.Errorf("mail: unknown MAIL_TRANSPORT %s", os.Getenv("MAIL_TRANSPORT"))
+	}
+}
+
+// Bytes renders the message as RFC 5322, multipart/alternative when it has
+// an HTML body.
+func (m Message) Bytes(from string, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	to := m.To
+	if m.ToName != "" {
+		to = (&mail.Address{Name: m.ToName, Address: m.To}).String()
+	}
+
+	id, err := messageID(from)
+	if err != nil {
+		return nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from)
+	header.Set("To", to)
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header.Set("Date", now.Format(time.RFC1123Z))
+	header.Set("Message-ID", id)
+	header.Set("MIME-Version", "1.0")
+	for key, value := range m.Headers {
+		header.Set(key, value)
+	}
+
+	if m.HTML == "" {
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, m.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	body := multipart.NewWriter(&buf)
+	header.Set("Content-Type", "multipart/alternative; boundary="+body.Boundary())
+	writeHeader(&buf, header)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	// Fixed order keeps the output readable and stable.
+	keys := []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version"}
+	written := make(map[string]bool)
+	for _, key := range keys {
+		fmt.Fprintf(buf, "%s: %s\r\n", key, header.Get(key))
+		written[textproto.CanonicalMIMEHeaderKey(key)] = true
+	}
+	rest := make([]string, 0, len(header))
+	for key := range header {
+		if !written[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	for _, key := range rest {
+		fmt.Fprintf(buf, "%s: %s\r\n", key, header.Get(key))
+	}
+	buf.WriteString("\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, s string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(s, "\n", "\r\n"))); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func messageID(from string) (string, error) {
+	domain := "localhost"
+	if address, err := mail.ParseAddress(from); err == nil {
+		if at := strings.LastIndex(address.Address, "@"); at >= 0 {
+			domain = address.Address[at+1:]
+		}
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
+}
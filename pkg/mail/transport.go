@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type smtpTransport struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// Send delivers through the server, upgrading to TLS with STARTTLS when the
+// server offers it.
+func (st *smtpTransport) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	content, err := message.Bytes(st.from, time.Now())
+	if err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(st.from)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(st.addr, st.auth, sender.Address, []string{message.To}, content)
+}
+
+// NewSMTPTransport sends through host:port. Authentication is skipped when
+// username is empty, as for a local relay.
+func NewSMTPTransport(host, port, username, password, from string) Transport {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &smtpTransport{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+type fileTransport struct {
+	dir  string
+	from string
+}
+
+// Send writes the message to a new .eml file, which any mail client opens.
+func (ft *fileTransport) Send(ctx context.Context, message Message) error {
+	now := time.Now()
+	content, err := message.Bytes(ft.from, now)
+	if err != nil {
+		return err
+	}
+
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(message.To)
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102-150405.000000000"), recipient)
+	return os.WriteFile(filepath.Join(ft.dir, name), content, 0o644)
+}
+
+func NewFileTransport(dir, from string) (Transport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("mail: failed to create %s: %w", dir, err)
+	}
+
+	return &fileTransport{
+		dir:  dir,
+		from: from,
+	}, nil
+}
+
+type logTransport struct {
+	from string
+}
+
+func (lt *logTransport) Send(ctx context.Context, message Message) error {
+	log.Printf("mail from %s to %s: %s\n%s", lt.from, message.To, message.Subject, message.Text)
+	return nil
+}
+
+// NewLogTransport logs the text body of every message instead of sending
+// it.
+func NewLogTransport(from string) Transport {
+	return &logTransport{
+		from: from,
+	}
+}
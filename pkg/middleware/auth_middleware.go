@@ -26,8 +26,40 @@ func (am *authMiddleware) Middleware(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	if am.isPublicEndpoint(info.FullMethod) {
-		return handler(ctx, req)
+	ctx, err := am.Authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (am *authMiddleware) StreamMiddleware(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := am.Authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// Authenticate checks the token in the incoming metadata against the rules
+// of the method and returns the context with the caller's claims.
+func (am *authMiddleware) Authenticate(ctx context.Context, method string) (context.Context, error) {
+	if am.isPublicEndpoint(method) {
+		return ctx, nil
+	}
+
+	// Guests may call these without a token; claims are only injected when one is sent.
+	if am.isOptionalAuthEndpoint(method) {
+		if _, err := utils.ExtractTokenFromContext(ctx); err != nil {
+			return ctx, nil
+		}
 	}
 
 	tokenStr, err := utils.ExtractTokenFromContext(ctx)
@@ -50,11 +82,21 @@ func (am *authMiddleware) Middleware(
 
 	ctx = utils.InjectClaimsToContext(ctx, claims)
 
-	if am.isAdminOnlyEndpoint(info.FullMethod) && claims.RoleCode != "ADMIN" {
+	if am.isAdminOnlyEndpoint(method) && claims.RoleCode != "ADMIN" {
 		return nil, status.Error(codes.PermissionDenied, "Only administrators can perform this action")
 	}
 
-	return handler(ctx, req)
+	return ctx, nil
+}
+
+// authServerStream hands the authenticated context to stream handlers.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (as *authServerStream) Context() context.Context {
+	return as.ctx
 }
 
 func (am *authMiddleware) isPublicEndpoint(method string) bool {
@@ -65,6 +107,11 @@ func (am *authMiddleware) isPublicEndpoint(method string) bool {
 		"/product.ProductService/DetailProduct",
 		"/product.ProductService/HighlightProducts",
 		"/newsletter.NewsletterService/Subscribe",
+		"/newsletter.NewsletterService/ConfirmSubscription",
+		"/newsletter.NewsletterService/Unsubscribe",
+		"/newsletter.NewsletterService/GetPreferences",
+		"/newsletter.NewsletterService/UpdatePreferences",
+		"/cart.CartService/CreateGuestCart",
 	}
 
 	for _, endpoint := range publicEndpoints {
@@ -76,11 +123,78 @@ func (am *authMiddleware) isPublicEndpoint(method string) bool {
 	return false
 }
 
+func (am *authMiddleware) isOptionalAuthEndpoint(method string) bool {
+	optionalAuthEndpoints := []string{
+		"/cart.CartService/AddToCart",
+		"/cart.CartService/ListCart",
+		"/cart.CartService/DeleteCart",
+		"/cart.CartService/UpdateCartQty",
+		"/cart.CartService/BulkUpdateCart",
+		"/cart.CartService/ClearCart",
+	}
+
+	for _, endpoint := range optionalAuthEndpoints {
+		if method == endpoint {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (am *authMiddleware) isAdminOnlyEndpoint(method string) bool {
 	adminOnlyEndpoints := []string{
 		"/product.ProductService/CreateProduct",
 		"/product.ProductService/UpdateProduct",
 		"/product.ProductService/DeleteProduct",
+		"/wishlist.WishlistService/MostWishlistedProducts",
+		"/promotion.PromotionService/CreatePromotion",
+		"/promotion.PromotionService/UpdatePromotion",
+		"/promotion.PromotionService/DeletePromotion",
+		"/promotion.PromotionService/DetailPromotion",
+		"/promotion.PromotionService/ListPromotion",
+		"/pricing.PricingService/SetProductPrice",
+		"/pricing.PricingService/DeleteProductPrice",
+		"/pricing.PricingService/ListProductPrices",
+		"/pricing.PricingService/SetExchangeRate",
+		"/pricing.PricingService/ListExchangeRates",
+		"/pricing.PricingService/DeriveProductPrices",
+		"/tax.TaxService/CreateTaxRule",
+		"/tax.TaxService/UpdateTaxRule",
+		"/tax.TaxService/DeleteTaxRule",
+		"/tax.TaxService/ListTaxRules",
+		"/shipping.ShippingService/CreateShippingMethod",
+		"/shipping.ShippingService/UpdateShippingMethod",
+		"/shipping.ShippingService/DeleteShippingMethod",
+		"/shipping.ShippingService/ListShippingMethods",
+		"/shipping.ShippingService/SetShippingRates",
+		"/order.OrderService/CreateShipment",
+		"/order.OrderService/ListOrderStatusHistory",
+		"/order.OrderService/ListOrderStatuses",
+		"/returns.ReturnService/ApproveReturn",
+		"/returns.ReturnService/RejectReturn",
+		"/returns.ReturnService/ReceiveReturn",
+		"/returns.ReturnService/ListReturns",
+		"/returns.ReturnService/RefundReport",
+		"/order.OrderService/ExportOrders",
+		"/order.OrderService/WatchOrders",
+		"/integration.IntegrationService/CreateWebhookSubscription",
+		"/integration.IntegrationService/UpdateWebhookSubscription",
+		"/integration.IntegrationService/DeleteWebhookSubscription",
+		"/integration.IntegrationService/ListWebhookSubscriptions",
+		"/integration.IntegrationService/PingWebhookSubscription",
+		"/integration.IntegrationService/ListWebhookDeliveries",
+		"/integration.IntegrationService/RetryWebhookDelivery",
+		"/notification.NotificationService/ListNotifications",
+		"/newsletter.NewsletterService/ListSubscribers",
+		"/newsletter.NewsletterService/ExportSubscribers",
+		"/newsletter.NewsletterService/CreateCampaign",
+		"/newsletter.NewsletterService/UpdateCampaign",
+		"/newsletter.NewsletterService/SendTestCampaign",
+		"/newsletter.NewsletterService/ScheduleCampaign",
+		"/newsletter.NewsletterService/CancelCampaign",
+		"/newsletter.NewsletterService/ListCampaigns",
+		"/newsletter.NewsletterService/DetailCampaign",
 	}
 
 	for _, endpoint := range adminOnlyEndpoints {
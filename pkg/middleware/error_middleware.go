@@ -31,3 +31,25 @@ func ErrorMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, h
 
 	return res, err
 }
+
+func ErrorStreamMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("recovered from panic: %v", r)
+			debug.PrintStack()
+			err = status.Errorf(codes.Internal, "internal server error")
+		}
+	}()
+	err = handler(srv, ss)
+	if err != nil {
+		log.Println(err)
+
+		if st, ok := status.FromError(err); ok {
+			return status.Error(st.Code(), st.Message())
+		}
+
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/internal/repositories"
+	"github.com/fahrillrizal/ecommerce-grpc/internal/utils"
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	idempotencyKeyHeader    = "idempotency-key"
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyMiddleware makes retries of mutating RPCs safe. When a request
+// to one of its endpoints carries an idempotency-key header, the response is
+// stored per user and key, and a retry with the same key and payload gets the
+// stored response without the request running again. It has to run after
+// authMiddleware, since keys are kept per user.
+//
+// ttl is how long a response is replayed. lease is how long a request that
+// has not finished holds its key. It has to outlast the slowest request, as
+// once it passes a retry runs the request again.
+type idempotencyMiddleware struct {
+	idempotencyRepository repositories.IIdempotencyRepository
+	ttl                   time.Duration
+	lease                 time.Duration
+}
+
+func NewIdempotencyMiddleware(idempotencyRepository repositories.IIdempotencyRepository, ttl time.Duration, lease time.Duration) *idempotencyMiddleware {
+	return &idempotencyMiddleware{
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   ttl,
+		lease:                 lease,
+	}
+}
+
+func (im *idempotencyMiddleware) Middleware(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !im.isIdempotentEndpoint(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	key := idempotencyKeyFromContext(ctx)
+	if key == "" {
+		return handler(ctx, req)
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	claims, err := utils.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "idempotency keys need an authenticated user")
+	}
+
+	message, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	requestHash, err := hashRequest(info.FullMethod, message)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash request")
+	}
+
+	now := time.Now()
+	lockedUntil := now.Add(im.lease)
+	record := &models.IdempotencyKey{
+		UserID:      claims.UserID,
+		Key:         key,
+		Method:      info.FullMethod,
+		RequestHash: requestHash,
+		LockedUntil: &lockedUntil,
+		ExpiresAt:   now.Add(im.ttl),
+		CreatedAt:   now,
+	}
+
+	existing, err := im.idempotencyRepository.Reserve(ctx, record)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check idempotency key")
+	}
+	if existing != nil {
+		return replayResponse(existing, record)
+	}
+
+	// The outcome has to be recorded even when the client gave up waiting.
+	storeCtx := context.WithoutCancel(ctx)
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		// The request did not go through, so it may be tried again.
+		releaseErr := im.idempotencyRepository.Release(storeCtx, record.ID)
+		if releaseErr != nil {
+			log.Printf("failed to release idempotency key %d: %v", record.ID, releaseErr)
+		}
+		return nil, err
+	}
+
+	response, err := marshalResponse(res)
+	if err == nil {
+		err = im.idempotencyRepository.Complete(storeCtx, record.ID, response, time.Now())
+	}
+	if err != nil {
+		// The request already ran. The key stays reserved until its lease
+		// ends, which is as long as a retry can be kept from running it again.
+		log.Printf("failed to store response for idempotency key %d: %v", record.ID, err)
+	}
+
+	return res, nil
+}
+
+// Run removes expired keys every interval until ctx is done.
+func (im *idempotencyMiddleware) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := im.idempotencyRepository.DeleteExpired(ctx, time.Now()); err != nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+			}
+		}
+	}
+}
+
+func replayResponse(existing *models.IdempotencyKey, record *models.IdempotencyKey) (interface{}, error) {
+	if existing.Method != record.Method || existing.RequestHash != record.RequestHash {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used for a different request")
+	}
+
+	if existing.CompletedAt == nil {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	if len(existing.Response) == 0 {
+		return nil, status.Error(codes.Internal, "response for this idempotency key was not stored")
+	}
+
+	var stored anypb.Any
+	err := proto.Unmarshal(existing.Response, &stored)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read stored response")
+	}
+
+	res, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read stored response")
+	}
+
+	return res, nil
+}
+
+func marshalResponse(res interface{}) ([]byte, error) {
+	message, ok := res.(proto.Message)
+	if !ok {
+		return nil, status.Error(codes.Internal, "response is not a protobuf message")
+	}
+
+	stored, err := anypb.New(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(stored)
+}
+
+// hashRequest fingerprints the payload of a request. Deterministic
+// marshaling keeps the hash stable for equal messages.
+func hashRequest(method string, message proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (im *idempotencyMiddleware) isIdempotentEndpoint(method string) bool {
+	idempotentEndpoints := []string{
+		"/order.OrderService/CreateOrder",
+		"/order.OrderService/CancelOrder",
+		"/order.OrderService/CreateShipment",
+		"/returns.ReturnService/RequestReturn",
+		"/returns.ReturnService/ReceiveReturn",
+	}
+
+	for _, endpoint := range idempotentEndpoints {
+		if method == endpoint {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,206 @@
+// Package money implements exact currency amounts stored as integer minor
+// units, matching the decimal(15,2) columns used by the models.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places kept for every amount.
+const Scale = 2
+
+// DefaultCurrency is the ISO 4217 code of the store currency. Product base
+// prices and promotion amounts are kept in it.
+const DefaultCurrency = "IDR"
+
+// SupportedCurrencies lists the currencies shoppers can pay in.
+var SupportedCurrencies = []string{DefaultCurrency, "SGD", "MYR"}
+
+const factor = 100
+
+// Amount is a currency amount in hundredths of the currency unit.
+type Amount int64
+
+// FromMinor returns the amount for the given number of minor units.
+func FromMinor(units int64) Amount {
+	return Amount(units)
+}
+
+// FromFloat converts a float to an amount, rounding half away from zero.
+// It is only meant for values that are floats at the boundary, such as
+// amounts reported by payment gateways.
+func FromFloat(f float64) Amount {
+	return Amount(math.Round(f * factor))
+}
+
+// Parse reads a decimal string such as "1500", "1500.5" or "-12.34".
+// More than Scale decimal places are rejected rather than rounded.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("money: empty amount")
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("money: invalid amount %q", s)
+	}
+	if len(frac) > Scale {
+		if strings.TrimRight(frac[Scale:], "0") != "" {
+			return 0, fmt.Errorf("money: amount %q has more than %d decimal places", s, Scale)
+		}
+		frac = frac[:Scale]
+	}
+	frac += strings.Repeat("0", Scale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	digits := whole + frac
+	if strings.TrimLeft(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("money: invalid amount %q", s)
+	}
+
+	units, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("money: invalid amount %q", s)
+	}
+
+	if negative {
+		units = -units
+	}
+	return Amount(units), nil
+}
+
+// Minor returns the amount in minor units.
+func (a Amount) Minor() int64 {
+	return int64(a)
+}
+
+// Float64 returns the amount in major units. Use it only when handing the
+// amount to an API that takes floats.
+func (a Amount) Float64() float64 {
+	return float64(a) / factor
+}
+
+// Mul multiplies the amount by a quantity.
+func (a Amount) Mul(quantity int) Amount {
+	return a * Amount(quantity)
+}
+
+// Percent returns percent of the amount, rounded half away from zero to the
+// nearest minor unit.
+func (a Amount) Percent(percent float64) Amount {
+	return Amount(math.Round(float64(a) * percent / 100))
+}
+
+// PercentIncluded returns the part of the amount that is a percent surcharge
+// already included in it, e.g. the tax inside a tax-inclusive price.
+func (a Amount) PercentIncluded(percent float64) Amount {
+	return a - Amount(math.Round(float64(a)/(1+percent/100)))
+}
+
+// Convert applies an exchange rate, rounding half away from zero to the
+// nearest minor unit. Every supported currency uses the same Scale.
+func (a Amount) Convert(rate float64) Amount {
+	return Amount(math.Round(float64(a) * rate))
+}
+
+// Min returns the smaller of the two amounts.
+func Min(a, b Amount) Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// NormalizeCurrency upper-cases the code and checks it is supported. An
+// empty code selects DefaultCurrency.
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+
+	for _, supported := range SupportedCurrencies {
+		if code == supported {
+			return code, nil
+		}
+	}
+
+	return "", fmt.Errorf("money: unsupported currency %s", code)
+}
+
+// String formats the amount with exactly Scale decimal places, e.g. "10.50".
+func (a Amount) String() string {
+	units := int64(a)
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, units/factor, units%factor)
+}
+
+// Value stores the amount as a decimal string so no precision is lost.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan reads a decimal column value.
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	case int64:
+		*a = Amount(v * factor)
+		return nil
+	case float64:
+		*a = FromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("money: cannot scan %T into Amount", src)
+	}
+}
+
+func (a *Amount) scanString(s string) error {
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// MarshalJSON writes the amount as a JSON number with Scale decimal places.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a decimal string.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		s = string(data)
+	}
+	return a.scanString(s)
+}
@@ -0,0 +1,181 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: "1500", want: 150000},
+		{in: "1500.5", want: 150050},
+		{in: "-12.34", want: -1234},
+		{in: "+0.1", want: 10},
+		{in: ".5", want: 50},
+		{in: "1.230", want: 123},
+		{in: "  7 ", want: 700},
+		{in: "-0.01", want: -1},
+		{in: "1.234", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "1a", wantErr: true},
+		{in: "--1", wantErr: true},
+		{in: "1.-5", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %d, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount  Amount
+		percent float64
+		want    Amount
+	}{
+		{amount: 10000, percent: 11, want: 1100},
+		{amount: 1005, percent: 10, want: 101},
+		{amount: -1005, percent: 10, want: -101},
+		{amount: 333, percent: 33.3, want: 111},
+		{amount: 0, percent: 11, want: 0},
+		{amount: 10000, percent: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		got := tt.amount.Percent(tt.percent)
+		if got != tt.want {
+			t.Errorf("Amount(%d).Percent(%v) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestPercentIncluded(t *testing.T) {
+	tests := []struct {
+		amount  Amount
+		percent float64
+		want    Amount
+	}{
+		{amount: 11100, percent: 11, want: 1100},
+		{amount: -11100, percent: 11, want: -1100},
+		{amount: 1000, percent: 10, want: 91},
+		{amount: 0, percent: 11, want: 0},
+		{amount: 1000, percent: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		got := tt.amount.PercentIncluded(tt.percent)
+		if got != tt.want {
+			t.Errorf("Amount(%d).PercentIncluded(%v) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		rate   float64
+		want   Amount
+	}{
+		{amount: 100000, rate: 0.0001, want: 10},
+		{amount: 150, rate: 0.5, want: 75},
+		{amount: 1, rate: 0.5, want: 1},
+		{amount: -1, rate: 0.5, want: -1},
+		{amount: 12345, rate: 0.000085, want: 1},
+		{amount: 100, rate: 1, want: 100},
+	}
+
+	for _, tt := range tests {
+		got := tt.amount.Convert(tt.rate)
+		if got != tt.want {
+			t.Errorf("Amount(%d).Convert(%v) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		want   string
+	}{
+		{amount: 1250, want: "12.50"},
+		{amount: -5, want: "-0.05"},
+		{amount: -1234, want: "-12.34"},
+		{amount: 0, want: "0.00"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.amount.Value()
+		if err != nil {
+			t.Errorf("Amount(%d).Value() returned error: %v", tt.amount, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Amount(%d).Value() = %v, want %q", tt.amount, got, tt.want)
+		}
+
+		var scanned Amount
+		err = scanned.Scan(got)
+		if err != nil {
+			t.Errorf("Scan(%v) returned error: %v", got, err)
+			continue
+		}
+		if scanned != tt.amount {
+			t.Errorf("Scan(%v) = %d, want %d", got, scanned, tt.amount)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		src     interface{}
+		want    Amount
+		wantErr bool
+	}{
+		{src: nil, want: 0},
+		{src: []byte("12.50"), want: 1250},
+		{src: "-1.2", want: -120},
+		{src: int64(5), want: 500},
+		{src: int64(-5), want: -500},
+		{src: 2.5, want: 250},
+		{src: -3.25, want: -325},
+		{src: "abc", wantErr: true},
+		{src: "1.005", wantErr: true},
+		{src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		a := Amount(99)
+		err := a.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%#v) = %d, want an error", tt.src, a)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Scan(%#v) returned error: %v", tt.src, err)
+			continue
+		}
+		if a != tt.want {
+			t.Errorf("Scan(%#v) = %d, want %d", tt.src, a, tt.want)
+		}
+	}
+}
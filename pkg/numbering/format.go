@@ -0,0 +1,107 @@
+// Package numbering parses the layouts of document numbers such as order
+// numbers and tells which sequence period a number belongs to.
+package numbering
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fahrillrizal/ecommerce-grpc/models"
+)
+
+// defaultLayouts are used for modules without a NUMBER_FORMAT_<MODULE>
+// environment variable.
+var defaultLayouts = map[string]string{
+	models.NumberingModuleOrder:  "ORD-{YYYY}{SEQ:8}",
+	models.NumberingModuleReturn: "RET-{YYYY}{SEQ:6}",
+}
+
+// formatToken matches the placeholders of a number format: {YYYY} and {YY}
+// for the year, {MM} for the month and {SEQ} or {SEQ:width} for the
+// sequence, zero padded to width.
+var formatToken = regexp.MustCompile(`\{(YYYY|YY|MM|SEQ(?::(\d+))?)\}`)
+
+// Format is a parsed number format. The sequence starts over whenever the
+// date parts used in the format change.
+type Format struct {
+	layout  string
+	monthly bool
+	yearly  bool
+}
+
+func Parse(layout string) (*Format, error) {
+	format := &Format{layout: layout}
+	sequences := 0
+	for _, match := range formatToken.FindAllStringSubmatch(layout, -1) {
+		switch {
+		case match[1] == "MM":
+			format.monthly = true
+		case match[1] == "YYYY" || match[1] == "YY":
+			format.yearly = true
+		default:
+			sequences++
+		}
+	}
+
+	if sequences != 1 {
+		return nil, fmt.Errorf("number format %q must contain {SEQ} exactly once", layout)
+	}
+
+	return format, nil
+}
+
+// Period names the span of time a sequence runs for.
+func (f *Format) Period(now time.Time) string {
+	switch {
+	case f.monthly:
+		return now.Format("2006-01")
+	case f.yearly:
+		return now.Format("2006")
+	default:
+		return ""
+	}
+}
+
+func (f *Format) Number(now time.Time, sequence int64) string {
+	return formatToken.ReplaceAllStringFunc(f.layout, func(token string) string {
+		match := formatToken.FindStringSubmatch(token)
+		switch match[1] {
+		case "YYYY":
+			return now.Format("2006")
+		case "YY":
+			return now.Format("06")
+		case "MM":
+			return now.Format("01")
+		}
+
+		width, _ := strconv.Atoi(match[2])
+		return fmt.Sprintf("%0*d", width, sequence)
+	})
+}
+
+// FormatsFromEnv returns the format of every module, taken from
+// NUMBER_FORMAT_<MODULE> when it is set and valid.
+func FormatsFromEnv() map[string]*Format {
+	formats := make(map[string]*Format)
+	for module, layout := range defaultLayouts {
+		format, _ := Parse(layout)
+
+		custom := os.Getenv("NUMBER_FORMAT_" + strings.ToUpper(module))
+		if custom != "" {
+			customFormat, err := Parse(custom)
+			if err != nil {
+				log.Printf("using the default %s number format: %v", module, err)
+			} else {
+				format = customFormat
+			}
+		}
+
+		formats[module] = format
+	}
+	return formats
+}
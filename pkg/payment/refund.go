@@ -0,0 +1,45 @@
+// Package payment talks to the payment gateway for operations the invoice
+// SDK does not cover.
+package payment
+
+import (
+	"context"
+
+	"github.com/fahrillrizal/ecommerce-grpc/pkg/money"
+)
+
+// Refund statuses reported by the gateway.
+const (
+	RefundStatusPending   = "PENDING"
+	RefundStatusSucceeded = "SUCCEEDED"
+	RefundStatusFailed    = "FAILED"
+)
+
+// Refund reasons understood by the gateway.
+const (
+	RefundReasonCancellation        = "CANCELLATION"
+	RefundReasonRequestedByCustomer = "REQUESTED_BY_CUSTOMER"
+	RefundReasonOthers              = "OTHERS"
+)
+
+type RefundRequest struct {
+	InvoiceID string
+	// ReferenceID identifies the refund on our side and makes retries safe.
+	ReferenceID  string
+	Amount       money.Amount
+	CurrencyCode string
+	// Reason is one of the RefundReason constants. Empty is sent as
+	// RefundReasonOthers.
+	Reason string
+}
+
+type Refund struct {
+	ID     string
+	Status string
+}
+
+// RefundGateway is implemented for each payment gateway that can refund a
+// paid invoice.
+type RefundGateway interface {
+	Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+}
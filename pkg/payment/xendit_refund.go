@@ -0,0 +1,87 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const xenditRefundURL = "https://api.xendit.co/refunds"
+
+// XenditRefundGateway refunds Xendit invoices through the Refund API.
+type XenditRefundGateway struct {
+	SecretKey string
+	Client    *http.Client
+}
+
+type xenditRefundRequest struct {
+	InvoiceID   string  `json:"invoice_id"`
+	ReferenceID string  `json:"reference_id"`
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`
+	Reason      string  `json:"reason"`
+}
+
+type xenditRefundResponse struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	ErrorCode string `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func (xg *XenditRefundGateway) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = RefundReasonOthers
+	}
+
+	body, err := json.Marshal(xenditRefundRequest{
+		InvoiceID:   req.InvoiceID,
+		ReferenceID: req.ReferenceID,
+		Amount:      req.Amount.Float64(),
+		Currency:    req.CurrencyCode,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, xenditRefundURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(xg.SecretKey, "")
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Idempotency-key", req.ReferenceID)
+
+	res, err := xg.Client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var refundRes xenditRefundResponse
+	err = json.NewDecoder(res.Body).Decode(&refundRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode xendit refund response: %w", err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("xendit refund failed: %s %s", refundRes.ErrorCode, refundRes.Message)
+	}
+
+	return &Refund{
+		ID:     refundRes.ID,
+		Status: refundRes.Status,
+	}, nil
+}
+
+func NewXenditRefundGateway(secretKey string) RefundGateway {
+	return &XenditRefundGateway{
+		SecretKey: secretKey,
+		Client:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// maxReceivedBody caps the body a Receiver reads.
+const maxReceivedBody = 1 << 20
+
+// Received is a delivery that passed signature checks.
+type Received struct {
+	EventID   string
+	EventType string
+	Body      []byte
+}
+
+// receiver is a minimal webhook endpoint. It stands in for a merchant's
+// system when trying subscriptions locally, e.g. behind httptest.NewServer
+// or a plain http.ListenAndServe.
+type receiver struct {
+	secret string
+	handle func(Received) error
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxReceivedBody))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	err = Verify(rc.secret, r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), body, 5*time.Minute, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	err = rc.handle(Received{
+		EventID:   r.Header.Get(IDHeader),
+		EventType: r.Header.Get(EventHeader),
+		Body:      body,
+	})
+	if err != nil {
+		log.Printf("webhook receiver failed to handle event %s: %v", r.Header.Get(IDHeader), err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// NewReceiver returns a handler that checks each delivery against secret
+// and passes it to handle. An error from handle answers 500, which makes
+// the sender retry.
+func NewReceiver(secret string, handle func(Received) error) http.Handler {
+	return &receiver{
+		secret: secret,
+		handle: handle,
+	}
+}